@@ -12,6 +12,8 @@ const (
 	DefaultWeightMsgCreateAtomicSwap      int = 20
 	DefaultWeightMsgUpdatePrices          int = 20
 	DefaultWeightMsgCdp                   int = 20
+	DefaultWeightMsgTransferCdp           int = 5
+	DefaultWeightMsgRepay                 int = 20
 	DefaultWeightMsgClaimReward           int = 20
 	DefaultWeightMsgIssue                 int = 20
 	DefaultWeightMsgRedeem                int = 20
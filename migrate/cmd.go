@@ -11,7 +11,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
 
-	"github.com/kava-labs/kava/migrate/v0_11"
+	"github.com/kava-labs/kava/migrate/v0_12"
 	tmtypes "github.com/tendermint/tendermint/types"
 )
 
@@ -24,8 +24,8 @@ const (
 func MigrateGenesisCmd(_ *server.Context, cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "migrate [genesis-file]",
-		Short:   "Migrate genesis file from kava v0.10 to v0.11",
-		Long:    "Migrate the source genesis into the current version, sorts it, and print to STDOUT. If not provided, chain-id is set to kava-4 and genesis time is set to 2020-10-15T:14:00:00Z",
+		Short:   "Migrate genesis file from kava v0.11 to v0.12",
+		Long:    "Migrate the source genesis into the current version, sorts it, and print to STDOUT. If not provided, chain-id and genesis time are kept from the source genesis",
 		Example: fmt.Sprintf(`%s migrate /path/to/genesis.json --chain-id=new-chain-id --genesis-time=1998-01-01T00:00:00Z`, version.ServerName),
 		Args:    cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -38,9 +38,9 @@ func MigrateGenesisCmd(_ *server.Context, cdc *codec.Codec) *cobra.Command {
 				return fmt.Errorf("failed to read genesis document from file %s: %w", importGenesis, err)
 			}
 
-			// 2) Migrate state from kava v0.3 to v0.8
+			// 2) Migrate state from kava v0.11 to v0.12
 
-			newGenDoc := v0_11.Migrate(*genDoc)
+			newGenDoc := v0_12.Migrate(*genDoc)
 
 			// 3) Create and output a new genesis file
 
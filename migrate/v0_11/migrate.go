@@ -306,7 +306,7 @@ func MigrateCommittee(oldGenState v0_9committee.GenesisState) v0_11committee.Gen
 					newDebtParam := v0_11committee.AllowedDebtParam{
 						ConversionFactor: oldDebtParam.ConversionFactor,
 						DebtFloor:        oldDebtParam.DebtFloor,
-						Denom:            oldDebtParam.Denom,
+						Denom:            "usdx",
 						ReferenceAsset:   oldDebtParam.ReferenceAsset,
 						SavingsRate:      oldDebtParam.SavingsRate,
 					}
@@ -322,7 +322,13 @@ func MigrateCommittee(oldGenState v0_9committee.GenesisState) v0_11committee.Gen
 					oldMarketParams := subPermission.AllowedMarkets
 					var newMarketParams v0_11committee.AllowedMarkets
 					for _, oldMarketParam := range oldMarketParams {
-						newMarketParam := v0_11committee.AllowedMarket(oldMarketParam)
+						newMarketParam := v0_11committee.AllowedMarket{
+							MarketID:   oldMarketParam.MarketID,
+							BaseAsset:  oldMarketParam.BaseAsset,
+							QuoteAsset: oldMarketParam.QuoteAsset,
+							Oracles:    oldMarketParam.Oracles,
+							Active:     oldMarketParam.Active,
+						}
 						newMarketParams = append(newMarketParams, newMarketParam)
 					}
 					// add btc, xrp, busd markets to committee
@@ -408,23 +414,23 @@ func MigrateCommittee(oldGenState v0_9committee.GenesisState) v0_11committee.Gen
 					}
 					// --------- ADD BTC-B, XRP-B, BUSD(a), BUSD(b) cdp collateral params to stability committee
 					busdaAllowedCollateralParam := v0_11committee.NewAllowedCollateralParam(
-						"busd-a", false, false, true, true, true, false, false, false, false, false,
+						"busd-a", false, false, true, true, true, false, false, false, false, false, false, false, false, false, false,
 					)
 					busdbAllowedCollateralParam := v0_11committee.NewAllowedCollateralParam(
-						"busd-b", false, false, true, true, true, false, false, false, false, false,
+						"busd-b", false, false, true, true, true, false, false, false, false, false, false, false, false, false, false,
 					)
 					btcbAllowedCollateralParam := v0_11committee.NewAllowedCollateralParam(
-						"btcb-a", false, false, true, true, true, false, false, false, false, false,
+						"btcb-a", false, false, true, true, true, false, false, false, false, false, false, false, false, false, false,
 					)
 					xrpbAllowedCollateralParam := v0_11committee.NewAllowedCollateralParam(
-						"xrpb-a", false, false, true, true, true, false, false, false, false, false,
+						"xrpb-a", false, false, true, true, true, false, false, false, false, false, false, false, false, false, false,
 					)
 
 					newStabilitySubParamPermissions.AllowedAssetParams = v0_11committee.AllowedAssetParams{
 						newAssetParam, busdAllowedAssetParam, btcbAllowedAssetParam, xrpbAllowedAssetParam}
 					newStabilitySubParamPermissions.AllowedCollateralParams = v0_11committee.AllowedCollateralParams{
 						newCollateralParam, busdaAllowedCollateralParam, busdbAllowedCollateralParam, btcbAllowedCollateralParam, xrpbAllowedCollateralParam}
-					newStabilitySubParamPermissions.AllowedDebtParam = newDebtParam
+					newStabilitySubParamPermissions.AllowedDebtParams = v0_11committee.AllowedDebtParams{newDebtParam}
 					newStabilitySubParamPermissions.AllowedMarkets = newMarketParams
 					newStabilitySubParamPermissions.AllowedParams = newAllowedParams
 					newStabilityPermissions = append(newStabilityPermissions, newStabilitySubParamPermissions)
@@ -670,7 +676,7 @@ func MigrateCDP(oldGenState v0_9cdp.GenesisState) v0_11cdp.GenesisState {
 	newStartingID := oldGenState.StartingCdpID
 
 	for _, cdp := range oldGenState.CDPs {
-		newCDP := v0_11cdp.NewCDPWithFees(cdp.ID, cdp.Owner, cdp.Collateral, "bnb-a", cdp.Principal, cdp.AccumulatedFees, cdp.FeesUpdated)
+		newCDP := v0_11cdp.NewCDPWithFees(cdp.ID, cdp.Owner, cdp.Collateral, "bnb-a", cdp.Principal, cdp.AccumulatedFees, cdp.FeesUpdated, sdk.OneDec())
 		newCDPs = append(newCDPs, newCDP)
 	}
 
@@ -680,21 +686,21 @@ func MigrateCDP(oldGenState v0_9cdp.GenesisState) v0_11cdp.GenesisState {
 	}
 
 	for _, cp := range oldGenState.Params.CollateralParams {
-		newCollateralParam := v0_11cdp.NewCollateralParam(cp.Denom, "bnb-a", cp.LiquidationRatio, cp.DebtLimit, cp.StabilityFee, cp.AuctionSize, cp.LiquidationPenalty, 0x01, cp.SpotMarketID, cp.LiquidationMarketID, cp.ConversionFactor)
+		newCollateralParam := v0_11cdp.NewCollateralParam(cp.Denom, "bnb-a", cp.LiquidationRatio, cp.DebtLimit, cp.StabilityFee, cp.AuctionSize, cp.LiquidationPenalty, 0x01, cp.SpotMarketID, cp.LiquidationMarketID, cp.ConversionFactor, sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec(), false, 0)
 		newCollateralParams = append(newCollateralParams, newCollateralParam)
 	}
-	btcbCollateralParam := v0_11cdp.NewCollateralParam("btcb", "btcb-a", sdk.MustNewDecFromStr("1.5"), sdk.NewCoin("usdx", sdk.NewInt(100000000000)), sdk.MustNewDecFromStr("1.000000001547125958"), sdk.NewInt(100000000), sdk.MustNewDecFromStr("0.075000000000000000"), 0x02, "btc:usd", "btc:usd:30", sdk.NewInt(8))
-	busdaCollateralParam := v0_11cdp.NewCollateralParam("busd", "busd-a", sdk.MustNewDecFromStr("1.01"), sdk.NewCoin("usdx", sdk.NewInt(3000000000000)), sdk.OneDec(), sdk.NewInt(1000000000000), sdk.MustNewDecFromStr("0.075000000000000000"), 0x03, "busd:usd", "busd:usd:30", sdk.NewInt(8))
-	busdbCollateralParam := v0_11cdp.NewCollateralParam("busd", "busd-b", sdk.MustNewDecFromStr("1.1"), sdk.NewCoin("usdx", sdk.NewInt(1000000000000)), sdk.MustNewDecFromStr("1.000000012857214317"), sdk.NewInt(1000000000000), sdk.MustNewDecFromStr("0.075000000000000000"), 0x04, "busd:usd", "busd:usd:30", sdk.NewInt(8))
-	xrpbCollateralParam := v0_11cdp.NewCollateralParam("xrpb", "xrpb-a", sdk.MustNewDecFromStr("1.5"), sdk.NewCoin("usdx", sdk.NewInt(100000000000)), sdk.MustNewDecFromStr("1.000000001547125958"), sdk.NewInt(4000000000000), sdk.MustNewDecFromStr("0.075000000000000000"), 0x05, "xrp:usd", "xrp:usd:30", sdk.NewInt(8))
+	btcbCollateralParam := v0_11cdp.NewCollateralParam("btcb", "btcb-a", sdk.MustNewDecFromStr("1.5"), sdk.NewCoin("usdx", sdk.NewInt(100000000000)), sdk.MustNewDecFromStr("1.000000001547125958"), sdk.NewInt(100000000), sdk.MustNewDecFromStr("0.075000000000000000"), 0x02, "btc:usd", "btc:usd:30", sdk.NewInt(8), sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec(), false, 0)
+	busdaCollateralParam := v0_11cdp.NewCollateralParam("busd", "busd-a", sdk.MustNewDecFromStr("1.01"), sdk.NewCoin("usdx", sdk.NewInt(3000000000000)), sdk.OneDec(), sdk.NewInt(1000000000000), sdk.MustNewDecFromStr("0.075000000000000000"), 0x03, "busd:usd", "busd:usd:30", sdk.NewInt(8), sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec(), false, 0)
+	busdbCollateralParam := v0_11cdp.NewCollateralParam("busd", "busd-b", sdk.MustNewDecFromStr("1.1"), sdk.NewCoin("usdx", sdk.NewInt(1000000000000)), sdk.MustNewDecFromStr("1.000000012857214317"), sdk.NewInt(1000000000000), sdk.MustNewDecFromStr("0.075000000000000000"), 0x04, "busd:usd", "busd:usd:30", sdk.NewInt(8), sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec(), false, 0)
+	xrpbCollateralParam := v0_11cdp.NewCollateralParam("xrpb", "xrpb-a", sdk.MustNewDecFromStr("1.5"), sdk.NewCoin("usdx", sdk.NewInt(100000000000)), sdk.MustNewDecFromStr("1.000000001547125958"), sdk.NewInt(4000000000000), sdk.MustNewDecFromStr("0.075000000000000000"), 0x05, "xrp:usd", "xrp:usd:30", sdk.NewInt(8), sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec(), false, 0)
 	newCollateralParams = append(newCollateralParams, btcbCollateralParam, busdaCollateralParam, busdbCollateralParam, xrpbCollateralParam)
 	oldDebtParam := oldGenState.Params.DebtParam
 
-	newDebtParam := v0_11cdp.NewDebtParam(oldDebtParam.Denom, oldDebtParam.ReferenceAsset, oldDebtParam.ConversionFactor, oldDebtParam.DebtFloor, oldDebtParam.SavingsRate)
+	newDebtParam := v0_11cdp.NewDebtParam(oldDebtParam.Denom, oldDebtParam.ReferenceAsset, oldDebtParam.ConversionFactor, oldDebtParam.DebtFloor, oldDebtParam.SavingsRate, oldGenState.Params.SurplusAuctionThreshold, oldGenState.Params.SurplusAuctionLot, oldGenState.Params.DebtAuctionThreshold, oldGenState.Params.DebtAuctionLot)
 
 	newGlobalDebtLimit := oldGenState.Params.GlobalDebtLimit.Add(btcbCollateralParam.DebtLimit).Add(busdaCollateralParam.DebtLimit).Add(busdbCollateralParam.DebtLimit).Add(xrpbCollateralParam.DebtLimit)
 
-	newParams := v0_11cdp.NewParams(newGlobalDebtLimit, newCollateralParams, newDebtParam, oldGenState.Params.SurplusAuctionThreshold, oldGenState.Params.SurplusAuctionLot, oldGenState.Params.DebtAuctionThreshold, oldGenState.Params.DebtAuctionLot, oldGenState.Params.SavingsDistributionFrequency, false)
+	newParams := v0_11cdp.NewParams(sdk.NewCoins(newGlobalDebtLimit), newCollateralParams, v0_11cdp.DebtParams{newDebtParam}, oldGenState.Params.SavingsDistributionFrequency, false)
 
 	return v0_11cdp.NewGenesisState(
 		newParams,
@@ -704,7 +710,9 @@ func MigrateCDP(oldGenState v0_9cdp.GenesisState) v0_11cdp.GenesisState {
 		oldGenState.DebtDenom,
 		oldGenState.GovDenom,
 		oldGenState.PreviousDistributionTime,
-		sdk.ZeroInt(),
+		sdk.NewCoins(),
+		v0_11cdp.GenesisAccumulationTimes{},
+		v0_11cdp.SavingsDeposits{},
 	)
 }
 
@@ -776,7 +784,7 @@ func MigratePricefeed(oldGenState v0_9pricefeed.GenesisState) v0_11pricefeed.Gen
 		newPrice := v0_11pricefeed.NewPostedPrice(price.MarketID, price.OracleAddress, price.Price, price.Expiry)
 		newPostedPrices = append(newPostedPrices, newPrice)
 	}
-	newParams := v0_11pricefeed.NewParams(newMarkets)
+	newParams := v0_11pricefeed.NewParams(newMarkets, v0_11pricefeed.DefaultPriceHistoryLength, v0_11pricefeed.DefaultMissedWindowsThreshold, v0_11pricefeed.DefaultDeviationThreshold)
 
 	return v0_11pricefeed.NewGenesisState(newParams, newPostedPrices)
 }
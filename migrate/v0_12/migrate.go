@@ -0,0 +1,389 @@
+package v0_12
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+
+	cryptoAmino "github.com/tendermint/tendermint/crypto/encoding/amino"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/auction"
+	"github.com/kava-labs/kava/x/cdp"
+	v0_11cdp "github.com/kava-labs/kava/x/cdp/legacy/v0_11"
+	"github.com/kava-labs/kava/x/committee"
+	v0_11committee "github.com/kava-labs/kava/x/committee/legacy/v0_11"
+	"github.com/kava-labs/kava/x/harvest"
+	"github.com/kava-labs/kava/x/pricefeed"
+)
+
+// legacyDebtParamKeys are the cdp param keys that were folded into the cdp DebtParams param
+var legacyDebtParamKeys = []string{
+	string(v0_11cdp.KeyDebtParam),
+	"SurplusThreshold",
+	"SurplusLot",
+	"DebtThreshold",
+	"DebtLot",
+}
+
+// Migrate translates a genesis file from kava v0.11 format to kava v0.12.x format.
+func Migrate(genDoc tmtypes.GenesisDoc) tmtypes.GenesisDoc {
+	// migrate app state
+	var appStateMap genutil.AppMap
+	cdc := codec.New()
+	cryptoAmino.RegisterAmino(cdc)
+	tmtypes.RegisterEvidences(cdc)
+
+	if err := cdc.UnmarshalJSON(genDoc.AppState, &appStateMap); err != nil {
+		panic(err)
+	}
+	newAppState := MigrateAppState(appStateMap)
+	v0_12Codec := app.MakeCodec()
+	marshaledNewAppState, err := v0_12Codec.MarshalJSON(newAppState)
+	if err != nil {
+		panic(err)
+	}
+	genDoc.AppState = marshaledNewAppState
+	return genDoc
+}
+
+// MigrateAppState migrates application state from v0.11 format to a kava v0.12.x format
+func MigrateAppState(v0_11AppState genutil.AppMap) genutil.AppMap {
+	v0_12AppState := v0_11AppState
+	v0_12Codec := app.MakeCodec()
+
+	// debt coins are renamed from the global debt denom to a debt denom specific to the principal denom
+	oldDebtDenom := cdp.DefaultDebtDenom
+	newDebtDenom := cdp.DebtCoinDenom(cdp.DefaultDebtDenom, cdp.DefaultStableDenom)
+	if v0_11AppState[cdp.ModuleName] != nil {
+		var cdpGenState v0_11cdp.GenesisState
+		v0_12Codec.MustUnmarshalJSON(v0_11AppState[cdp.ModuleName], &cdpGenState)
+		delete(v0_11AppState, cdp.ModuleName)
+		oldDebtDenom = cdpGenState.DebtDenom
+		newDebtDenom = cdp.DebtCoinDenom(cdpGenState.DebtDenom, cdpGenState.Params.DebtParam.Denom)
+		v0_12AppState[cdp.ModuleName] = v0_12Codec.MustMarshalJSON(MigrateCDP(cdpGenState))
+	}
+	if v0_11AppState[committee.ModuleName] != nil {
+		var committeeGenState v0_11committee.GenesisState
+		cdc := codec.New()
+		sdk.RegisterCodec(cdc)
+		registerV0_11CommitteeCodec(cdc)
+		cdc.MustUnmarshalJSON(v0_11AppState[committee.ModuleName], &committeeGenState)
+		delete(v0_11AppState, committee.ModuleName)
+		v0_12AppState[committee.ModuleName] = v0_12Codec.MustMarshalJSON(MigrateCommittee(committeeGenState))
+	}
+	if v0_11AppState[auth.ModuleName] != nil {
+		var authGenState auth.GenesisState
+		v0_12Codec.MustUnmarshalJSON(v0_11AppState[auth.ModuleName], &authGenState)
+		delete(v0_11AppState, auth.ModuleName)
+		v0_12AppState[auth.ModuleName] = v0_12Codec.MustMarshalJSON(MigrateAuth(authGenState, oldDebtDenom, newDebtDenom))
+	}
+	if v0_11AppState[supply.ModuleName] != nil {
+		var supplyGenState supply.GenesisState
+		v0_12Codec.MustUnmarshalJSON(v0_11AppState[supply.ModuleName], &supplyGenState)
+		delete(v0_11AppState, supply.ModuleName)
+		supplyGenState.Supply = renameCoins(supplyGenState.Supply, oldDebtDenom, newDebtDenom)
+		v0_12AppState[supply.ModuleName] = v0_12Codec.MustMarshalJSON(supplyGenState)
+	}
+	if v0_11AppState[auction.ModuleName] != nil {
+		var auctionGenState auction.GenesisState
+		v0_12Codec.MustUnmarshalJSON(v0_11AppState[auction.ModuleName], &auctionGenState)
+		delete(v0_11AppState, auction.ModuleName)
+		v0_12AppState[auction.ModuleName] = v0_12Codec.MustMarshalJSON(MigrateAuction(auctionGenState, oldDebtDenom, newDebtDenom))
+	}
+	if v0_11AppState[pricefeed.ModuleName] != nil {
+		var pricefeedGenState pricefeed.GenesisState
+		v0_12Codec.MustUnmarshalJSON(v0_11AppState[pricefeed.ModuleName], &pricefeedGenState)
+		delete(v0_11AppState, pricefeed.ModuleName)
+		v0_12AppState[pricefeed.ModuleName] = v0_12Codec.MustMarshalJSON(MigratePricefeed(pricefeedGenState))
+	}
+	if v0_11AppState[harvest.ModuleName] != nil {
+		var harvestGenState harvest.GenesisState
+		v0_12Codec.MustUnmarshalJSON(v0_11AppState[harvest.ModuleName], &harvestGenState)
+		delete(v0_11AppState, harvest.ModuleName)
+		v0_12AppState[harvest.ModuleName] = v0_12Codec.MustMarshalJSON(MigrateHarvest(harvestGenState))
+	}
+	return v0_12AppState
+}
+
+// MigrateCDP migrates from a v0.11 cdp genesis state to a v0.12 cdp genesis state
+func MigrateCDP(oldGenState v0_11cdp.GenesisState) cdp.GenesisState {
+	var newCollateralParams cdp.CollateralParams
+	for _, cp := range oldGenState.Params.CollateralParams {
+		newCollateralParam := cdp.NewCollateralParam(cp.Denom, cp.Type, cp.LiquidationRatio, cp.DebtLimit, cp.StabilityFee, cp.AuctionSize, cp.LiquidationPenalty, cp.Prefix, cp.SpotMarketID, cp.LiquidationMarketID, cp.ConversionFactor, sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec(), false, 0)
+		newCollateralParams = append(newCollateralParams, newCollateralParam)
+	}
+
+	// the auction thresholds and lots move from the top level params into the debt param
+	oldDebtParam := oldGenState.Params.DebtParam
+	newDebtParam := cdp.NewDebtParam(
+		oldDebtParam.Denom, oldDebtParam.ReferenceAsset, oldDebtParam.ConversionFactor, oldDebtParam.DebtFloor, oldDebtParam.SavingsRate,
+		oldGenState.Params.SurplusAuctionThreshold, oldGenState.Params.SurplusAuctionLot,
+		oldGenState.Params.DebtAuctionThreshold, oldGenState.Params.DebtAuctionLot,
+	)
+
+	newParams := cdp.NewParams(
+		sdk.NewCoins(oldGenState.Params.GlobalDebtLimit),
+		newCollateralParams,
+		cdp.DebtParams{newDebtParam},
+		oldGenState.Params.SavingsDistributionFrequency,
+		oldGenState.Params.CircuitBreaker,
+	)
+
+	// fees accumulated before the migration have already been added to each cdp, so every cdp
+	// starts from an interest factor of one
+	var newCDPs cdp.CDPs
+	for _, c := range oldGenState.CDPs {
+		newCDP := cdp.NewCDPWithFees(c.ID, c.Owner, c.Collateral, c.Type, c.Principal, c.AccumulatedFees, c.FeesUpdated, sdk.OneDec())
+		newCDPs = append(newCDPs, newCDP)
+	}
+
+	var newDeposits cdp.Deposits
+	for _, d := range oldGenState.Deposits {
+		newDeposits = append(newDeposits, cdp.NewDeposit(d.CdpID, d.Depositor, d.Amount))
+	}
+
+	// the savings rate was previously paid to every holder of the debt denom, holders now opt in by
+	// depositing into the savings vault, so the vault starts empty
+	return cdp.NewGenesisState(
+		newParams,
+		newCDPs,
+		newDeposits,
+		oldGenState.StartingCdpID,
+		oldGenState.DebtDenom,
+		oldGenState.GovDenom,
+		oldGenState.PreviousDistributionTime,
+		sdk.NewCoins(sdk.NewCoin(oldDebtParam.Denom, oldGenState.SavingsRateDistributed)),
+		cdp.GenesisAccumulationTimes{},
+		cdp.SavingsDeposits{},
+	)
+}
+
+// MigrateCommittee migrates from a v0.11 committee genesis state to a v0.12 committee genesis state
+func MigrateCommittee(oldGenState v0_11committee.GenesisState) committee.GenesisState {
+	var newCommittees []committee.Committee
+	for _, c := range oldGenState.Committees {
+		var newPermissions []committee.Permission
+		for _, permission := range c.Permissions {
+			newPermissions = append(newPermissions, migratePermission(permission))
+		}
+		newCommittees = append(newCommittees, committee.NewCommittee(c.ID, c.Description, c.Members, newPermissions, c.VoteThreshold, c.ProposalDuration))
+	}
+
+	var newProposals []committee.Proposal
+	for _, oldProp := range oldGenState.Proposals {
+		newPubProposal := committee.PubProposal(oldProp.PubProposal)
+		newProposals = append(newProposals, committee.NewProposal(newPubProposal, oldProp.ID, oldProp.CommitteeID, oldProp.Deadline))
+	}
+
+	var newVotes []committee.Vote
+	for _, oldVote := range oldGenState.Votes {
+		newVotes = append(newVotes, committee.NewVote(oldVote.ProposalID, oldVote.Voter))
+	}
+
+	return committee.NewGenesisState(oldGenState.NextProposalID, newCommittees, newProposals, newVotes)
+}
+
+func migratePermission(permission v0_11committee.Permission) committee.Permission {
+	switch perm := permission.(type) {
+	case v0_11committee.GodPermission:
+		return committee.GodPermission{}
+	case v0_11committee.TextPermission:
+		return committee.TextPermission{}
+	case v0_11committee.SoftwareUpgradePermission:
+		return committee.SoftwareUpgradePermission{}
+	case v0_11committee.SimpleParamChangePermission:
+		allowedParams, _ := migrateAllowedParams(perm.AllowedParams)
+		return committee.SimpleParamChangePermission{AllowedParams: allowedParams}
+	case v0_11committee.SubParamChangePermission:
+		allowedParams, allowedDebtKeys := migrateAllowedParams(perm.AllowedParams)
+
+		var allowedCollateralParams committee.AllowedCollateralParams
+		for _, acp := range perm.AllowedCollateralParams {
+			allowedCollateralParams = append(allowedCollateralParams, committee.NewAllowedCollateralParam(
+				acp.Type, acp.Denom, acp.LiquidationRatio, acp.DebtLimit, acp.StabilityFee, acp.AuctionSize,
+				acp.LiquidationPenalty, acp.Prefix, acp.SpotMarketID, acp.LiquidationMarketID, acp.ConversionFactor,
+				false, false, false, false, false,
+			))
+		}
+
+		// the debt param permission is kept for usdx, the only debt asset in v0.11, and extended to the
+		// auction thresholds and lots if those params could previously be changed on their own
+		oldDebtParam := perm.AllowedDebtParam
+		allowedDebtParam := committee.AllowedDebtParam{
+			Denom:                   cdp.DefaultStableDenom,
+			ReferenceAsset:          oldDebtParam.ReferenceAsset,
+			ConversionFactor:        oldDebtParam.ConversionFactor,
+			DebtFloor:               oldDebtParam.DebtFloor,
+			SavingsRate:             oldDebtParam.SavingsRate,
+			SurplusAuctionThreshold: allowedDebtKeys["SurplusThreshold"],
+			SurplusAuctionLot:       allowedDebtKeys["SurplusLot"],
+			DebtAuctionThreshold:    allowedDebtKeys["DebtThreshold"],
+			DebtAuctionLot:          allowedDebtKeys["DebtLot"],
+		}
+
+		var allowedAssetParams committee.AllowedAssetParams
+		for _, aap := range perm.AllowedAssetParams {
+			// v0.11 permissions didn't check the max swap amount or min block lock, so changes to them stay allowed
+			allowedAssetParams = append(allowedAssetParams, committee.AllowedAssetParam{
+				Denom:         aap.Denom,
+				CoinID:        aap.CoinID,
+				Limit:         aap.Limit,
+				Active:        aap.Active,
+				MaxSwapAmount: true,
+				MinBlockLock:  true,
+			})
+		}
+
+		var allowedMarkets committee.AllowedMarkets
+		for _, am := range perm.AllowedMarkets {
+			allowedMarkets = append(allowedMarkets, committee.AllowedMarket{
+				MarketID:   am.MarketID,
+				BaseAsset:  am.BaseAsset,
+				QuoteAsset: am.QuoteAsset,
+				Oracles:    am.Oracles,
+				Active:     am.Active,
+			})
+		}
+
+		return committee.SubParamChangePermission{
+			AllowedParams:           allowedParams,
+			AllowedCollateralParams: allowedCollateralParams,
+			AllowedDebtParams:       committee.AllowedDebtParams{allowedDebtParam},
+			AllowedAssetParams:      allowedAssetParams,
+			AllowedMarkets:          allowedMarkets,
+		}
+	default:
+		panic(fmt.Sprintf("unrecognized committee permission type: %T", permission))
+	}
+}
+
+// migrateAllowedParams replaces the removed cdp debt param keys with the DebtParams key, returning the
+// new allowed params and the set of removed keys that were allowed
+func migrateAllowedParams(oldAllowedParams v0_11committee.AllowedParams) (committee.AllowedParams, map[string]bool) {
+	var newAllowedParams committee.AllowedParams
+	allowedDebtKeys := make(map[string]bool)
+	for _, oldAllowedParam := range oldAllowedParams {
+		if oldAllowedParam.Subspace == cdp.ModuleName && isLegacyDebtParamKey(oldAllowedParam.Key) {
+			if len(allowedDebtKeys) == 0 {
+				newAllowedParams = append(newAllowedParams, committee.AllowedParam{Subspace: cdp.ModuleName, Key: string(cdp.KeyDebtParams)})
+			}
+			allowedDebtKeys[oldAllowedParam.Key] = true
+			continue
+		}
+		newAllowedParams = append(newAllowedParams, committee.AllowedParam(oldAllowedParam))
+	}
+	return newAllowedParams, allowedDebtKeys
+}
+
+func isLegacyDebtParamKey(key string) bool {
+	for _, k := range legacyDebtParamKeys {
+		if k == key {
+			return true
+		}
+	}
+	return false
+}
+
+// MigrateAuth renames the debt coins held by accounts in a v0.11 auth genesis state
+func MigrateAuth(genState auth.GenesisState, oldDebtDenom, newDebtDenom string) auth.GenesisState {
+	for _, acc := range genState.Accounts {
+		if acc.GetCoins().AmountOf(oldDebtDenom).IsZero() {
+			continue
+		}
+		if err := acc.SetCoins(renameCoins(acc.GetCoins(), oldDebtDenom, newDebtDenom)); err != nil {
+			panic(err)
+		}
+	}
+	return genState
+}
+
+// MigrateAuction renames the debt coins referenced by the auctions in a v0.11 auction genesis state
+func MigrateAuction(genState auction.GenesisState, oldDebtDenom, newDebtDenom string) auction.GenesisState {
+	var newAuctions auction.GenesisAuctions
+	for _, a := range genState.Auctions {
+		switch a := a.(type) {
+		case auction.CollateralAuction:
+			a.CorrespondingDebt = renameCoin(a.CorrespondingDebt, oldDebtDenom, newDebtDenom)
+			newAuctions = append(newAuctions, a)
+		case auction.DebtAuction:
+			a.CorrespondingDebt = renameCoin(a.CorrespondingDebt, oldDebtDenom, newDebtDenom)
+			newAuctions = append(newAuctions, a)
+		default:
+			newAuctions = append(newAuctions, a)
+		}
+	}
+	genState.Auctions = newAuctions
+
+	// dutch auction params did not exist in v0.11
+	genState.Params.DutchAuctionDuration = auction.DefaultDutchAuctionDuration
+	genState.Params.DutchStartPriceBuffer = auction.DefaultDutchStartPriceBuffer
+	genState.Params.DutchDecayCurve = auction.DefaultDutchDecayCurve
+	genState.Params.DutchDecayRate = auction.DefaultDutchDecayRate
+	genState.Params.CollateralAuctionParams = auction.DefaultCollateralAuctionParams
+	genState.Params.ReserveParams = auction.DefaultReserveParams
+	return genState
+}
+
+// MigratePricefeed sets the pricefeed params added in v0.12
+func MigratePricefeed(genState pricefeed.GenesisState) pricefeed.GenesisState {
+	// price history did not exist in v0.11
+	genState.Params.PriceHistoryLength = pricefeed.DefaultPriceHistoryLength
+	// oracle accountability did not exist in v0.11
+	genState.Params.OracleMissedWindowsThreshold = pricefeed.DefaultMissedWindowsThreshold
+	genState.Params.OracleDeviationThreshold = pricefeed.DefaultDeviationThreshold
+	return genState
+}
+
+// MigrateHarvest sets the money market params added in v0.12
+func MigrateHarvest(genState harvest.GenesisState) harvest.GenesisState {
+	newMoneyMarkets := harvest.MoneyMarkets{}
+	for _, mm := range genState.Params.MoneyMarkets {
+		// money markets without a reserve factor send none of their interest to the reserves
+		if mm.ReserveFactor.IsNil() {
+			mm.ReserveFactor = sdk.ZeroDec()
+		}
+		// money markets without a liquidation discount pay liquidators no discount on seized deposits
+		if mm.LiquidationDiscount.IsNil() {
+			mm.LiquidationDiscount = sdk.ZeroDec()
+		}
+		newMoneyMarkets = append(newMoneyMarkets, mm)
+	}
+	genState.Params.MoneyMarkets = newMoneyMarkets
+	// the close factor did not exist in v0.11
+	if genState.Params.CloseFactor.IsNil() {
+		genState.Params.CloseFactor = harvest.DefaultCloseFactor
+	}
+	return genState
+}
+
+// registerV0_11CommitteeCodec registers the v0.11 committee permission and proposal types for reading a v0.11 genesis
+func registerV0_11CommitteeCodec(cdc *codec.Codec) {
+	cdc.RegisterInterface((*v0_11committee.PubProposal)(nil), nil)
+	cdc.RegisterInterface((*v0_11committee.Permission)(nil), nil)
+	cdc.RegisterConcrete(v0_11committee.GodPermission{}, "kava/GodPermission", nil)
+	cdc.RegisterConcrete(v0_11committee.SimpleParamChangePermission{}, "kava/SimpleParamChangePermission", nil)
+	cdc.RegisterConcrete(v0_11committee.TextPermission{}, "kava/TextPermission", nil)
+	cdc.RegisterConcrete(v0_11committee.SoftwareUpgradePermission{}, "kava/SoftwareUpgradePermission", nil)
+	cdc.RegisterConcrete(v0_11committee.SubParamChangePermission{}, "kava/SubParamChangePermission", nil)
+}
+
+func renameCoins(coins sdk.Coins, oldDenom, newDenom string) sdk.Coins {
+	newCoins := sdk.NewCoins()
+	for _, c := range coins {
+		newCoins = newCoins.Add(renameCoin(c, oldDenom, newDenom))
+	}
+	return newCoins
+}
+
+func renameCoin(coin sdk.Coin, oldDenom, newDenom string) sdk.Coin {
+	if coin.Denom != oldDenom {
+		return coin
+	}
+	return sdk.NewCoin(newDenom, coin.Amount)
+}
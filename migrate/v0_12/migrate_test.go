@@ -0,0 +1,154 @@
+package v0_12
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/auction"
+	"github.com/kava-labs/kava/x/cdp"
+	v0_11cdp "github.com/kava-labs/kava/x/cdp/legacy/v0_11"
+	"github.com/kava-labs/kava/x/committee"
+	v0_11committee "github.com/kava-labs/kava/x/committee/legacy/v0_11"
+	"github.com/kava-labs/kava/x/harvest"
+	harvesttypes "github.com/kava-labs/kava/x/harvest/types"
+	"github.com/kava-labs/kava/x/pricefeed"
+)
+
+func TestMain(m *testing.M) {
+	config := sdk.GetConfig()
+	app.SetBech32AddressPrefixes(config)
+	app.SetBip44CoinType(config)
+
+	os.Exit(m.Run())
+}
+
+func TestMigrateCDP(t *testing.T) {
+	bz, err := ioutil.ReadFile(filepath.Join("testdata", "cdp-v011.json"))
+	require.NoError(t, err)
+	var oldGenState v0_11cdp.GenesisState
+	cdc := app.MakeCodec()
+	require.NotPanics(t, func() {
+		cdc.MustUnmarshalJSON(bz, &oldGenState)
+	})
+
+	newGenState := MigrateCDP(oldGenState)
+	err = newGenState.Validate()
+	require.NoError(t, err)
+
+	require.Len(t, newGenState.Params.DebtParams, 1)
+	dp := newGenState.Params.DebtParams[0]
+	require.Equal(t, oldGenState.Params.DebtParam.Denom, dp.Denom)
+	require.Equal(t, oldGenState.Params.SurplusAuctionThreshold, dp.SurplusAuctionThreshold)
+	require.Equal(t, oldGenState.Params.DebtAuctionLot, dp.DebtAuctionLot)
+	require.Equal(t, sdk.NewCoins(oldGenState.Params.GlobalDebtLimit), newGenState.Params.GlobalDebtLimit)
+	require.Equal(t, oldGenState.SavingsRateDistributed, newGenState.SavingsRateDistributed.AmountOf(dp.Denom))
+	require.Len(t, newGenState.CDPs, len(oldGenState.CDPs))
+	for _, c := range newGenState.CDPs {
+		require.Equal(t, sdk.OneDec(), c.InterestFactor)
+	}
+	require.Empty(t, newGenState.SavingsDeposits)
+}
+
+func TestMigrateCommittee(t *testing.T) {
+	bz, err := ioutil.ReadFile(filepath.Join("testdata", "committee-v011.json"))
+	require.NoError(t, err)
+	var oldGenState v0_11committee.GenesisState
+	cdc := codec.New()
+	sdk.RegisterCodec(cdc)
+	registerV0_11CommitteeCodec(cdc)
+	require.NotPanics(t, func() {
+		cdc.MustUnmarshalJSON(bz, &oldGenState)
+	})
+
+	newGenState := MigrateCommittee(oldGenState)
+	err = newGenState.Validate()
+	require.NoError(t, err)
+
+	require.Len(t, newGenState.Committees, 2)
+	perm, ok := newGenState.Committees[0].Permissions[0].(committee.SubParamChangePermission)
+	require.True(t, ok)
+	require.Equal(t,
+		committee.AllowedDebtParams{{
+			Denom:                   "usdx",
+			DebtFloor:               true,
+			SavingsRate:             true,
+			SurplusAuctionThreshold: true,
+			DebtAuctionThreshold:    true,
+		}},
+		perm.AllowedDebtParams,
+	)
+	require.Equal(t,
+		committee.AllowedParams{
+			{Subspace: "auction", Key: "BidDuration"},
+			{Subspace: "cdp", Key: "CollateralParams"},
+			{Subspace: "cdp", Key: "DebtParams"},
+			{Subspace: "cdp", Key: "CircuitBreaker"},
+			{Subspace: "pricefeed", Key: "Markets"},
+		},
+		perm.AllowedParams,
+	)
+	require.True(t, perm.AllowedAssetParams[0].MaxSwapAmount)
+	require.IsType(t, committee.TextPermission{}, newGenState.Committees[0].Permissions[1])
+	require.IsType(t, committee.SoftwareUpgradePermission{}, newGenState.Committees[1].Permissions[0])
+}
+
+func TestMigrateAuction(t *testing.T) {
+	addr := sdk.AccAddress("test")
+	oldGenState := auction.NewGenesisState(
+		2,
+		auction.DefaultParams(),
+		auction.GenesisAuctions{
+			auction.NewCollateralAuction(
+				cdp.LiquidatorMacc, sdk.NewInt64Coin("bnb", 100), time.Date(2020, 11, 1, 0, 0, 0, 0, time.UTC), sdk.NewInt64Coin("usdx", 1000),
+				auction.WeightedAddresses{Addresses: []sdk.AccAddress{addr}, Weights: []sdk.Int{sdk.NewInt(100)}},
+				sdk.NewInt64Coin("debt", 1000),
+			),
+		},
+	)
+
+	newGenState := MigrateAuction(oldGenState, "debt", "debtusdx")
+	require.Len(t, newGenState.Auctions, 1)
+	collateralAuction, ok := newGenState.Auctions[0].(auction.CollateralAuction)
+	require.True(t, ok)
+	require.Equal(t, sdk.NewInt64Coin("debtusdx", 1000), collateralAuction.CorrespondingDebt)
+	require.Equal(t, sdk.NewInt64Coin("bnb", 100), collateralAuction.Lot)
+}
+
+func TestMigratePricefeed(t *testing.T) {
+	oldGenState := pricefeed.DefaultGenesisState()
+	oldGenState.Params.PriceHistoryLength = 0
+	oldGenState.Params.OracleDeviationThreshold = sdk.Dec{}
+
+	newGenState := MigratePricefeed(oldGenState)
+	require.NoError(t, newGenState.Validate())
+	require.Equal(t, pricefeed.DefaultPriceHistoryLength, newGenState.Params.PriceHistoryLength)
+	require.Equal(t, pricefeed.DefaultDeviationThreshold, newGenState.Params.OracleDeviationThreshold)
+}
+
+func TestMigrateHarvest(t *testing.T) {
+	oldGenState := harvest.DefaultGenesisState()
+	oldGenState.Params.MoneyMarkets = harvest.MoneyMarkets{
+		harvesttypes.NewMoneyMarket("usdx", false, sdk.NewDec(1000000000000), sdk.MustNewDecFromStr("0.5"), "usdx:usd", sdk.NewInt(1000000),
+			harvesttypes.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")),
+			sdk.Dec{}, sdk.MustNewDecFromStr("0.05")),
+		harvesttypes.NewMoneyMarket("ukava", false, sdk.NewDec(1000000000000), sdk.MustNewDecFromStr("0.5"), "kava:usd", sdk.NewInt(1000000),
+			harvesttypes.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")),
+			sdk.MustNewDecFromStr("0.05"), sdk.Dec{}),
+	}
+	oldGenState.Params.CloseFactor = sdk.Dec{}
+	require.Error(t, oldGenState.Validate())
+
+	newGenState := MigrateHarvest(oldGenState)
+	require.NoError(t, newGenState.Validate())
+	require.Equal(t, sdk.ZeroDec(), newGenState.Params.MoneyMarkets[0].ReserveFactor)
+	require.Equal(t, sdk.ZeroDec(), newGenState.Params.MoneyMarkets[1].LiquidationDiscount)
+	require.Equal(t, harvest.DefaultCloseFactor, newGenState.Params.CloseFactor)
+}
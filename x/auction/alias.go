@@ -8,105 +8,152 @@ import (
 )
 
 const (
-	AttributeKeyAuctionID     = types.AttributeKeyAuctionID
-	AttributeKeyAuctionType   = types.AttributeKeyAuctionType
-	AttributeKeyBid           = types.AttributeKeyBid
-	AttributeKeyBidder        = types.AttributeKeyBidder
-	AttributeKeyCloseBlock    = types.AttributeKeyCloseBlock
-	AttributeKeyEndTime       = types.AttributeKeyEndTime
-	AttributeKeyLot           = types.AttributeKeyLot
-	AttributeKeyMaxBid        = types.AttributeKeyMaxBid
-	AttributeValueCategory    = types.AttributeValueCategory
-	CollateralAuctionType     = types.CollateralAuctionType
-	DebtAuctionType           = types.DebtAuctionType
-	DefaultBidDuration        = types.DefaultBidDuration
-	DefaultMaxAuctionDuration = types.DefaultMaxAuctionDuration
-	DefaultNextAuctionID      = types.DefaultNextAuctionID
-	DefaultParamspace         = types.DefaultParamspace
-	EventTypeAuctionBid       = types.EventTypeAuctionBid
-	EventTypeAuctionClose     = types.EventTypeAuctionClose
-	EventTypeAuctionStart     = types.EventTypeAuctionStart
-	ForwardAuctionPhase       = types.ForwardAuctionPhase
-	ModuleName                = types.ModuleName
-	QuerierRoute              = types.QuerierRoute
-	QueryGetAuction           = types.QueryGetAuction
-	QueryGetAuctions          = types.QueryGetAuctions
-	QueryGetParams            = types.QueryGetParams
-	QueryNextAuctionID        = types.QueryNextAuctionID
-	ReverseAuctionPhase       = types.ReverseAuctionPhase
-	RouterKey                 = types.RouterKey
-	StoreKey                  = types.StoreKey
-	SurplusAuctionType        = types.SurplusAuctionType
+	AttributeKeyAuctionID       = types.AttributeKeyAuctionID
+	AttributeKeyAuctionType     = types.AttributeKeyAuctionType
+	AttributeKeyBid             = types.AttributeKeyBid
+	AttributeKeyBidder          = types.AttributeKeyBidder
+	AttributeKeyCloseBlock      = types.AttributeKeyCloseBlock
+	AttributeKeyEndTime         = types.AttributeKeyEndTime
+	AttributeKeyLot             = types.AttributeKeyLot
+	AttributeKeyMaxBid          = types.AttributeKeyMaxBid
+	AttributeKeyPrice           = types.AttributeKeyPrice
+	AttributeKeyReservePrice    = types.AttributeKeyReservePrice
+	AttributeValueCategory      = types.AttributeValueCategory
+	CollateralAuctionType       = types.CollateralAuctionType
+	DebtAuctionType             = types.DebtAuctionType
+	DefaultBidDuration          = types.DefaultBidDuration
+	DefaultDutchAuctionDuration = types.DefaultDutchAuctionDuration
+	DefaultDutchDecayCurve      = types.DefaultDutchDecayCurve
+	DefaultMaxAuctionDuration   = types.DefaultMaxAuctionDuration
+	DefaultNextAuctionID        = types.DefaultNextAuctionID
+	DefaultParamspace           = types.DefaultParamspace
+	DutchAuctionPhase           = types.DutchAuctionPhase
+	DutchCollateralAuctionType  = types.DutchCollateralAuctionType
+	EventTypeAuctionBid         = types.EventTypeAuctionBid
+	EventTypeAuctionClose       = types.EventTypeAuctionClose
+	EventTypeAuctionExtend      = types.EventTypeAuctionExtend
+	EventTypeAuctionRestart     = types.EventTypeAuctionRestart
+	EventTypeAuctionStart       = types.EventTypeAuctionStart
+	ExponentialDecayCurve       = types.ExponentialDecayCurve
+	ForwardAuctionPhase         = types.ForwardAuctionPhase
+	LinearDecayCurve            = types.LinearDecayCurve
+	MaxConversionFactor         = types.MaxConversionFactor
+	ModuleName                  = types.ModuleName
+	QuerierRoute                = types.QuerierRoute
+	QueryGetAuction             = types.QueryGetAuction
+	QueryGetAuctions            = types.QueryGetAuctions
+	QueryGetAuctionsByBidder    = types.QueryGetAuctionsByBidder
+	QueryGetBidHistory          = types.QueryGetBidHistory
+	QueryGetParams              = types.QueryGetParams
+	QueryNextAuctionID          = types.QueryNextAuctionID
+	ReverseAuctionPhase         = types.ReverseAuctionPhase
+	RouterKey                   = types.RouterKey
+	StoreKey                    = types.StoreKey
+	SurplusAuctionType          = types.SurplusAuctionType
 )
 
 var (
 	// function aliases
-	ModuleAccountInvariants  = keeper.ModuleAccountInvariants
-	NewKeeper                = keeper.NewKeeper
-	NewQuerier               = keeper.NewQuerier
-	RegisterInvariants       = keeper.RegisterInvariants
-	ValidAuctionInvariant    = keeper.ValidAuctionInvariant
-	ValidIndexInvariant      = keeper.ValidIndexInvariant
-	DefaultGenesisState      = types.DefaultGenesisState
-	DefaultParams            = types.DefaultParams
-	GetAuctionByTimeKey      = types.GetAuctionByTimeKey
-	GetAuctionKey            = types.GetAuctionKey
-	NewAuctionWithPhase      = types.NewAuctionWithPhase
-	NewCollateralAuction     = types.NewCollateralAuction
-	NewDebtAuction           = types.NewDebtAuction
-	NewGenesisState          = types.NewGenesisState
-	NewMsgPlaceBid           = types.NewMsgPlaceBid
-	NewParams                = types.NewParams
-	NewQueryAllAuctionParams = types.NewQueryAllAuctionParams
-	NewQueryAuctionParams    = types.NewQueryAuctionParams
-	NewSurplusAuction        = types.NewSurplusAuction
-	NewWeightedAddresses     = types.NewWeightedAddresses
-	ParamKeyTable            = types.ParamKeyTable
-	RegisterCodec            = types.RegisterCodec
-	Uint64FromBytes          = types.Uint64FromBytes
-	Uint64ToBytes            = types.Uint64ToBytes
+	ModuleAccountInvariants        = keeper.ModuleAccountInvariants
+	NewKeeper                      = keeper.NewKeeper
+	NewQuerier                     = keeper.NewQuerier
+	RegisterInvariants             = keeper.RegisterInvariants
+	ValidAuctionInvariant          = keeper.ValidAuctionInvariant
+	ValidIndexInvariant            = keeper.ValidIndexInvariant
+	DefaultGenesisState            = types.DefaultGenesisState
+	DefaultParams                  = types.DefaultParams
+	GetAuctionByBidderKey          = types.GetAuctionByBidderKey
+	GetAuctionByTimeKey            = types.GetAuctionByTimeKey
+	GetAuctionKey                  = types.GetAuctionKey
+	GetBidHistoryKey               = types.GetBidHistoryKey
+	NewAuctionBid                  = types.NewAuctionBid
+	NewAuctionWithPhase            = types.NewAuctionWithPhase
+	NewBidRecord                   = types.NewBidRecord
+	NewCollateralAuction           = types.NewCollateralAuction
+	NewCollateralAuctionParam      = types.NewCollateralAuctionParam
+	NewDebtAuction                 = types.NewDebtAuction
+	NewDutchCollateralAuction      = types.NewDutchCollateralAuction
+	NewGenesisState                = types.NewGenesisState
+	NewMsgPlaceBid                 = types.NewMsgPlaceBid
+	NewMsgPlaceBids                = types.NewMsgPlaceBids
+	NewParams                      = types.NewParams
+	NewQueryAllAuctionParams       = types.NewQueryAllAuctionParams
+	NewQueryAuctionParams          = types.NewQueryAuctionParams
+	NewQueryAuctionsByBidderParams = types.NewQueryAuctionsByBidderParams
+	NewReserveParam                = types.NewReserveParam
+	NewSurplusAuction              = types.NewSurplusAuction
+	NewWeightedAddresses           = types.NewWeightedAddresses
+	ParamKeyTable                  = types.ParamKeyTable
+	RegisterCodec                  = types.RegisterCodec
+	Uint64FromBytes                = types.Uint64FromBytes
+	Uint64ToBytes                  = types.Uint64ToBytes
 
 	// variable aliases
-	AuctionByTimeKeyPrefix     = types.AuctionByTimeKeyPrefix
-	AuctionKeyPrefix           = types.AuctionKeyPrefix
-	DefaultIncrement           = types.DefaultIncrement
-	DistantFuture              = types.DistantFuture
-	ErrAuctionHasExpired       = types.ErrAuctionHasExpired
-	ErrAuctionHasNotExpired    = types.ErrAuctionHasNotExpired
-	ErrAuctionNotFound         = types.ErrAuctionNotFound
-	ErrBidTooLarge             = types.ErrBidTooLarge
-	ErrBidTooSmall             = types.ErrBidTooSmall
-	ErrInvalidBidDenom         = types.ErrInvalidBidDenom
-	ErrInvalidInitialAuctionID = types.ErrInvalidInitialAuctionID
-	ErrInvalidLotDenom         = types.ErrInvalidLotDenom
-	ErrLotTooLarge             = types.ErrLotTooLarge
-	ErrLotTooSmall             = types.ErrLotTooSmall
-	ErrUnrecognizedAuctionType = types.ErrUnrecognizedAuctionType
-	KeyBidDuration             = types.KeyBidDuration
-	KeyIncrementCollateral     = types.KeyIncrementCollateral
-	KeyIncrementDebt           = types.KeyIncrementDebt
-	KeyIncrementSurplus        = types.KeyIncrementSurplus
-	KeyMaxAuctionDuration      = types.KeyMaxAuctionDuration
-	ModuleCdc                  = types.ModuleCdc
-	NextAuctionIDKey           = types.NextAuctionIDKey
+	AuctionByBidderKeyPrefix       = types.AuctionByBidderKeyPrefix
+	AuctionByTimeKeyPrefix         = types.AuctionByTimeKeyPrefix
+	AuctionKeyPrefix               = types.AuctionKeyPrefix
+	BidHistoryKeyPrefix            = types.BidHistoryKeyPrefix
+	DefaultCollateralAuctionParams = types.DefaultCollateralAuctionParams
+	DefaultDutchDecayRate          = types.DefaultDutchDecayRate
+	DefaultDutchStartPriceBuffer   = types.DefaultDutchStartPriceBuffer
+	DefaultIncrement               = types.DefaultIncrement
+	DefaultReserveParams           = types.DefaultReserveParams
+	DistantFuture                  = types.DistantFuture
+	ErrAuctionHasExpired           = types.ErrAuctionHasExpired
+	ErrAuctionHasNotExpired        = types.ErrAuctionHasNotExpired
+	ErrAuctionNotFound             = types.ErrAuctionNotFound
+	ErrBelowReservePrice           = types.ErrBelowReservePrice
+	ErrBidTooLarge                 = types.ErrBidTooLarge
+	ErrBidTooSmall                 = types.ErrBidTooSmall
+	ErrInvalidBidDenom             = types.ErrInvalidBidDenom
+	ErrInvalidInitialAuctionID     = types.ErrInvalidInitialAuctionID
+	ErrInvalidLotDenom             = types.ErrInvalidLotDenom
+	ErrLotTooLarge                 = types.ErrLotTooLarge
+	ErrLotTooSmall                 = types.ErrLotTooSmall
+	ErrUnrecognizedAuctionType     = types.ErrUnrecognizedAuctionType
+	KeyBidDuration                 = types.KeyBidDuration
+	KeyCollateralAuctionParams     = types.KeyCollateralAuctionParams
+	KeyDutchAuctionDuration        = types.KeyDutchAuctionDuration
+	KeyDutchDecayCurve             = types.KeyDutchDecayCurve
+	KeyDutchDecayRate              = types.KeyDutchDecayRate
+	KeyDutchStartPriceBuffer       = types.KeyDutchStartPriceBuffer
+	KeyIncrementCollateral         = types.KeyIncrementCollateral
+	KeyIncrementDebt               = types.KeyIncrementDebt
+	KeyIncrementSurplus            = types.KeyIncrementSurplus
+	KeyMaxAuctionDuration          = types.KeyMaxAuctionDuration
+	KeyReserveParams               = types.KeyReserveParams
+	ModuleCdc                      = types.ModuleCdc
+	NextAuctionIDKey               = types.NextAuctionIDKey
 )
 
 type (
-	Keeper                = keeper.Keeper
-	Auction               = types.Auction
-	AuctionWithPhase      = types.AuctionWithPhase
-	Auctions              = types.Auctions
-	BaseAuction           = types.BaseAuction
-	CollateralAuction     = types.CollateralAuction
-	DebtAuction           = types.DebtAuction
-	GenesisAuction        = types.GenesisAuction
-	GenesisAuctions       = types.GenesisAuctions
-	GenesisState          = types.GenesisState
-	MsgPlaceBid           = types.MsgPlaceBid
-	Params                = types.Params
-	QueryAllAuctionParams = types.QueryAllAuctionParams
-	QueryAuctionParams    = types.QueryAuctionParams
-	SupplyKeeper          = types.SupplyKeeper
-	SurplusAuction        = types.SurplusAuction
-	WeightedAddresses     = types.WeightedAddresses
+	Keeper                      = keeper.Keeper
+	Auction                     = types.Auction
+	AuctionBid                  = types.AuctionBid
+	AuctionBids                 = types.AuctionBids
+	AuctionWithPhase            = types.AuctionWithPhase
+	Auctions                    = types.Auctions
+	BaseAuction                 = types.BaseAuction
+	BidRecord                   = types.BidRecord
+	BidRecords                  = types.BidRecords
+	CollateralAuction           = types.CollateralAuction
+	CollateralAuctionParam      = types.CollateralAuctionParam
+	CollateralAuctionParams     = types.CollateralAuctionParams
+	DebtAuction                 = types.DebtAuction
+	DutchCollateralAuction      = types.DutchCollateralAuction
+	GenesisAuction              = types.GenesisAuction
+	GenesisAuctions             = types.GenesisAuctions
+	GenesisState                = types.GenesisState
+	MsgPlaceBid                 = types.MsgPlaceBid
+	MsgPlaceBids                = types.MsgPlaceBids
+	Params                      = types.Params
+	PricefeedKeeper             = types.PricefeedKeeper
+	QueryAllAuctionParams       = types.QueryAllAuctionParams
+	QueryAuctionParams          = types.QueryAuctionParams
+	QueryAuctionsByBidderParams = types.QueryAuctionsByBidderParams
+	ReserveParam                = types.ReserveParam
+	ReserveParams               = types.ReserveParams
+	SupplyKeeper                = types.SupplyKeeper
+	SurplusAuction              = types.SurplusAuction
+	WeightedAddresses           = types.WeightedAddresses
 )
@@ -37,6 +37,8 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		QueryGetAuctionCmd(queryRoute, cdc),
 		QueryGetAuctionsCmd(queryRoute, cdc),
 		QueryParamsCmd(queryRoute, cdc),
+		QueryBidHistoryCmd(queryRoute, cdc),
+		QueryAuctionsByBidderCmd(queryRoute, cdc),
 	)...)
 
 	return auctionQueryCmd
@@ -77,10 +79,10 @@ func QueryGetAuctionsCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		Short: "query auctions with optional filters",
 		Long: strings.TrimSpace(`Query for all paginated auctions that match optional filters:
 Example:
-$ kvcli q auction auctions --type=(collateral|surplus|debt)
+$ kvcli q auction auctions --type=(collateral|dutch_collateral|surplus|debt)
 $ kvcli q auction auctions --owner=kava1hatdq32u5x4wnxrtv5wzjzmq49sxgjgsj0mffm
 $ kvcli q auction auctions --denom=bnb
-$ kvcli q auction auctions --phase=(forward|reverse)
+$ kvcli q auction auctions --phase=(forward|reverse|dutch)
 $ kvcli q auction auctions --page=2 --limit=100
 `,
 		),
@@ -104,6 +106,7 @@ $ kvcli q auction auctions --page=2 --limit=100
 			if len(strType) != 0 {
 				auctionType = strings.ToLower(strings.TrimSpace(strType))
 				if auctionType != types.CollateralAuctionType &&
+					auctionType != types.DutchCollateralAuctionType &&
 					auctionType != types.SurplusAuctionType &&
 					auctionType != types.DebtAuctionType {
 					return fmt.Errorf("invalid auction type %s", strType)
@@ -112,7 +115,7 @@ $ kvcli q auction auctions --page=2 --limit=100
 			}
 
 			if len(auctionOwner) != 0 {
-				if auctionType != types.CollateralAuctionType {
+				if auctionType != types.CollateralAuctionType && auctionType != types.DutchCollateralAuctionType {
 					return fmt.Errorf("cannot apply owner flag to non-collateral auction type")
 				}
 				auctionOwnerStr := strings.ToLower(strings.TrimSpace(strOwner))
@@ -134,10 +137,10 @@ $ kvcli q auction auctions --page=2 --limit=100
 
 			if len(strPhase) != 0 {
 				auctionPhase := strings.ToLower(strings.TrimSpace(strPhase))
-				if auctionType != types.CollateralAuctionType && len(auctionType) > 0 {
+				if auctionType != types.CollateralAuctionType && auctionType != types.DutchCollateralAuctionType && len(auctionType) > 0 {
 					return fmt.Errorf("cannot apply phase flag to non-collateral auction type")
 				}
-				if auctionPhase != types.ForwardAuctionPhase && auctionPhase != types.ReverseAuctionPhase {
+				if auctionPhase != types.ForwardAuctionPhase && auctionPhase != types.ReverseAuctionPhase && auctionPhase != types.DutchAuctionPhase {
 					return fmt.Errorf("invalid auction phase %s", strPhase)
 				}
 				params.Phase = auctionPhase
@@ -175,10 +178,10 @@ $ kvcli q auction auctions --page=2 --limit=100
 
 	cmd.Flags().Int(flags.FlagPage, 1, "pagination page of auctions to to query for")
 	cmd.Flags().Int(flags.FlagLimit, 100, "pagination limit of auctions to query for")
-	cmd.Flags().String(flagType, "", "(optional) filter by auction type, type: collateral, debt, surplus")
+	cmd.Flags().String(flagType, "", "(optional) filter by auction type, type: collateral, dutch_collateral, debt, surplus")
 	cmd.Flags().String(flagOwner, "", "(optional) filter by collateral auction owner")
 	cmd.Flags().String(flagDenom, "", "(optional) filter by auction denom")
-	cmd.Flags().String(flagPhase, "", "(optional) filter by collateral auction phase, phase: forward/reverse")
+	cmd.Flags().String(flagPhase, "", "(optional) filter by collateral auction phase, phase: forward/reverse/dutch")
 
 	return cmd
 }
@@ -208,3 +211,95 @@ func QueryParamsCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		},
 	}
 }
+
+// QueryBidHistoryCmd queries the bids placed on an open auction
+func QueryBidHistoryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "bid-history [auction-id]",
+		Short: "get the bids placed on an auction",
+		Long: strings.TrimSpace(`Get the bids placed on an open auction, in the order they were placed. Bid history is removed when an auction closes.
+Example:
+$ kvcli q auction bid-history 34
+`,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// Prepare params for querier
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("auction-id '%s' not a valid uint", args[0])
+			}
+			bz, err := cdc.MarshalJSON(types.NewQueryAuctionParams(id))
+			if err != nil {
+				return err
+			}
+
+			// Query
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryGetBidHistory)
+			res, height, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			// Decode and print results
+			var out types.BidRecords
+			cdc.MustUnmarshalJSON(res, &out)
+			cliCtx = cliCtx.WithHeight(height)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// QueryAuctionsByBidderCmd queries the auctions a bidder is currently winning
+func QueryAuctionsByBidderCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bidder-auctions [bidder-address]",
+		Short: "get the auctions a bidder is currently winning",
+		Long: strings.TrimSpace(`Get the open auctions where the bidder placed the latest bid.
+Example:
+$ kvcli q auction bidder-auctions kava1hatdq32u5x4wnxrtv5wzjzmq49sxgjgsj0mffm
+`,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// Prepare params for querier
+			bidder, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			page := viper.GetInt(flags.FlagPage)
+			limit := viper.GetInt(flags.FlagLimit)
+			bz, err := cdc.MarshalJSON(types.NewQueryAuctionsByBidderParams(page, limit, bidder))
+			if err != nil {
+				return err
+			}
+
+			// Query
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryGetAuctionsByBidder)
+			res, height, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			// Decode and print results
+			var auctions types.Auctions
+			cdc.MustUnmarshalJSON(res, &auctions)
+
+			auctionsWithPhase := []types.AuctionWithPhase{} // using empty slice so json returns [] instead of null when there's no auctions
+			for _, a := range auctions {
+				auctionsWithPhase = append(auctionsWithPhase, types.NewAuctionWithPhase(a))
+			}
+			cliCtx = cliCtx.WithHeight(height)
+			return cliCtx.PrintOutput(auctionsWithPhase)
+		},
+	}
+
+	cmd.Flags().Int(flags.FlagPage, 1, "pagination page of auctions to to query for")
+	cmd.Flags().Int(flags.FlagLimit, 100, "pagination limit of auctions to query for")
+
+	return cmd
+}
@@ -28,6 +28,7 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 
 	auctionTxCmd.AddCommand(flags.PostCommands(
 		GetCmdPlaceBid(cdc),
+		GetCmdPlaceBids(cdc),
 	)...)
 
 	return auctionTxCmd
@@ -69,3 +70,49 @@ $ %s tx %s bid 34 1000usdx --from myKeyName
 		},
 	}
 }
+
+// GetCmdPlaceBids cli command for placing bids on several auctions in one transaction
+func GetCmdPlaceBids(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "bids [auction-id:amount]...",
+		Short: "place bids on several auctions",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Place bids on several auctions at once, each given as an auction id and amount separated by a colon. Bids are placed in order, and if any bid fails none are placed.
+
+Example:
+$ %s tx %s bids 34:1000usdx 35:1000usdx 36:500usdx --from myKeyName
+`, version.ClientName, types.ModuleName)),
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			var bids types.AuctionBids
+			for _, arg := range args {
+				parts := strings.Split(arg, ":")
+				if len(parts) != 2 {
+					return fmt.Errorf("bid '%s' not in the form auction-id:amount", arg)
+				}
+
+				id, err := strconv.ParseUint(parts[0], 10, 64)
+				if err != nil {
+					return fmt.Errorf("auction-id '%s' not a valid uint", parts[0])
+				}
+
+				amt, err := sdk.ParseCoin(parts[1])
+				if err != nil {
+					return err
+				}
+				bids = append(bids, types.NewAuctionBid(id, amt))
+			}
+
+			msg := types.NewMsgPlaceBids(cliCtx.GetFromAddress(), bids)
+			err := msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
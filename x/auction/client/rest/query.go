@@ -15,11 +15,16 @@ import (
 	"github.com/kava-labs/kava/x/auction/types"
 )
 
-const restAuctionID = "auction-id"
+const (
+	restAuctionID = "auction-id"
+	restBidder    = "bidder"
+)
 
 func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	r.HandleFunc(fmt.Sprintf("/%s/auctions", types.ModuleName), queryAuctionsHandlerFn(cliCtx)).Methods("GET")
 	r.HandleFunc(fmt.Sprintf("/%s/auctions/{%s}", types.ModuleName, restAuctionID), queryAuctionHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/auctions/{%s}/bids", types.ModuleName, restAuctionID), queryBidHistoryHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/bidders/{%s}/auctions", types.ModuleName, restBidder), queryAuctionsByBidderHandlerFn(cliCtx)).Methods("GET")
 	r.HandleFunc(fmt.Sprintf("/%s/parameters", types.ModuleName), getParamsHandlerFn(cliCtx)).Methods("GET")
 }
 
@@ -76,6 +81,7 @@ func queryAuctionsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 		if x := r.URL.Query().Get(RestType); len(x) != 0 {
 			auctionType = strings.ToLower(strings.TrimSpace(x))
 			if auctionType != types.CollateralAuctionType &&
+				auctionType != types.DutchCollateralAuctionType &&
 				auctionType != types.SurplusAuctionType &&
 				auctionType != types.DebtAuctionType {
 				rest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("invalid auction type %s", x))
@@ -84,7 +90,7 @@ func queryAuctionsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 		}
 
 		if x := r.URL.Query().Get(RestOwner); len(x) != 0 {
-			if auctionType != types.CollateralAuctionType {
+			if auctionType != types.CollateralAuctionType && auctionType != types.DutchCollateralAuctionType {
 				rest.WriteErrorResponse(w, http.StatusBadRequest, "cannot apply owner flag to non-collateral auction type")
 			}
 			auctionOwnerStr := strings.ToLower(strings.TrimSpace(x))
@@ -105,11 +111,11 @@ func queryAuctionsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 
 		if x := r.URL.Query().Get(RestPhase); len(x) != 0 {
 			auctionPhase = strings.ToLower(strings.TrimSpace(x))
-			if auctionType != types.CollateralAuctionType && len(auctionType) > 0 {
+			if auctionType != types.CollateralAuctionType && auctionType != types.DutchCollateralAuctionType && len(auctionType) > 0 {
 				rest.WriteErrorResponse(w, http.StatusBadRequest, "cannot apply phase flag to non-collateral auction type")
 				return
 			}
-			if auctionPhase != types.ForwardAuctionPhase && auctionPhase != types.ReverseAuctionPhase {
+			if auctionPhase != types.ForwardAuctionPhase && auctionPhase != types.ReverseAuctionPhase && auctionPhase != types.DutchAuctionPhase {
 				rest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("invalid auction phase %s", x))
 				return
 			}
@@ -165,3 +171,85 @@ func getParamsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 		rest.PostProcessResponse(w, cliCtx, res)
 	}
 }
+
+func queryBidHistoryHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		// Parse the query height
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		// Prepare params for querier
+		auctionID, ok := rest.ParseUint64OrReturnBadRequest(w, mux.Vars(r)[restAuctionID])
+		if !ok {
+			return
+		}
+		bz, err := cliCtx.Codec.MarshalJSON(types.NewQueryAuctionParams(auctionID))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", types.ModuleName, types.QueryGetBidHistory)
+		res, height, err := cliCtx.QueryWithData(route, bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		// Decode and return results
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func queryAuctionsByBidderHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		_, page, limit, err := rest.ParseHTTPArgsWithLimit(r, 0)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// Parse the query height
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		// Prepare params for querier
+		bidder, err := sdk.AccAddressFromBech32(mux.Vars(r)[restBidder])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		bz, err := cliCtx.Codec.MarshalJSON(types.NewQueryAuctionsByBidderParams(page, limit, bidder))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", types.ModuleName, types.QueryGetAuctionsByBidder)
+		res, height, err := cliCtx.QueryWithData(route, bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+
+		// Unmarshal to Auction and remarshal as AuctionWithPhase
+		var auctions types.Auctions
+		err = cliCtx.Codec.UnmarshalJSON(res, &auctions)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		auctionsWithPhase := []types.AuctionWithPhase{} // using empty slice so json returns [] instead of null when there's no auctions
+		for _, a := range auctions {
+			auctionsWithPhase = append(auctionsWithPhase, types.NewAuctionWithPhase(a))
+		}
+		rest.PostProcessResponse(w, cliCtx, cliCtx.Codec.MustMarshalJSON(auctionsWithPhase))
+	}
+}
@@ -6,6 +6,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/context"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/rest"
+
+	"github.com/kava-labs/kava/x/auction/types"
 )
 
 // REST Variable names
@@ -28,3 +30,9 @@ type placeBidReq struct {
 	BaseReq rest.BaseReq `json:"base_req"`
 	Amount  sdk.Coin     `json:"amount"`
 }
+
+// placeBidsReq defines the properties of a batch bid request's body
+type placeBidsReq struct {
+	BaseReq rest.BaseReq      `json:"base_req"`
+	Bids    types.AuctionBids `json:"bids"`
+}
@@ -130,6 +130,59 @@ func (k Keeper) StartCollateralAuction(
 	return auctionID, nil
 }
 
+// StartDutchCollateralAuction starts a new dutch (descending price) collateral auction.
+// The price of the lot starts at the market price, in units of the bid denom, multiplied by the start price buffer.
+func (k Keeper) StartDutchCollateralAuction(
+	ctx sdk.Context, seller string, lot, maxBid sdk.Coin,
+	lotReturnAddrs []sdk.AccAddress, lotReturnWeights []sdk.Int, debt sdk.Coin, marketPrice sdk.Dec,
+) (uint64, error) {
+	weightedAddresses, err := types.NewWeightedAddresses(lotReturnAddrs, lotReturnWeights)
+	if err != nil {
+		return 0, err
+	}
+	params := k.GetParams(ctx)
+	auction := types.NewDutchCollateralAuction(
+		seller,
+		lot,
+		ctx.BlockTime(),
+		ctx.BlockTime().Add(params.DutchAuctionDuration),
+		maxBid,
+		weightedAddresses,
+		debt,
+		marketPrice.Mul(params.DutchStartPriceBuffer),
+		params.DutchDecayCurve,
+		params.DutchDecayRate,
+	)
+
+	// NOTE: for the duration of the auction the auction module account holds the debt and the lot
+	err = k.supplyKeeper.SendCoinsFromModuleToModule(ctx, seller, types.ModuleName, sdk.NewCoins(lot))
+	if err != nil {
+		return 0, err
+	}
+	err = k.supplyKeeper.SendCoinsFromModuleToModule(ctx, seller, types.ModuleName, sdk.NewCoins(debt))
+	if err != nil {
+		return 0, err
+	}
+
+	auctionID, err := k.StoreNewAuction(ctx, auction)
+	if err != nil {
+		return 0, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAuctionStart,
+			sdk.NewAttribute(types.AttributeKeyAuctionID, fmt.Sprintf("%d", auctionID)),
+			sdk.NewAttribute(types.AttributeKeyAuctionType, auction.GetType()),
+			sdk.NewAttribute(types.AttributeKeyBid, auction.Bid.String()),
+			sdk.NewAttribute(types.AttributeKeyLot, auction.Lot.String()),
+			sdk.NewAttribute(types.AttributeKeyMaxBid, auction.MaxBid.String()),
+			sdk.NewAttribute(types.AttributeKeyPrice, auction.StartPrice.String()),
+		),
+	)
+	return auctionID, nil
+}
+
 // PlaceBid places a bid on any auction.
 func (k Keeper) PlaceBid(ctx sdk.Context, auctionID uint64, bidder sdk.AccAddress, newAmount sdk.Coin) error {
 
@@ -159,6 +212,8 @@ func (k Keeper) PlaceBid(ctx sdk.Context, auctionID uint64, bidder sdk.AccAddres
 		} else {
 			updatedAuction, err = k.PlaceReverseBidCollateral(ctx, auctionType, bidder, newAmount)
 		}
+	case types.DutchCollateralAuction:
+		updatedAuction, err = k.PlaceBidDutchCollateral(ctx, auctionType, bidder, newAmount)
 	default:
 		err = sdkerrors.Wrap(types.ErrUnrecognizedAuctionType, auction.GetType())
 	}
@@ -168,10 +223,26 @@ func (k Keeper) PlaceBid(ctx sdk.Context, auctionID uint64, bidder sdk.AccAddres
 	}
 
 	k.SetAuction(ctx, updatedAuction)
+	k.AppendBidRecord(ctx, types.NewBidRecord(
+		auctionID, bidder, newAmount, updatedAuction.GetBid(), updatedAuction.GetLot(), ctx.BlockHeight(), ctx.BlockTime(),
+	))
 
 	return nil
 }
 
+// PlaceBids places bids on several auctions in order. If any bid fails no bids are placed.
+func (k Keeper) PlaceBids(ctx sdk.Context, bidder sdk.AccAddress, bids types.AuctionBids) error {
+	cacheCtx, write := ctx.CacheContext()
+	for _, bid := range bids {
+		err := k.PlaceBid(cacheCtx, bid.AuctionID, bidder, bid.Amount)
+		if err != nil {
+			return sdkerrors.Wrapf(err, "auction %d", bid.AuctionID)
+		}
+	}
+	write()
+	return nil
+}
+
 // PlaceBidSurplus places a forward bid on a surplus auction, moving coins and returning the updated auction.
 func (k Keeper) PlaceBidSurplus(ctx sdk.Context, auction types.SurplusAuction, bidder sdk.AccAddress, bid sdk.Coin) (types.SurplusAuction, error) {
 	// Validate new bid
@@ -234,6 +305,8 @@ func (k Keeper) PlaceBidSurplus(ctx sdk.Context, auction types.SurplusAuction, b
 
 // PlaceForwardBidCollateral places a forward bid on a collateral auction, moving coins and returning the updated auction.
 func (k Keeper) PlaceForwardBidCollateral(ctx sdk.Context, auction types.CollateralAuction, bidder sdk.AccAddress, bid sdk.Coin) (types.CollateralAuction, error) {
+	params := k.GetParams(ctx).GetCollateralAuctionParam(auction.Lot.Denom)
+
 	// Validate new bid
 	if bid.Denom != auction.Bid.Denom {
 		return auction, sdkerrors.Wrapf(types.ErrInvalidBidDenom, "%s ≠ %s", bid.Denom, auction.Bid.Denom)
@@ -244,7 +317,7 @@ func (k Keeper) PlaceForwardBidCollateral(ctx sdk.Context, auction types.Collate
 	minNewBidAmt := auction.Bid.Amount.Add( // new bids must be some % greater than old bid, and at least 1 larger to avoid replacing an old bid at no cost
 		sdk.MaxInt(
 			sdk.NewInt(1),
-			sdk.NewDecFromInt(auction.Bid.Amount).Mul(k.GetParams(ctx).IncrementCollateral).RoundInt(),
+			sdk.NewDecFromInt(auction.Bid.Amount).Mul(params.IncrementCollateral).RoundInt(),
 		),
 	)
 	minNewBidAmt = sdk.MinInt(minNewBidAmt, auction.MaxBid.Amount) // allow new bids to hit MaxBid even though it may be less than the increment %
@@ -290,10 +363,10 @@ func (k Keeper) PlaceForwardBidCollateral(ctx sdk.Context, auction types.Collate
 	auction.Bidder = bidder
 	auction.Bid = bid
 	if !auction.HasReceivedBids {
-		auction.MaxEndTime = ctx.BlockTime().Add(k.GetParams(ctx).MaxAuctionDuration) // set maximum ending time on receipt of first bid
+		auction.MaxEndTime = ctx.BlockTime().Add(params.MaxAuctionDuration) // set maximum ending time on receipt of first bid
 		auction.HasReceivedBids = true
 	}
-	auction.EndTime = earliestTime(ctx.BlockTime().Add(k.GetParams(ctx).BidDuration), auction.MaxEndTime) // increment timeout, up to MaxEndTime
+	auction.EndTime = earliestTime(ctx.BlockTime().Add(params.BidDuration), auction.MaxEndTime) // increment timeout, up to MaxEndTime
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -310,6 +383,8 @@ func (k Keeper) PlaceForwardBidCollateral(ctx sdk.Context, auction types.Collate
 
 // PlaceReverseBidCollateral places a reverse bid on a collateral auction, moving coins and returning the updated auction.
 func (k Keeper) PlaceReverseBidCollateral(ctx sdk.Context, auction types.CollateralAuction, bidder sdk.AccAddress, lot sdk.Coin) (types.CollateralAuction, error) {
+	params := k.GetParams(ctx).GetCollateralAuctionParam(auction.Lot.Denom)
+
 	// Validate new bid
 	if lot.Denom != auction.Lot.Denom {
 		return auction, sdkerrors.Wrapf(types.ErrInvalidLotDenom, lot.Denom, auction.Lot.Denom)
@@ -320,7 +395,7 @@ func (k Keeper) PlaceReverseBidCollateral(ctx sdk.Context, auction types.Collate
 	maxNewLotAmt := auction.Lot.Amount.Sub( // new lot must be some % less than old lot, and at least 1 smaller to avoid replacing an old bid at no cost
 		sdk.MaxInt(
 			sdk.NewInt(1),
-			sdk.NewDecFromInt(auction.Lot.Amount).Mul(k.GetParams(ctx).IncrementCollateral).RoundInt(),
+			sdk.NewDecFromInt(auction.Lot.Amount).Mul(params.IncrementCollateral).RoundInt(),
 		),
 	)
 	if lot.Amount.GT(maxNewLotAmt) {
@@ -364,10 +439,10 @@ func (k Keeper) PlaceReverseBidCollateral(ctx sdk.Context, auction types.Collate
 	auction.Bidder = bidder
 	auction.Lot = lot
 	if !auction.HasReceivedBids {
-		auction.MaxEndTime = ctx.BlockTime().Add(k.GetParams(ctx).MaxAuctionDuration) // set maximum ending time on receipt of first bid
+		auction.MaxEndTime = ctx.BlockTime().Add(params.MaxAuctionDuration) // set maximum ending time on receipt of first bid
 		auction.HasReceivedBids = true
 	}
-	auction.EndTime = earliestTime(ctx.BlockTime().Add(k.GetParams(ctx).BidDuration), auction.MaxEndTime) // increment timeout, up to MaxEndTime
+	auction.EndTime = earliestTime(ctx.BlockTime().Add(params.BidDuration), auction.MaxEndTime) // increment timeout, up to MaxEndTime
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -382,6 +457,93 @@ func (k Keeper) PlaceReverseBidCollateral(ctx sdk.Context, auction types.Collate
 	return auction, nil
 }
 
+// PlaceBidDutchCollateral buys some amount of lot from a dutch collateral auction at the current price, moving coins and returning the updated auction.
+// If the lot is worth more than the remaining bid the auction needs to raise, only the remaining bid is paid and only the lot it buys is sent.
+// The price does not decay below the reserve price of the lot, and no lot is sold while the reserve price is unavailable.
+// Once the auction has raised its max bid, or sold all its lot, it is set to close at the current block time.
+func (k Keeper) PlaceBidDutchCollateral(ctx sdk.Context, auction types.DutchCollateralAuction, bidder sdk.AccAddress, lot sdk.Coin) (types.DutchCollateralAuction, error) {
+	// Validate new bid
+	if lot.Denom != auction.Lot.Denom {
+		return auction, sdkerrors.Wrapf(types.ErrInvalidLotDenom, "%s ≠ %s", lot.Denom, auction.Lot.Denom)
+	}
+	if !lot.IsPositive() {
+		return auction, sdkerrors.Wrapf(types.ErrLotTooSmall, "%s ≤ 0%s", lot, auction.Lot.Denom)
+	}
+	if auction.Lot.IsLT(lot) {
+		return auction, sdkerrors.Wrapf(types.ErrLotTooLarge, "%s > %s", lot, auction.Lot)
+	}
+	price := auction.CurrentPrice(ctx.BlockTime())
+	if auction.IsComplete() || !price.IsPositive() {
+		return auction, sdkerrors.Wrapf(types.ErrAuctionHasExpired, "%d", auction.ID)
+	}
+	reservePrice, hasReserve, err := k.getReservePrice(ctx, auction.Lot.Denom)
+	if hasReserve {
+		if err != nil {
+			return auction, sdkerrors.Wrapf(err, "reserve price of %s is unavailable", auction.Lot.Denom)
+		}
+		price = sdk.MaxDec(price, reservePrice)
+	}
+
+	// Bidder pays for the lot at the current price, up to the remaining amount the auction needs to raise.
+	// Costs are rounded up and lots rounded down, so bids never receive more lot than they pay for.
+	remainingBid := auction.MaxBid.Sub(auction.Bid)
+	cost := sdk.NewCoin(auction.Bid.Denom, price.MulInt(lot.Amount).Ceil().TruncateInt())
+	if remainingBid.IsLT(cost) {
+		cost = remainingBid
+		lot = sdk.NewCoin(lot.Denom, sdk.MinInt(lot.Amount, cost.Amount.ToDec().Quo(price).TruncateInt()))
+		if !lot.IsPositive() {
+			return auction, sdkerrors.Wrapf(types.ErrLotTooSmall, "remaining bid %s buys less than 1%s", remainingBid, lot.Denom)
+		}
+	}
+	if hasReserve && !meetsReservePrice(cost, lot, reservePrice) {
+		return auction, sdkerrors.Wrapf(types.ErrBelowReservePrice, "%s for %s", cost, lot)
+	}
+	err = k.supplyKeeper.SendCoinsFromAccountToModule(ctx, bidder, auction.Initiator, sdk.NewCoins(cost))
+	if err != nil {
+		return auction, err
+	}
+	// Debt coins are sent to liquidator (until there is no CorrespondingDebt left). Amount sent is equal to cost (or whatever is left if < cost).
+	if auction.CorrespondingDebt.IsPositive() {
+
+		debtAmountToReturn := sdk.MinInt(cost.Amount, auction.CorrespondingDebt.Amount)
+		debtToReturn := sdk.NewCoin(auction.CorrespondingDebt.Denom, debtAmountToReturn)
+
+		err = k.supplyKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, auction.Initiator, sdk.NewCoins(debtToReturn))
+		if err != nil {
+			return auction, err
+		}
+		auction.CorrespondingDebt = auction.CorrespondingDebt.Sub(debtToReturn) // debtToReturn will always be ≤ auction.CorrespondingDebt from the MinInt above
+	}
+	// Bought lot is sent to the bidder immediately
+	err = k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, bidder, sdk.NewCoins(lot))
+	if err != nil {
+		return auction, err
+	}
+
+	// Update Auction
+	auction.Bidder = bidder
+	auction.Bid = auction.Bid.Add(cost)
+	auction.Lot = auction.Lot.Sub(lot)
+	auction.HasReceivedBids = true
+	if auction.IsComplete() {
+		auction.EndTime = ctx.BlockTime() // close the auction in the next begin blocker
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAuctionBid,
+			sdk.NewAttribute(types.AttributeKeyAuctionID, fmt.Sprintf("%d", auction.ID)),
+			sdk.NewAttribute(types.AttributeKeyBidder, auction.Bidder.String()),
+			sdk.NewAttribute(types.AttributeKeyBid, cost.String()),
+			sdk.NewAttribute(types.AttributeKeyLot, lot.String()),
+			sdk.NewAttribute(types.AttributeKeyPrice, price.String()),
+			sdk.NewAttribute(types.AttributeKeyEndTime, fmt.Sprintf("%d", auction.EndTime.Unix())),
+		),
+	)
+
+	return auction, nil
+}
+
 // PlaceBidDebt places a reverse bid on a debt auction, moving coins and returning the updated auction.
 func (k Keeper) PlaceBidDebt(ctx sdk.Context, auction types.DebtAuction, bidder sdk.AccAddress, lot sdk.Coin) (types.DebtAuction, error) {
 	// Validate new bid
@@ -467,7 +629,18 @@ func (k Keeper) CloseAuction(ctx sdk.Context, auctionID uint64) error {
 	case types.DebtAuction:
 		err = k.PayoutDebtAuction(ctx, auc)
 	case types.CollateralAuction:
+		// auctions that have not met their reserve price, or whose reserve price is unavailable, are extended rather than paid out
+		reservePrice, found, priceErr := k.getReservePrice(ctx, auc.Lot.Denom)
+		if found && (priceErr != nil || !meetsReservePrice(auc.Bid, auc.Lot, reservePrice)) {
+			if priceErr != nil {
+				reservePrice = sdk.ZeroDec()
+			}
+			k.extendCollateralAuction(ctx, auc, reservePrice)
+			return nil
+		}
 		err = k.PayoutCollateralAuction(ctx, auc)
+	case types.DutchCollateralAuction:
+		err = k.PayoutDutchCollateralAuction(ctx, auc)
 	default:
 		err = sdkerrors.Wrap(types.ErrUnrecognizedAuctionType, auc.GetType())
 	}
@@ -530,6 +703,80 @@ func (k Keeper) PayoutCollateralAuction(ctx sdk.Context, auction types.Collatera
 	return k.supplyKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, auction.Initiator, sdk.NewCoins(auction.CorrespondingDebt))
 }
 
+// extendCollateralAuction extends a collateral auction that has not met its reserve price by the bid duration, so it can receive new bids.
+// The reserve price is zero if the reserve market has no valid price.
+// Auctions are extended up to a reserve deadline, the max extension duration of their reserve param after they were first extended.
+// Once the deadline has passed the auction is restarted with a new deadline, so it is never paid out below its reserve price.
+func (k Keeper) extendCollateralAuction(ctx sdk.Context, auction types.CollateralAuction, reservePrice sdk.Dec) {
+	reserveParam, _ := k.GetParams(ctx).GetReserveParam(auction.Lot.Denom)
+	eventType := types.EventTypeAuctionExtend
+	if !ctx.BlockTime().Before(auction.ReserveDeadline) {
+		if !auction.ReserveDeadline.IsZero() {
+			eventType = types.EventTypeAuctionRestart
+		}
+		auction.ReserveDeadline = ctx.BlockTime().Add(reserveParam.MaxExtensionDuration)
+	}
+
+	params := k.GetParams(ctx).GetCollateralAuctionParam(auction.Lot.Denom)
+	auction.EndTime = earliestTime(ctx.BlockTime().Add(params.BidDuration), auction.ReserveDeadline)
+	if auction.MaxEndTime.Before(auction.EndTime) {
+		auction.MaxEndTime = auction.EndTime
+	}
+	k.SetAuction(ctx, auction)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(types.AttributeKeyAuctionID, fmt.Sprintf("%d", auction.ID)),
+			sdk.NewAttribute(types.AttributeKeyReservePrice, reservePrice.String()),
+			sdk.NewAttribute(types.AttributeKeyEndTime, fmt.Sprintf("%d", auction.EndTime.Unix())),
+		),
+	)
+}
+
+// getReservePrice returns the reserve price of a collateral auction lot, in base units of the bid denom per base unit of the lot denom.
+// It returns false if the lot denom has no reserve or there is no pricefeed keeper, and an error if the reserve market has no valid price.
+func (k Keeper) getReservePrice(ctx sdk.Context, lotDenom string) (sdk.Dec, bool, error) {
+	if k.pricefeedKeeper == nil {
+		return sdk.Dec{}, false, nil
+	}
+	reserveParam, found := k.GetParams(ctx).GetReserveParam(lotDenom)
+	if !found {
+		return sdk.Dec{}, false, nil
+	}
+	price, err := k.pricefeedKeeper.GetCurrentPrice(ctx, reserveParam.MarketID)
+	if err != nil {
+		return sdk.Dec{}, true, err
+	}
+	return reserveParam.ReservePrice(price.Price), true, nil
+}
+
+// PayoutDutchCollateralAuction returns any unsold lot of a dutch collateral auction to the lot returns addresses, and any remaining debt to the initiator.
+func (k Keeper) PayoutDutchCollateralAuction(ctx sdk.Context, auction types.DutchCollateralAuction) error {
+	if auction.Lot.IsPositive() {
+		lotPayouts, err := splitCoinIntoWeightedBuckets(auction.Lot, auction.LotReturns.Weights)
+		if err != nil {
+			return err
+		}
+		for i, payout := range lotPayouts {
+			if !payout.IsPositive() {
+				continue
+			}
+			err = k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, auction.LotReturns.Addresses[i], sdk.NewCoins(payout))
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	// if there is remaining debt after the auction, send it back to the initiating module for management
+	if !auction.CorrespondingDebt.IsPositive() {
+		return nil
+	}
+
+	return k.supplyKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, auction.Initiator, sdk.NewCoins(auction.CorrespondingDebt))
+}
+
 // CloseExpiredAuctions iterates over all the auctions stored by until the current
 // block timestamp and that are past (or at) their ending times and closes them,
 // paying out to the highest bidder.
@@ -566,3 +813,8 @@ func splitCoinIntoWeightedBuckets(coin sdk.Coin, buckets []sdk.Int) ([]sdk.Coin,
 	}
 	return result, nil
 }
+
+// meetsReservePrice returns true if a bid pays at least the reserve price for a lot
+func meetsReservePrice(bid, lot sdk.Coin, reservePrice sdk.Dec) bool {
+	return sdk.NewDecFromInt(bid.Amount).GTE(sdk.NewDecFromInt(lot.Amount).Mul(reservePrice))
+}
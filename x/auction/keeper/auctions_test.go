@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"errors"
 	"testing"
 	"time"
 
@@ -16,6 +17,7 @@ import (
 	"github.com/kava-labs/kava/app"
 	"github.com/kava-labs/kava/x/auction/types"
 	"github.com/kava-labs/kava/x/cdp"
+	"github.com/kava-labs/kava/x/pricefeed"
 )
 
 func TestSurplusAuctionBasic(t *testing.T) {
@@ -257,6 +259,336 @@ func TestCollateralAuctionDebtRemaining(t *testing.T) {
 	tApp.CheckBalance(t, ctx, sellerAddr, cs(c("token1", 80), c("token2", 110), c("debt", 100)))
 }
 
+func TestCollateralAuctionParamOverride(t *testing.T) {
+	// Setup
+	_, addrs := app.GeneratePrivKeyAddressPairs(4)
+	buyer := addrs[0]
+	returnAddrs := addrs[1:]
+	returnWeights := is(30, 20, 10)
+	sellerModName := cdp.LiquidatorMacc
+
+	tApp := app.NewTestApp()
+	sellerAcc := supply.NewEmptyModuleAccount(sellerModName)
+	require.NoError(t, sellerAcc.SetCoins(cs(c("token1", 100), c("token2", 100), c("debt", 100))))
+	tApp.InitializeFromGenesisStates(
+		NewAuthGenStateFromAccs(authexported.GenesisAccounts{
+			auth.NewBaseAccount(buyer, cs(c("token1", 100), c("token2", 100)), nil, 0, 0),
+			sellerAcc,
+		}),
+	)
+	ctx := tApp.NewContext(false, abci.Header{})
+	keeper := tApp.GetAuctionKeeper()
+
+	// Override the collateral auction params for the token1 lot denom
+	params := keeper.GetParams(ctx)
+	params.CollateralAuctionParams = types.CollateralAuctionParams{
+		types.NewCollateralAuctionParam("token1", d("0.5"), 10*time.Minute, time.Hour),
+	}
+	keeper.SetParams(ctx, params)
+
+	// Start auction
+	auctionID, err := keeper.StartCollateralAuction(ctx, sellerModName, c("token1", 20), c("token2", 50), returnAddrs, returnWeights, c("debt", 40))
+	require.NoError(t, err)
+
+	// Forward bids use the overridden increment and timings
+	require.NoError(t, keeper.PlaceBid(ctx, auctionID, buyer, c("token2", 10)))
+	require.True(t, errors.Is(keeper.PlaceBid(ctx, auctionID, buyer, c("token2", 14)), types.ErrBidTooSmall))
+	require.NoError(t, keeper.PlaceBid(ctx, auctionID, buyer, c("token2", 15)))
+	auction, found := keeper.GetAuction(ctx, auctionID)
+	require.True(t, found)
+	require.Equal(t, ctx.BlockTime().Add(10*time.Minute), auction.GetEndTime())
+	require.Equal(t, ctx.BlockTime().Add(time.Hour), auction.(types.CollateralAuction).MaxEndTime)
+
+	// Reverse bids use the overridden increment
+	require.NoError(t, keeper.PlaceBid(ctx, auctionID, buyer, c("token2", 50)))
+	require.True(t, errors.Is(keeper.PlaceBid(ctx, auctionID, buyer, c("token1", 11)), types.ErrLotTooLarge))
+	require.NoError(t, keeper.PlaceBid(ctx, auctionID, buyer, c("token1", 10)))
+}
+
+func TestCollateralAuctionReservePrice(t *testing.T) {
+	// Setup
+	_, addrs := app.GeneratePrivKeyAddressPairs(5)
+	buyer := addrs[0]
+	oracle := addrs[4]
+	returnAddrs := addrs[1:4]
+	returnWeights := is(30, 20, 10)
+	sellerModName := cdp.LiquidatorMacc
+
+	tApp := app.NewTestApp()
+	sellerAcc := supply.NewEmptyModuleAccount(sellerModName)
+	require.NoError(t, sellerAcc.SetCoins(cs(c("token1", 100), c("token2", 100), c("debt", 100))))
+	tApp.InitializeFromGenesisStates(
+		NewAuthGenStateFromAccs(authexported.GenesisAccounts{
+			auth.NewBaseAccount(buyer, cs(c("token1", 100), c("token2", 200)), nil, 0, 0),
+			sellerAcc,
+		}),
+	)
+	ctx := tApp.NewContext(false, abci.Header{})
+	keeper := tApp.GetAuctionKeeper()
+	pricefeedKeeper := tApp.GetPriceFeedKeeper()
+	setPrice := func(price sdk.Dec) {
+		_, err := pricefeedKeeper.SetPrice(ctx, oracle, "token1:token2", price, ctx.BlockTime().Add(24*time.Hour))
+		require.NoError(t, err)
+		require.NoError(t, pricefeedKeeper.SetCurrentPrices(ctx, "token1:token2"))
+	}
+
+	// Lots of token1 cannot be sold below half the market price
+	pricefeedKeeper.SetParams(ctx, pricefeed.NewParams(pricefeed.Markets{
+		pricefeed.NewMarket("token1:token2", "token1", "token2", []sdk.AccAddress{oracle}, true),
+	}, pricefeed.DefaultPriceHistoryLength, pricefeed.DefaultMissedWindowsThreshold, pricefeed.DefaultDeviationThreshold))
+	setPrice(d("2.0"))
+	params := keeper.GetParams(ctx)
+	params.ReserveParams = types.ReserveParams{
+		types.NewReserveParam("token1", "token1:token2", d("0.5"), sdk.ZeroInt(), sdk.ZeroInt(), 4*time.Hour),
+	}
+	keeper.SetParams(ctx, params)
+
+	// A forward phase auction below the reserve price is extended instead of closed
+	auctionID, err := keeper.StartCollateralAuction(ctx, sellerModName, c("token1", 20), c("token2", 50), returnAddrs, returnWeights, c("debt", 40))
+	require.NoError(t, err)
+	require.NoError(t, keeper.PlaceBid(ctx, auctionID, buyer, c("token2", 10)))
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(types.DefaultBidDuration))
+	require.NoError(t, keeper.CloseAuction(ctx, auctionID))
+	auction, found := keeper.GetAuction(ctx, auctionID)
+	require.True(t, found)
+	require.Equal(t, ctx.BlockTime().Add(types.DefaultBidDuration), auction.GetEndTime())
+	tApp.CheckBalance(t, ctx, buyer, cs(c("token1", 100), c("token2", 190)))
+
+	// Once the reserve price is met the auction closes
+	require.NoError(t, keeper.PlaceBid(ctx, auctionID, buyer, c("token2", 20)))
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(types.DefaultBidDuration))
+	require.NoError(t, keeper.CloseAuction(ctx, auctionID))
+	_, found = keeper.GetAuction(ctx, auctionID)
+	require.False(t, found)
+	tApp.CheckBalance(t, ctx, buyer, cs(c("token1", 120), c("token2", 180)))
+
+	// Reverse phase bids below the reserve price are accepted, but the auction is extended until the reserve price is met
+	auctionID, err = keeper.StartCollateralAuction(ctx, sellerModName, c("token1", 20), c("token2", 50), returnAddrs, returnWeights, c("debt", 40))
+	require.NoError(t, err)
+	require.NoError(t, keeper.PlaceBid(ctx, auctionID, buyer, c("token2", 50)))
+	setPrice(d("6.0"))
+	require.NoError(t, keeper.PlaceBid(ctx, auctionID, buyer, c("token1", 17)))
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(types.DefaultBidDuration))
+	require.NoError(t, keeper.CloseAuction(ctx, auctionID))
+	auction, found = keeper.GetAuction(ctx, auctionID)
+	require.True(t, found)
+	require.Equal(t, ctx.BlockTime().Add(types.DefaultBidDuration), auction.GetEndTime())
+	require.NoError(t, keeper.PlaceBid(ctx, auctionID, buyer, c("token1", 16)))
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(types.DefaultBidDuration))
+	require.NoError(t, keeper.CloseAuction(ctx, auctionID))
+	_, found = keeper.GetAuction(ctx, auctionID)
+	require.False(t, found)
+	tApp.CheckBalance(t, ctx, buyer, cs(c("token1", 136), c("token2", 130)))
+
+	// Auctions with a reserve are paused while the reserve market has no valid price
+	setPrice(d("2.0"))
+	auctionID, err = keeper.StartCollateralAuction(ctx, sellerModName, c("token1", 20), c("token2", 50), returnAddrs, returnWeights, c("debt", 40))
+	require.NoError(t, err)
+	require.NoError(t, keeper.PlaceBid(ctx, auctionID, buyer, c("token2", 30)))
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(25 * time.Hour))
+	require.True(t, errors.Is(pricefeedKeeper.SetCurrentPrices(ctx, "token1:token2"), pricefeed.ErrNoValidPrice))
+	require.NoError(t, keeper.CloseAuction(ctx, auctionID))
+	auction, found = keeper.GetAuction(ctx, auctionID)
+	require.True(t, found)
+	require.Equal(t, ctx.BlockTime().Add(types.DefaultBidDuration), auction.GetEndTime())
+	require.NoError(t, keeper.PlaceBid(ctx, auctionID, buyer, c("token2", 50)))
+	require.NoError(t, keeper.PlaceBid(ctx, auctionID, buyer, c("token1", 15)))
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(types.DefaultBidDuration))
+	require.NoError(t, keeper.CloseAuction(ctx, auctionID))
+	auction, found = keeper.GetAuction(ctx, auctionID)
+	require.True(t, found)
+
+	// Once the max extension duration has passed, an auction whose reserve market has no valid price is restarted instead of paid out
+	require.Equal(t, auction.(types.CollateralAuction).ReserveDeadline, ctx.BlockTime().Add(3*time.Hour))
+	ctx = ctx.WithBlockTime(auction.(types.CollateralAuction).ReserveDeadline).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, keeper.CloseAuction(ctx, auctionID))
+	auction, found = keeper.GetAuction(ctx, auctionID)
+	require.True(t, found)
+	require.Equal(t, ctx.BlockTime().Add(4*time.Hour), auction.(types.CollateralAuction).ReserveDeadline)
+	require.Equal(t, ctx.BlockTime().Add(types.DefaultBidDuration), auction.GetEndTime())
+	require.Equal(t, types.EventTypeAuctionRestart, ctx.EventManager().Events()[0].Type)
+	tApp.CheckBalance(t, ctx, buyer, cs(c("token1", 136), c("token2", 80)))
+
+	// An auction still below the reserve price at its deadline is restarted instead of paid out
+	setPrice(d("10.0"))
+	ctx = ctx.WithBlockTime(auction.(types.CollateralAuction).ReserveDeadline).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, keeper.CloseAuction(ctx, auctionID))
+	auction, found = keeper.GetAuction(ctx, auctionID)
+	require.True(t, found)
+	require.Equal(t, ctx.BlockTime().Add(4*time.Hour), auction.(types.CollateralAuction).ReserveDeadline)
+	require.Equal(t, types.EventTypeAuctionRestart, ctx.EventManager().Events()[0].Type)
+	tApp.CheckBalance(t, ctx, buyer, cs(c("token1", 136), c("token2", 80)))
+
+	// The restarted auction is paid out once the reserve price is met
+	setPrice(d("2.0"))
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(types.DefaultBidDuration))
+	require.NoError(t, keeper.CloseAuction(ctx, auctionID))
+	_, found = keeper.GetAuction(ctx, auctionID)
+	require.False(t, found)
+	tApp.CheckBalance(t, ctx, buyer, cs(c("token1", 151), c("token2", 80)))
+}
+
+func TestDutchCollateralAuctionBasic(t *testing.T) {
+	// Setup
+	_, addrs := app.GeneratePrivKeyAddressPairs(4)
+	buyer := addrs[0]
+	returnAddrs := addrs[1:]
+	returnWeights := is(30, 20, 10)
+	sellerModName := cdp.LiquidatorMacc
+	sellerAddr := supply.NewModuleAddress(sellerModName)
+
+	tApp := app.NewTestApp()
+	sellerAcc := supply.NewEmptyModuleAccount(sellerModName)
+	require.NoError(t, sellerAcc.SetCoins(cs(c("token1", 100), c("token2", 100), c("debt", 100))))
+	tApp.InitializeFromGenesisStates(
+		NewAuthGenStateFromAccs(authexported.GenesisAccounts{
+			auth.NewBaseAccount(buyer, cs(c("token1", 100), c("token2", 100)), nil, 0, 0),
+			auth.NewBaseAccount(returnAddrs[0], cs(c("token1", 100), c("token2", 100)), nil, 0, 0),
+			auth.NewBaseAccount(returnAddrs[1], cs(c("token1", 100), c("token2", 100)), nil, 0, 0),
+			auth.NewBaseAccount(returnAddrs[2], cs(c("token1", 100), c("token2", 100)), nil, 0, 0),
+			sellerAcc,
+		}),
+	)
+	startTime := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := tApp.NewContext(false, abci.Header{Time: startTime})
+	keeper := tApp.GetAuctionKeeper()
+
+	// Start auction at a price of 1.2 * 2 token2 per token1, decaying linearly to zero over the dutch auction duration
+	auctionID, err := keeper.StartDutchCollateralAuction(ctx, sellerModName, c("token1", 20), c("token2", 20), returnAddrs, returnWeights, c("debt", 40), d("2"))
+	require.NoError(t, err)
+	// Check seller's coins have decreased
+	tApp.CheckBalance(t, ctx, sellerAddr, cs(c("token1", 80), c("token2", 100), c("debt", 60)))
+
+	// Buy half the lot half way through the auction, at a price of 1.2
+	ctx = ctx.WithBlockTime(startTime.Add(types.DefaultDutchAuctionDuration / 2))
+	require.NoError(t, keeper.PlaceBid(ctx, auctionID, buyer, c("token1", 10)))
+	// Check bidder has paid and received the lot
+	tApp.CheckBalance(t, ctx, buyer, cs(c("token1", 110), c("token2", 88)))
+	// Check seller has received the payment and an equal amount of debt
+	tApp.CheckBalance(t, ctx, sellerAddr, cs(c("token1", 80), c("token2", 112), c("debt", 72)))
+
+	// Buying more than the remaining lot fails
+	require.Error(t, keeper.PlaceBid(ctx, auctionID, buyer, c("token1", 11)))
+
+	// Buy the rest of the lot, which is only charged up to the max bid, receiving the 6.67 token1 that buys rounded down
+	require.NoError(t, keeper.PlaceBid(ctx, auctionID, buyer, c("token1", 10)))
+	tApp.CheckBalance(t, ctx, buyer, cs(c("token1", 116), c("token2", 80)))
+	tApp.CheckBalance(t, ctx, sellerAddr, cs(c("token1", 80), c("token2", 120), c("debt", 80)))
+
+	// The auction has raised its max bid so accepts no more bids
+	require.Error(t, keeper.PlaceBid(ctx, auctionID, buyer, c("token1", 1)))
+
+	// Close auction in the same block
+	require.NoError(t, keeper.CloseAuction(ctx, auctionID))
+	// Check return addresses have received the unsold lot
+	tApp.CheckBalance(t, ctx, returnAddrs[0], cs(c("token1", 102), c("token2", 100)))
+	tApp.CheckBalance(t, ctx, returnAddrs[1], cs(c("token1", 101), c("token2", 100)))
+	tApp.CheckBalance(t, ctx, returnAddrs[2], cs(c("token1", 101), c("token2", 100)))
+	// Check remaining debt is returned to the seller
+	tApp.CheckBalance(t, ctx, sellerAddr, cs(c("token1", 80), c("token2", 120), c("debt", 100)))
+}
+
+func TestDutchCollateralAuctionLotRemaining(t *testing.T) {
+	// Setup
+	_, addrs := app.GeneratePrivKeyAddressPairs(4)
+	buyer := addrs[0]
+	returnAddrs := addrs[1:]
+	returnWeights := is(30, 20, 10)
+	sellerModName := cdp.LiquidatorMacc
+	sellerAddr := supply.NewModuleAddress(sellerModName)
+
+	tApp := app.NewTestApp()
+	sellerAcc := supply.NewEmptyModuleAccount(sellerModName)
+	require.NoError(t, sellerAcc.SetCoins(cs(c("token1", 100), c("token2", 100), c("debt", 100))))
+	tApp.InitializeFromGenesisStates(
+		NewAuthGenStateFromAccs(authexported.GenesisAccounts{
+			auth.NewBaseAccount(buyer, cs(c("token1", 100), c("token2", 100)), nil, 0, 0),
+			auth.NewBaseAccount(returnAddrs[0], cs(c("token1", 100), c("token2", 100)), nil, 0, 0),
+			auth.NewBaseAccount(returnAddrs[1], cs(c("token1", 100), c("token2", 100)), nil, 0, 0),
+			auth.NewBaseAccount(returnAddrs[2], cs(c("token1", 100), c("token2", 100)), nil, 0, 0),
+			sellerAcc,
+		}),
+	)
+	startTime := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := tApp.NewContext(false, abci.Header{Time: startTime})
+	keeper := tApp.GetAuctionKeeper()
+
+	auctionID, err := keeper.StartDutchCollateralAuction(ctx, sellerModName, c("token1", 60), c("token2", 50), returnAddrs, returnWeights, c("debt", 40), d("2"))
+	require.NoError(t, err)
+
+	// Buy some lot at the start price of 2.4
+	require.NoError(t, keeper.PlaceBid(ctx, auctionID, buyer, c("token1", 5)))
+	tApp.CheckBalance(t, ctx, buyer, cs(c("token1", 105), c("token2", 88)))
+	tApp.CheckBalance(t, ctx, sellerAddr, cs(c("token1", 40), c("token2", 112), c("debt", 72)))
+
+	// Bids at the end time fail as the price has decayed to zero
+	ctx = ctx.WithBlockTime(startTime.Add(types.DefaultDutchAuctionDuration))
+	require.Error(t, keeper.PlaceBid(ctx, auctionID, buyer, c("token1", 5)))
+
+	// Close the auction, returning the unsold lot and remaining debt
+	require.NoError(t, keeper.CloseAuction(ctx, auctionID))
+	tApp.CheckBalance(t, ctx, returnAddrs[0], cs(c("token1", 128), c("token2", 100)))
+	tApp.CheckBalance(t, ctx, returnAddrs[1], cs(c("token1", 118), c("token2", 100)))
+	tApp.CheckBalance(t, ctx, returnAddrs[2], cs(c("token1", 109), c("token2", 100)))
+	tApp.CheckBalance(t, ctx, sellerAddr, cs(c("token1", 40), c("token2", 112), c("debt", 100)))
+}
+
+func TestDutchCollateralAuctionReservePrice(t *testing.T) {
+	// Setup
+	_, addrs := app.GeneratePrivKeyAddressPairs(5)
+	buyer := addrs[0]
+	oracle := addrs[4]
+	returnAddrs := addrs[1:4]
+	returnWeights := is(30, 20, 10)
+	sellerModName := cdp.LiquidatorMacc
+
+	tApp := app.NewTestApp()
+	sellerAcc := supply.NewEmptyModuleAccount(sellerModName)
+	require.NoError(t, sellerAcc.SetCoins(cs(c("token1", 100), c("token2", 100), c("debt", 100))))
+	tApp.InitializeFromGenesisStates(
+		NewAuthGenStateFromAccs(authexported.GenesisAccounts{
+			auth.NewBaseAccount(buyer, cs(c("token1", 100), c("token2", 100)), nil, 0, 0),
+			sellerAcc,
+		}),
+	)
+	startTime := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := tApp.NewContext(false, abci.Header{Time: startTime})
+	keeper := tApp.GetAuctionKeeper()
+	pricefeedKeeper := tApp.GetPriceFeedKeeper()
+	setPrice := func(price sdk.Dec, expiry time.Time) {
+		_, err := pricefeedKeeper.SetPrice(ctx, oracle, "token1:token2", price, expiry)
+		require.NoError(t, err)
+		require.NoError(t, pricefeedKeeper.SetCurrentPrices(ctx, "token1:token2"))
+	}
+
+	// Lots of token1 cannot be sold below half the market price
+	pricefeedKeeper.SetParams(ctx, pricefeed.NewParams(pricefeed.Markets{
+		pricefeed.NewMarket("token1:token2", "token1", "token2", []sdk.AccAddress{oracle}, true),
+	}, pricefeed.DefaultPriceHistoryLength, pricefeed.DefaultMissedWindowsThreshold, pricefeed.DefaultDeviationThreshold))
+	setPrice(d("2.0"), startTime.Add(time.Hour))
+	params := keeper.GetParams(ctx)
+	params.ReserveParams = types.ReserveParams{
+		types.NewReserveParam("token1", "token1:token2", d("0.5"), sdk.ZeroInt(), sdk.ZeroInt(), 4*time.Hour),
+	}
+	keeper.SetParams(ctx, params)
+
+	// Start auction at a price of 1.2 * 2 token2 per token1, decaying linearly to zero over the dutch auction duration
+	auctionID, err := keeper.StartDutchCollateralAuction(ctx, sellerModName, c("token1", 20), c("token2", 20), returnAddrs, returnWeights, c("debt", 40), d("2"))
+	require.NoError(t, err)
+
+	// No lot is sold while the reserve market has no valid price
+	ctx = ctx.WithBlockTime(startTime.Add(2 * time.Hour))
+	require.True(t, errors.Is(pricefeedKeeper.SetCurrentPrices(ctx, "token1:token2"), pricefeed.ErrNoValidPrice))
+	require.True(t, errors.Is(keeper.PlaceBid(ctx, auctionID, buyer, c("token1", 5)), pricefeed.ErrNoValidPrice))
+	tApp.CheckBalance(t, ctx, buyer, cs(c("token1", 100), c("token2", 100)))
+
+	// Near the end of the auction the price does not fall below the reserve price of 0.5 * 2
+	setPrice(d("2.0"), ctx.BlockTime().Add(24*time.Hour))
+	ctx = ctx.WithBlockTime(startTime.Add(types.DefaultDutchAuctionDuration - 10*time.Minute))
+	require.NoError(t, keeper.PlaceBid(ctx, auctionID, buyer, c("token1", 5)))
+	tApp.CheckBalance(t, ctx, buyer, cs(c("token1", 105), c("token2", 95)))
+}
+
 func TestStartSurplusAuction(t *testing.T) {
 	someTime := time.Date(1998, time.January, 1, 0, 0, 0, 0, time.UTC)
 	type args struct {
@@ -523,3 +523,54 @@ func TestAuctionBidding(t *testing.T) {
 		})
 	}
 }
+
+func TestPlaceBids(t *testing.T) {
+	// Setup
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+	buyer := addrs[0]
+	returnAddrs := addrs[1:]
+	sellerModName := "liquidator"
+	sellerAddr := supply.NewModuleAddress(sellerModName)
+
+	tApp := app.NewTestApp()
+	sellerAcc := supply.NewEmptyModuleAccount(sellerModName)
+	require.NoError(t, sellerAcc.SetCoins(cs(c("token1", 100), c("token2", 100), c("debt", 100))))
+	tApp.InitializeFromGenesisStates(
+		NewAuthGenStateFromAccs(authexported.GenesisAccounts{
+			auth.NewBaseAccount(buyer, cs(c("token1", 100), c("token2", 100)), nil, 0, 0),
+			sellerAcc,
+		}),
+	)
+	ctx := tApp.NewContext(false, abci.Header{})
+	keeper := tApp.GetAuctionKeeper()
+
+	firstID, err := keeper.StartCollateralAuction(ctx, sellerModName, c("token1", 20), c("token2", 50), returnAddrs, is(1), c("debt", 40))
+	require.NoError(t, err)
+	secondID, err := keeper.StartCollateralAuction(ctx, sellerModName, c("token1", 20), c("token2", 50), returnAddrs, is(1), c("debt", 40))
+	require.NoError(t, err)
+
+	// Bid on both auctions
+	err = keeper.PlaceBids(ctx, buyer, types.AuctionBids{
+		types.NewAuctionBid(firstID, c("token2", 10)),
+		types.NewAuctionBid(secondID, c("token2", 20)),
+	})
+	require.NoError(t, err)
+	tApp.CheckBalance(t, ctx, buyer, cs(c("token1", 100), c("token2", 70)))
+	tApp.CheckBalance(t, ctx, sellerAddr, cs(c("token1", 60), c("token2", 130), c("debt", 50)))
+
+	// A failing bid reverts every bid in the batch
+	err = keeper.PlaceBids(ctx, buyer, types.AuctionBids{
+		types.NewAuctionBid(firstID, c("token2", 30)),
+		types.NewAuctionBid(secondID, c("token2", 20)), // not greater than the current bid
+	})
+	require.True(t, errors.Is(err, types.ErrBidTooSmall))
+	tApp.CheckBalance(t, ctx, buyer, cs(c("token1", 100), c("token2", 70)))
+	tApp.CheckBalance(t, ctx, sellerAddr, cs(c("token1", 60), c("token2", 130), c("debt", 50)))
+	firstAuction, found := keeper.GetAuction(ctx, firstID)
+	require.True(t, found)
+	require.Equal(t, c("token2", 10), firstAuction.GetBid())
+
+	// Bidding on a missing auction fails
+	err = keeper.PlaceBids(ctx, buyer, types.AuctionBids{types.NewAuctionBid(secondID+1, c("token2", 30))})
+	require.True(t, errors.Is(err, types.ErrAuctionNotFound))
+}
@@ -15,14 +15,16 @@ import (
 )
 
 type Keeper struct {
-	supplyKeeper  types.SupplyKeeper
-	storeKey      sdk.StoreKey
-	cdc           *codec.Codec
-	paramSubspace subspace.Subspace
+	supplyKeeper    types.SupplyKeeper
+	pricefeedKeeper types.PricefeedKeeper
+	storeKey        sdk.StoreKey
+	cdc             *codec.Codec
+	paramSubspace   subspace.Subspace
 }
 
 // NewKeeper returns a new auction keeper.
-func NewKeeper(cdc *codec.Codec, storeKey sdk.StoreKey, supplyKeeper types.SupplyKeeper, paramstore subspace.Subspace) Keeper {
+// The pricefeed keeper is optional, reserve prices of collateral auctions are not enforced if it is nil.
+func NewKeeper(cdc *codec.Codec, storeKey sdk.StoreKey, supplyKeeper types.SupplyKeeper, pricefeedKeeper types.PricefeedKeeper, paramstore subspace.Subspace) Keeper {
 	if addr := supplyKeeper.GetModuleAddress(types.ModuleName); addr == nil {
 		panic(fmt.Sprintf("%s module account has not been set", types.ModuleName))
 	}
@@ -32,10 +34,11 @@ func NewKeeper(cdc *codec.Codec, storeKey sdk.StoreKey, supplyKeeper types.Suppl
 	}
 
 	return Keeper{
-		supplyKeeper:  supplyKeeper,
-		storeKey:      storeKey,
-		cdc:           cdc,
-		paramSubspace: paramstore,
+		supplyKeeper:    supplyKeeper,
+		pricefeedKeeper: pricefeedKeeper,
+		storeKey:        storeKey,
+		cdc:             cdc,
+		paramSubspace:   paramstore,
 	}
 }
 
@@ -93,6 +96,7 @@ func (k Keeper) SetAuction(ctx sdk.Context, auction types.Auction) {
 	existingAuction, found := k.GetAuction(ctx, auction.GetID())
 	if found {
 		k.removeFromByTimeIndex(ctx, existingAuction.GetEndTime(), existingAuction.GetID())
+		k.removeFromByBidderIndex(ctx, existingAuction.GetBidder(), existingAuction.GetID())
 	}
 
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AuctionKeyPrefix)
@@ -100,6 +104,7 @@ func (k Keeper) SetAuction(ctx sdk.Context, auction types.Auction) {
 	store.Set(types.GetAuctionKey(auction.GetID()), bz)
 
 	k.InsertIntoByTimeIndex(ctx, auction.GetEndTime(), auction.GetID())
+	k.insertIntoByBidderIndex(ctx, auction.GetBidder(), auction.GetID())
 }
 
 // GetAuction gets an auction from the store.
@@ -121,7 +126,9 @@ func (k Keeper) DeleteAuction(ctx sdk.Context, auctionID uint64) {
 	auction, found := k.GetAuction(ctx, auctionID)
 	if found {
 		k.removeFromByTimeIndex(ctx, auction.GetEndTime(), auctionID)
+		k.removeFromByBidderIndex(ctx, auction.GetBidder(), auctionID)
 	}
+	k.deleteBidHistory(ctx, auctionID)
 
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AuctionKeyPrefix)
 	store.Delete(types.GetAuctionKey(auctionID))
@@ -139,6 +146,99 @@ func (k Keeper) removeFromByTimeIndex(ctx sdk.Context, endTime time.Time, auctio
 	store.Delete(types.GetAuctionByTimeKey(endTime, auctionID))
 }
 
+// insertIntoByBidderIndex adds an auction ID into the index of the auctions a bidder is currently winning.
+func (k Keeper) insertIntoByBidderIndex(ctx sdk.Context, bidder sdk.AccAddress, auctionID uint64) {
+	if bidder.Empty() {
+		return
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AuctionByBidderKeyPrefix)
+	store.Set(types.GetAuctionByBidderKey(bidder, auctionID), types.Uint64ToBytes(auctionID))
+}
+
+// removeFromByBidderIndex removes an auction ID from the index of the auctions a bidder is currently winning.
+func (k Keeper) removeFromByBidderIndex(ctx sdk.Context, bidder sdk.AccAddress, auctionID uint64) {
+	if bidder.Empty() {
+		return
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AuctionByBidderKeyPrefix)
+	store.Delete(types.GetAuctionByBidderKey(bidder, auctionID))
+}
+
+// IterateAuctionsByBidder provides an iterator over the IDs of the auctions a bidder is currently winning, ordered by ID.
+// For each auction cb will be called. If cb returns true the iterator will close and stop.
+func (k Keeper) IterateAuctionsByBidder(ctx sdk.Context, bidder sdk.AccAddress, cb func(auctionID uint64) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AuctionByBidderKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, bidder.Bytes())
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+
+		auctionID := types.Uint64FromBytes(iterator.Value())
+
+		if cb(auctionID) {
+			break
+		}
+	}
+}
+
+// GetAuctionsByBidder returns the auctions a bidder is currently winning
+func (k Keeper) GetAuctionsByBidder(ctx sdk.Context, bidder sdk.AccAddress) (auctions types.Auctions) {
+	k.IterateAuctionsByBidder(ctx, bidder, func(auctionID uint64) bool {
+		auction, found := k.GetAuction(ctx, auctionID)
+		if found {
+			auctions = append(auctions, auction)
+		}
+		return false
+	})
+	return
+}
+
+// AppendBidRecord stores a bid placed on an auction after any previous bids.
+func (k Keeper) AppendBidRecord(ctx sdk.Context, record types.BidRecord) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BidHistoryKeyPrefix)
+
+	// the next index is one after the last stored bid for the auction
+	var bidIndex uint64
+	iterator := sdk.KVStoreReversePrefixIterator(store, types.Uint64ToBytes(record.AuctionID))
+	if iterator.Valid() {
+		bidIndex = types.Uint64FromBytes(iterator.Key()[8:]) + 1
+	}
+	iterator.Close()
+
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(record)
+	store.Set(types.GetBidHistoryKey(record.AuctionID, bidIndex), bz)
+}
+
+// GetBidHistory returns the bids placed on an auction, in the order they were placed
+func (k Keeper) GetBidHistory(ctx sdk.Context, auctionID uint64) (records types.BidRecords) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BidHistoryKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, types.Uint64ToBytes(auctionID))
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.BidRecord
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &record)
+		records = append(records, record)
+	}
+	return
+}
+
+// deleteBidHistory removes all bids placed on an auction from the store
+func (k Keeper) deleteBidHistory(ctx sdk.Context, auctionID uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BidHistoryKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, types.Uint64ToBytes(auctionID))
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
 // IterateAuctionByTime provides an iterator over auctions ordered by auction.EndTime.
 // For each auction cb will be callled. If cb returns true the iterator will close and stop.
 func (k Keeper) IterateAuctionsByTime(ctx sdk.Context, inclusiveCutoffTime time.Time, cb func(auctionID uint64) (stop bool)) {
@@ -134,3 +134,67 @@ func TestIterateAuctionsByTime(t *testing.T) {
 
 	require.Equal(t, expectedIndex, readIndex)
 }
+
+func TestAuctionsByBidderIndex(t *testing.T) {
+	// setup keeper
+	tApp := app.NewTestApp()
+	keeper := tApp.GetAuctionKeeper()
+	ctx := tApp.NewContext(true, abci.Header{})
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+
+	someTime := time.Date(1998, time.January, 1, 0, 0, 0, 0, time.UTC)
+	first := types.NewSurplusAuction("sellerMod", c("denom", 100), "anotherdenom", someTime).WithID(1).(types.SurplusAuction)
+	second := types.NewSurplusAuction("sellerMod", c("denom", 100), "anotherdenom", someTime).WithID(2).(types.SurplusAuction)
+	keeper.SetAuction(ctx, first)
+	keeper.SetAuction(ctx, second)
+	require.Empty(t, keeper.GetAuctionsByBidder(ctx, addrs[0]))
+
+	// bid on both auctions
+	first.Bidder = addrs[0]
+	second.Bidder = addrs[0]
+	keeper.SetAuction(ctx, first)
+	keeper.SetAuction(ctx, second)
+	require.Equal(t, types.Auctions{first, second}, keeper.GetAuctionsByBidder(ctx, addrs[0]))
+
+	// outbid on one auction
+	second.Bidder = addrs[1]
+	keeper.SetAuction(ctx, second)
+	require.Equal(t, types.Auctions{first}, keeper.GetAuctionsByBidder(ctx, addrs[0]))
+	require.Equal(t, types.Auctions{second}, keeper.GetAuctionsByBidder(ctx, addrs[1]))
+
+	// deleting an auction removes it from the index
+	keeper.DeleteAuction(ctx, first.GetID())
+	require.Empty(t, keeper.GetAuctionsByBidder(ctx, addrs[0]))
+}
+
+func TestBidHistory(t *testing.T) {
+	// setup keeper
+	tApp := app.NewTestApp()
+	keeper := tApp.GetAuctionKeeper()
+	ctx := tApp.NewContext(true, abci.Header{})
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+
+	someTime := time.Date(1998, time.January, 1, 0, 0, 0, 0, time.UTC)
+	keeper.SetAuction(ctx, types.NewSurplusAuction("sellerMod", c("denom", 100), "anotherdenom", someTime).WithID(1))
+	keeper.SetAuction(ctx, types.NewSurplusAuction("sellerMod", c("denom", 100), "anotherdenom", someTime).WithID(2))
+
+	records := types.BidRecords{
+		types.NewBidRecord(1, addrs[0], c("anotherdenom", 10), c("anotherdenom", 10), c("denom", 100), 1, someTime),
+		types.NewBidRecord(1, addrs[1], c("anotherdenom", 20), c("anotherdenom", 20), c("denom", 100), 2, someTime),
+		types.NewBidRecord(1, addrs[0], c("anotherdenom", 30), c("anotherdenom", 30), c("denom", 100), 3, someTime),
+	}
+	otherRecord := types.NewBidRecord(2, addrs[1], c("anotherdenom", 10), c("anotherdenom", 10), c("denom", 100), 2, someTime)
+	keeper.AppendBidRecord(ctx, records[0])
+	keeper.AppendBidRecord(ctx, records[1])
+	keeper.AppendBidRecord(ctx, otherRecord)
+	keeper.AppendBidRecord(ctx, records[2])
+
+	// bids are returned in the order they were placed
+	require.Equal(t, records, keeper.GetBidHistory(ctx, 1))
+	require.Equal(t, types.BidRecords{otherRecord}, keeper.GetBidHistory(ctx, 2))
+
+	// history is pruned when the auction is deleted
+	keeper.DeleteAuction(ctx, 1)
+	require.Empty(t, keeper.GetBidHistory(ctx, 1))
+	require.Equal(t, types.BidRecords{otherRecord}, keeper.GetBidHistory(ctx, 2))
+}
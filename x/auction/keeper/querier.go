@@ -22,6 +22,10 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			return queryGetParams(ctx, req, keeper)
 		case types.QueryNextAuctionID:
 			return queryNextAuctionID(ctx, req, keeper)
+		case types.QueryGetBidHistory:
+			return queryBidHistory(ctx, req, keeper)
+		case types.QueryGetAuctionsByBidder:
+			return queryAuctionsByBidder(ctx, req, keeper)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown %s query endpoint", types.ModuleName)
 		}
@@ -101,9 +105,19 @@ func filterAuctions(ctx sdk.Context, auctions types.Auctions, params types.Query
 
 		// match auction owner (if supplied)
 		if len(params.Owner) > 0 {
-			if cAuc, ok := auc.(types.CollateralAuction); ok {
+			var lotReturns types.WeightedAddresses
+			hasLotReturns := true
+			switch cAuc := auc.(type) {
+			case types.CollateralAuction:
+				lotReturns = cAuc.GetLotReturns()
+			case types.DutchCollateralAuction:
+				lotReturns = cAuc.GetLotReturns()
+			default:
+				hasLotReturns = false
+			}
+			if hasLotReturns {
 				foundOwnerAddr := false
-				for _, addr := range cAuc.GetLotReturns().Addresses {
+				for _, addr := range lotReturns.Addresses {
 					if addr.Equals(params.Owner) {
 						foundOwnerAddr = true
 						break
@@ -149,3 +163,52 @@ func queryNextAuctionID(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) (
 	}
 	return bz, nil
 }
+
+func queryBidHistory(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	var requestParams types.QueryAuctionParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &requestParams)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	// bid history is pruned when an auction closes
+	_, found := keeper.GetAuction(ctx, requestParams.AuctionID)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrAuctionNotFound, "%d", requestParams.AuctionID)
+	}
+
+	records := keeper.GetBidHistory(ctx, requestParams.AuctionID)
+	if records == nil {
+		records = types.BidRecords{}
+	}
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, records)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+func queryAuctionsByBidder(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	var params types.QueryAuctionsByBidderParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	auctions := keeper.GetAuctionsByBidder(ctx, params.Bidder)
+	start, end := client.Paginate(len(auctions), params.Page, params.Limit, 100)
+	if start < 0 || end < 0 {
+		auctions = types.Auctions{}
+	} else {
+		auctions = auctions[start:end]
+	}
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, auctions)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
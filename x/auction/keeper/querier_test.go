@@ -33,6 +33,7 @@ type QuerierTestSuite struct {
 	keeper   keeper.Keeper
 	app      app.TestApp
 	auctions types.Auctions
+	addrs    []sdk.AccAddress
 	ctx      sdk.Context
 	querier  sdk.Querier
 }
@@ -81,6 +82,7 @@ func (suite *QuerierTestSuite) SetupTest() {
 		suite.auctions = append(suite.auctions, auc)
 	}
 
+	suite.addrs = addrs
 	suite.querier = keeper.NewQuerier(suite.keeper)
 }
 
@@ -142,6 +144,56 @@ func (suite *QuerierTestSuite) TestQueryAuctions() {
 	}
 }
 
+func (suite *QuerierTestSuite) TestQueryBidHistoryAndAuctionsByBidder() {
+	ctx := suite.ctx.WithIsCheckTx(false)
+	buyer := suite.addrs[0]
+
+	// bid twice on the first surplus auction
+	var auctionID uint64
+	for _, a := range suite.auctions {
+		if a.GetType() == types.SurplusAuctionType {
+			auctionID = a.GetID()
+			break
+		}
+	}
+	suite.Require().NoError(suite.keeper.PlaceBid(ctx, auctionID, buyer, c("token2", 10)))
+	suite.Require().NoError(suite.keeper.PlaceBid(ctx, auctionID, buyer, c("token2", 20)))
+
+	// query bid history
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryGetBidHistory}, "/"),
+		Data: types.ModuleCdc.MustMarshalJSON(types.NewQueryAuctionParams(auctionID)),
+	}
+	bz, err := suite.querier(ctx, []string{types.QueryGetBidHistory}, query)
+	suite.Require().NoError(err)
+	var records types.BidRecords
+	suite.Require().NoError(types.ModuleCdc.UnmarshalJSON(bz, &records))
+	suite.Require().Len(records, 2)
+	suite.Equal(c("token2", 10), records[0].Amount)
+	suite.Equal(c("token2", 20), records[1].Amount)
+	suite.Equal(buyer, records[1].Bidder)
+
+	// query auctions the buyer is winning
+	query = abci.RequestQuery{
+		Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryGetAuctionsByBidder}, "/"),
+		Data: types.ModuleCdc.MustMarshalJSON(types.NewQueryAuctionsByBidderParams(1, 100, buyer)),
+	}
+	bz, err = suite.querier(ctx, []string{types.QueryGetAuctionsByBidder}, query)
+	suite.Require().NoError(err)
+	var auctions types.Auctions
+	suite.Require().NoError(types.ModuleCdc.UnmarshalJSON(bz, &auctions))
+	suite.Require().Len(auctions, 1)
+	suite.Equal(auctionID, auctions[0].GetID())
+
+	// bid history of a missing auction returns an error
+	query = abci.RequestQuery{
+		Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryGetBidHistory}, "/"),
+		Data: types.ModuleCdc.MustMarshalJSON(types.NewQueryAuctionParams(9999)),
+	}
+	_, err = suite.querier(ctx, []string{types.QueryGetBidHistory}, query)
+	suite.Error(err)
+}
+
 func TestQuerierTestSuite(t *testing.T) {
 	suite.Run(t, new(QuerierTestSuite))
 }
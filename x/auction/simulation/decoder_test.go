@@ -27,11 +27,14 @@ func TestDecodeDistributionStore(t *testing.T) {
 
 	oneCoin := sdk.NewCoin("coin", sdk.OneInt())
 	auction := types.NewSurplusAuction("me", oneCoin, "coin", time.Now().UTC())
+	bidRecord := types.NewBidRecord(2, sdk.AccAddress("test"), oneCoin, oneCoin, oneCoin, 10, time.Now().UTC())
 
 	kvPairs := kv.Pairs{
 		kv.Pair{Key: types.AuctionKeyPrefix, Value: cdc.MustMarshalBinaryLengthPrefixed(&auction)},
 		kv.Pair{Key: types.AuctionByTimeKeyPrefix, Value: sdk.Uint64ToBigEndian(2)},
 		kv.Pair{Key: types.NextAuctionIDKey, Value: sdk.Uint64ToBigEndian(10)},
+		kv.Pair{Key: types.BidHistoryKeyPrefix, Value: cdc.MustMarshalBinaryLengthPrefixed(bidRecord)},
+		kv.Pair{Key: types.AuctionByBidderKeyPrefix, Value: sdk.Uint64ToBigEndian(2)},
 		kv.Pair{Key: []byte{0x99}, Value: []byte{0x99}},
 	}
 
@@ -42,6 +45,8 @@ func TestDecodeDistributionStore(t *testing.T) {
 		{"Auction", fmt.Sprintf("%v\n%v", auction, auction)},
 		{"AuctionByTime", "2\n2"},
 		{"NextAuctionI", "10\n10"},
+		{"BidHistory", fmt.Sprintf("%v\n%v", bidRecord, bidRecord)},
+		{"AuctionByBidder", "2\n2"},
 		{"other", ""},
 	}
 	for i, tt := range tests {
@@ -51,6 +51,36 @@ func GenIncrementCollateral(r *rand.Rand) sdk.Dec {
 var GenIncrementDebt = GenIncrementCollateral
 var GenIncrementSurplus = GenIncrementCollateral
 
+func GenDutchAuctionDuration(r *rand.Rand) time.Duration {
+	d, err := RandomPositiveDuration(r, AverageBlockTime*10, AverageBlockTime*200)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+func GenDutchStartPriceBuffer(r *rand.Rand) sdk.Dec {
+	return sdk.OneDec().Add(simulation.RandomDecAmount(r, sdk.MustNewDecFromStr("0.5")))
+}
+
+func GenDutchDecayCurve(r *rand.Rand) string {
+	if r.Intn(2) == 0 {
+		return types.LinearDecayCurve
+	}
+	return types.ExponentialDecayCurve
+}
+
+func GenDutchDecayRate(r *rand.Rand) sdk.Dec {
+	return simulation.RandomDecAmount(r, sdk.MustNewDecFromStr("0.001")).Add(sdk.SmallestDec())
+}
+
+// GenCollateralAuctionParams generates an override of the collateral auction params for the bnb lot denom
+func GenCollateralAuctionParams(r *rand.Rand) types.CollateralAuctionParams {
+	return types.CollateralAuctionParams{
+		types.NewCollateralAuctionParam("bnb", GenIncrementCollateral(r), GenBidDuration(r), GenMaxAuctionDuration(r)),
+	}
+}
+
 // RandomizedGenState generates a random GenesisState for auction
 func RandomizedGenState(simState *module.SimulationState) {
 
@@ -60,6 +90,12 @@ func RandomizedGenState(simState *module.SimulationState) {
 		GenIncrementSurplus(simState.Rand),
 		GenIncrementDebt(simState.Rand),
 		GenIncrementCollateral(simState.Rand),
+		GenDutchAuctionDuration(simState.Rand),
+		GenDutchStartPriceBuffer(simState.Rand),
+		GenDutchDecayCurve(simState.Rand),
+		GenDutchDecayRate(simState.Rand),
+		GenCollateralAuctionParams(simState.Rand),
+		types.DefaultReserveParams,
 	)
 	if err := p.Validate(); err != nil {
 		panic(err)
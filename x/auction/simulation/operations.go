@@ -210,6 +210,24 @@ func generateBidAmount(
 			return sdk.NewCoin(a.Bid.Denom, amt), nil // stable coin
 		}
 
+	case types.DutchCollateralAuction:
+		// Check auction can still receive new bids
+		price := a.CurrentPrice(blockTime)
+		if a.IsComplete() || !price.IsPositive() {
+			return sdk.Coin{}, errorCantReceiveBids
+		}
+		// Check the bidder has enough (stable coin) to buy at least one unit of lot
+		maxLotAmt := sdk.MinInt(a.Lot.Amount, bidderBalance.AmountOf(a.Bid.Denom).ToDec().Quo(price).TruncateInt())
+		if !maxLotAmt.IsPositive() {
+			return sdk.Coin{}, errorNotEnoughCoins
+		}
+		// Generate an amount of lot to buy (collateral coin)
+		amt, err := RandIntInclusive(r, sdk.OneInt(), maxLotAmt)
+		if err != nil {
+			panic(err)
+		}
+		return sdk.NewCoin(a.Lot.Denom, amt), nil // collateral coin
+
 	default:
 		return sdk.Coin{}, fmt.Errorf("unknown auction type")
 	}
@@ -42,5 +42,10 @@ func ParamChanges(r *rand.Rand) []simulation.ParamChange {
 				return fmt.Sprintf("%d", GenIncrementSurplus(r))
 			},
 		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyDutchAuctionDuration),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("%d", GenDutchAuctionDuration(r))
+			},
+		),
 	}
 }
@@ -11,11 +11,16 @@ import (
 )
 
 const (
-	CollateralAuctionType = "collateral"
-	SurplusAuctionType    = "surplus"
-	DebtAuctionType       = "debt"
-	ForwardAuctionPhase   = "forward"
-	ReverseAuctionPhase   = "reverse"
+	CollateralAuctionType      = "collateral"
+	DutchCollateralAuctionType = "dutch_collateral"
+	SurplusAuctionType         = "surplus"
+	DebtAuctionType            = "debt"
+	ForwardAuctionPhase        = "forward"
+	ReverseAuctionPhase        = "reverse"
+	DutchAuctionPhase          = "dutch"
+
+	LinearDecayCurve      = "linear"
+	ExponentialDecayCurve = "exponential"
 )
 
 // DistantFuture is a very large time value to use as initial the ending time for auctions.
@@ -215,6 +220,7 @@ type CollateralAuction struct {
 	CorrespondingDebt sdk.Coin          `json:"corresponding_debt" yaml:"corresponding_debt"`
 	MaxBid            sdk.Coin          `json:"max_bid" yaml:"max_bid"`
 	LotReturns        WeightedAddresses `json:"lot_returns" yaml:"lot_returns"`
+	ReserveDeadline   time.Time         `json:"reserve_deadline" yaml:"reserve_deadline"` // Time up to which the auction is extended for being below its reserve price. Set when it is first extended, and reset when it is restarted.
 }
 
 // WithID returns an auction with the ID set.
@@ -272,10 +278,12 @@ func (a CollateralAuction) String() string {
   End Time:   						%s
 	Max End Time:      			%s
 	Max Bid									%s
-	LotReturns						%s`,
+	LotReturns						%s
+	Reserve Deadline				%s`,
 		a.GetID(), a.Initiator, a.Lot,
 		a.Bidder, a.Bid, a.GetEndTime().String(),
 		a.MaxEndTime.String(), a.MaxBid, a.LotReturns,
+		a.ReserveDeadline.String(),
 	)
 }
 
@@ -298,6 +306,139 @@ func NewCollateralAuction(seller string, lot sdk.Coin, endTime time.Time, maxBid
 	return auction
 }
 
+// DutchCollateralAuction is a descending price auction.
+// The price of the lot starts above the market price and decays along a curve until the auction ends.
+// Bidders buy all or part of the remaining lot at the current price, receiving it immediately, until MaxBid has been raised.
+// Unsold Lot is sent to LotReturns, being divided among the addresses by weight.
+type DutchCollateralAuction struct {
+	BaseAuction `json:"base_auction" yaml:"base_auction"`
+
+	CorrespondingDebt sdk.Coin          `json:"corresponding_debt" yaml:"corresponding_debt"`
+	MaxBid            sdk.Coin          `json:"max_bid" yaml:"max_bid"`
+	LotReturns        WeightedAddresses `json:"lot_returns" yaml:"lot_returns"`
+	StartPrice        sdk.Dec           `json:"start_price" yaml:"start_price"` // price of one unit of lot, in units of the bid denom, at the start time
+	StartTime         time.Time         `json:"start_time" yaml:"start_time"`
+	DecayCurve        string            `json:"decay_curve" yaml:"decay_curve"` // shape of the price decay, linear or exponential
+	DecayRate         sdk.Dec           `json:"decay_rate" yaml:"decay_rate"`   // per second decrease of the price of an exponential curve
+}
+
+// WithID returns an auction with the ID set.
+func (a DutchCollateralAuction) WithID(id uint64) Auction { a.ID = id; return a }
+
+// GetType returns the auction type. Used to identify auctions in event attributes.
+func (a DutchCollateralAuction) GetType() string { return DutchCollateralAuctionType }
+
+// GetModuleAccountCoins returns the total number of coins held in the module account for this auction.
+// It is used in genesis initialize the module account correctly.
+func (a DutchCollateralAuction) GetModuleAccountCoins() sdk.Coins {
+	// a.Bid is paid out on bids, so is never stored in the module account
+	return sdk.NewCoins(a.Lot).Add(sdk.NewCoins(a.CorrespondingDebt)...)
+}
+
+// GetPhase returns the phase of a dutch collateral auction, which never changes.
+func (a DutchCollateralAuction) GetPhase() string { return DutchAuctionPhase }
+
+// GetLotReturns returns a dutch collateral auction's lot owners
+func (a DutchCollateralAuction) GetLotReturns() WeightedAddresses {
+	return a.LotReturns
+}
+
+// IsComplete returns whether the auction has raised its max bid or sold all of its lot.
+func (a DutchCollateralAuction) IsComplete() bool {
+	return a.Bid.IsGTE(a.MaxBid) || a.Lot.IsZero()
+}
+
+// CurrentPrice returns the price of one unit of lot at the input time.
+// A linear curve falls from the start price to zero at the auction's end time,
+// an exponential curve falls by the decay rate every second.
+// Bids are placed at no less than the reserve price of the lot, which is applied by the keeper as it depends on the market price.
+func (a DutchCollateralAuction) CurrentPrice(t time.Time) sdk.Dec {
+	if !t.After(a.StartTime) {
+		return a.StartPrice
+	}
+	if !t.Before(a.MaxEndTime) {
+		return sdk.ZeroDec()
+	}
+	elapsed := int64(t.Sub(a.StartTime).Seconds())
+	switch a.DecayCurve {
+	case ExponentialDecayCurve:
+		return a.StartPrice.Mul(sdk.OneDec().Sub(a.DecayRate).Power(uint64(elapsed)))
+	default:
+		duration := int64(a.MaxEndTime.Sub(a.StartTime).Seconds())
+		return a.StartPrice.MulInt64(duration - elapsed).QuoInt64(duration)
+	}
+}
+
+// Validate validates the DutchCollateralAuction fields values.
+func (a DutchCollateralAuction) Validate() error {
+	if !a.CorrespondingDebt.IsValid() {
+		return fmt.Errorf("invalid corresponding debt: %s", a.CorrespondingDebt)
+	}
+	if !a.MaxBid.IsValid() {
+		return fmt.Errorf("invalid max bid: %s", a.MaxBid)
+	}
+	if err := a.LotReturns.Validate(); err != nil {
+		return fmt.Errorf("invalid lot returns: %w", err)
+	}
+	if a.StartPrice.IsNil() || !a.StartPrice.IsPositive() {
+		return fmt.Errorf("start price must be positive: %s", a.StartPrice)
+	}
+	if !a.StartTime.Before(a.MaxEndTime) {
+		return fmt.Errorf("start time must be before max end time (%s ≥ %s)", a.StartTime, a.MaxEndTime)
+	}
+	if err := validateDecayCurve(a.DecayCurve, a.DecayRate); err != nil {
+		return err
+	}
+	return a.BaseAuction.Validate()
+}
+
+func (a DutchCollateralAuction) String() string {
+	return fmt.Sprintf(`Auction %d:
+  Initiator:              %s
+  Lot:               			%s
+  Bidder:            		  %s
+  Bid:        						%s
+  End Time:   						%s
+	Max End Time:      			%s
+	Max Bid									%s
+	LotReturns						%s
+	Start Price						%s
+	Start Time						%s
+	Decay Curve						%s
+	Decay Rate						%s`,
+		a.GetID(), a.Initiator, a.Lot,
+		a.Bidder, a.Bid, a.GetEndTime().String(),
+		a.MaxEndTime.String(), a.MaxBid, a.LotReturns,
+		a.StartPrice, a.StartTime.String(), a.DecayCurve, a.DecayRate,
+	)
+}
+
+// NewDutchCollateralAuction returns a new dutch collateral auction.
+func NewDutchCollateralAuction(
+	seller string, lot sdk.Coin, startTime, endTime time.Time, maxBid sdk.Coin, lotReturns WeightedAddresses,
+	debt sdk.Coin, startPrice sdk.Dec, decayCurve string, decayRate sdk.Dec,
+) DutchCollateralAuction {
+	auction := DutchCollateralAuction{
+		BaseAuction: BaseAuction{
+			// no ID
+			Initiator:       seller,
+			Lot:             lot,
+			Bidder:          nil,
+			Bid:             sdk.NewInt64Coin(maxBid.Denom, 0),
+			HasReceivedBids: false, // new auctions don't have any bids
+			EndTime:         endTime,
+			MaxEndTime:      endTime},
+		CorrespondingDebt: debt,
+		MaxBid:            maxBid,
+		LotReturns:        lotReturns,
+		StartPrice:        startPrice,
+		StartTime:         startTime,
+		DecayCurve:        decayCurve,
+		DecayRate:         decayRate,
+	}
+	return auction
+}
+
 // WeightedAddresses is a type for storing some addresses and associated weights.
 type WeightedAddresses struct {
 	Addresses []sdk.AccAddress `json:"addresses" yaml:"addresses"`
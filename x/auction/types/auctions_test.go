@@ -373,6 +373,86 @@ func TestCollateralAuctionValidate(t *testing.T) {
 	}
 }
 
+func TestDutchCollateralAuctionValidate(t *testing.T) {
+	addr1, err := sdk.AccAddressFromBech32(testAccAddress1)
+	require.NoError(t, err)
+
+	now := time.Now()
+	validAuction := DutchCollateralAuction{
+		BaseAuction: BaseAuction{
+			ID:              1,
+			Initiator:       testAccAddress1,
+			Lot:             c("kava", 1),
+			Bidder:          addr1,
+			Bid:             c("usdx", 1),
+			EndTime:         now,
+			MaxEndTime:      now,
+			HasReceivedBids: true,
+		},
+		CorrespondingDebt: c("debt", 1),
+		MaxBid:            c("usdx", 1),
+		LotReturns: WeightedAddresses{
+			Addresses: []sdk.AccAddress{addr1},
+			Weights:   []sdk.Int{sdk.NewInt(1)},
+		},
+		StartPrice: d("1.2"),
+		StartTime:  now.Add(-time.Hour),
+		DecayCurve: LinearDecayCurve,
+		DecayRate:  sdk.ZeroDec(),
+	}
+
+	tests := []struct {
+		msg      string
+		malleate func(a *DutchCollateralAuction)
+		expPass  bool
+	}{
+		{"valid auction", func(a *DutchCollateralAuction) {}, true},
+		{"invalid max bid", func(a *DutchCollateralAuction) { a.MaxBid = sdk.Coin{Denom: "DENOM", Amount: sdk.NewInt(1)} }, false},
+		{"zero start price", func(a *DutchCollateralAuction) { a.StartPrice = sdk.ZeroDec() }, false},
+		{"nil start price", func(a *DutchCollateralAuction) { a.StartPrice = sdk.Dec{} }, false},
+		{"start time after max end time", func(a *DutchCollateralAuction) { a.StartTime = now.Add(time.Hour) }, false},
+		{"invalid decay curve", func(a *DutchCollateralAuction) { a.DecayCurve = "quadratic" }, false},
+		{"exponential curve with zero rate", func(a *DutchCollateralAuction) { a.DecayCurve = ExponentialDecayCurve }, false},
+		{"decay rate of one", func(a *DutchCollateralAuction) { a.DecayRate = sdk.OneDec() }, false},
+	}
+
+	for _, tc := range tests {
+		auction := validAuction
+		tc.malleate(&auction)
+
+		err := auction.Validate()
+
+		if tc.expPass {
+			require.NoError(t, err, tc.msg)
+		} else {
+			require.Error(t, err, tc.msg)
+		}
+	}
+}
+
+func TestDutchCollateralAuctionCurrentPrice(t *testing.T) {
+	startTime := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	endTime := startTime.Add(100 * time.Second)
+
+	linear := NewDutchCollateralAuction(
+		TestInitiatorModuleName, c(TestLotDenom, TestLotAmount), startTime, endTime, c(TestBidDenom, TestBidAmount),
+		WeightedAddresses{}, c(TestDebtDenom, TestDebtAmount1), d("2.0"), LinearDecayCurve, sdk.ZeroDec(),
+	)
+	require.Equal(t, d("2.0"), linear.CurrentPrice(startTime.Add(-time.Second)))
+	require.Equal(t, d("2.0"), linear.CurrentPrice(startTime))
+	require.Equal(t, d("1.5"), linear.CurrentPrice(startTime.Add(25*time.Second)))
+	require.Equal(t, d("0.02"), linear.CurrentPrice(startTime.Add(99*time.Second)))
+	require.Equal(t, sdk.ZeroDec(), linear.CurrentPrice(endTime))
+
+	exponential := linear
+	exponential.DecayCurve = ExponentialDecayCurve
+	exponential.DecayRate = d("0.1")
+	require.Equal(t, d("2.0"), exponential.CurrentPrice(startTime))
+	require.Equal(t, d("1.8"), exponential.CurrentPrice(startTime.Add(time.Second)))
+	require.Equal(t, d("1.62"), exponential.CurrentPrice(startTime.Add(2*time.Second)))
+	require.Equal(t, sdk.ZeroDec(), exponential.CurrentPrice(endTime))
+}
+
 func TestBaseAuctionGetters(t *testing.T) {
 	endTime := time.Now().Add(TestExtraEndTime)
 
@@ -0,0 +1,46 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BidRecord is a bid placed on an auction, stored until the auction closes.
+type BidRecord struct {
+	AuctionID uint64         `json:"auction_id" yaml:"auction_id"`
+	Bidder    sdk.AccAddress `json:"bidder" yaml:"bidder"`
+	Amount    sdk.Coin       `json:"amount" yaml:"amount"` // the bid or lot amount placed
+	Bid       sdk.Coin       `json:"bid" yaml:"bid"`       // the auction's bid after the bid was placed
+	Lot       sdk.Coin       `json:"lot" yaml:"lot"`       // the auction's lot after the bid was placed
+	Height    int64          `json:"height" yaml:"height"`
+	Time      time.Time      `json:"time" yaml:"time"`
+}
+
+// NewBidRecord returns a new BidRecord
+func NewBidRecord(auctionID uint64, bidder sdk.AccAddress, amount, bid, lot sdk.Coin, height int64, t time.Time) BidRecord {
+	return BidRecord{
+		AuctionID: auctionID,
+		Bidder:    bidder,
+		Amount:    amount,
+		Bid:       bid,
+		Lot:       lot,
+		Height:    height,
+		Time:      t,
+	}
+}
+
+func (br BidRecord) String() string {
+	return fmt.Sprintf(`Bid on auction %d:
+	Bidder: %s
+	Amount: %s
+	Bid: %s
+	Lot: %s
+	Height: %d
+	Time: %s`,
+		br.AuctionID, br.Bidder, br.Amount, br.Bid, br.Lot, br.Height, br.Time)
+}
+
+// BidRecords is a slice of BidRecord
+type BidRecords []BidRecord
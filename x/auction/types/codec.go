@@ -14,10 +14,12 @@ func init() {
 // RegisterCodec registers concrete types on the codec.
 func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgPlaceBid{}, "auction/MsgPlaceBid", nil)
+	cdc.RegisterConcrete(MsgPlaceBids{}, "auction/MsgPlaceBids", nil)
 
 	cdc.RegisterInterface((*GenesisAuction)(nil), nil)
 	cdc.RegisterInterface((*Auction)(nil), nil)
 	cdc.RegisterConcrete(SurplusAuction{}, "auction/SurplusAuction", nil)
 	cdc.RegisterConcrete(DebtAuction{}, "auction/DebtAuction", nil)
 	cdc.RegisterConcrete(CollateralAuction{}, "auction/CollateralAuction", nil)
+	cdc.RegisterConcrete(DutchCollateralAuction{}, "auction/DutchCollateralAuction", nil)
 }
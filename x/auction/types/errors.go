@@ -27,4 +27,6 @@ var (
 	ErrLotTooSmall = sdkerrors.Register(ModuleName, 11, "lot is not greater than auction's min new lot amount")
 	// ErrLotTooLarge error for when lot is not smaller than auction's max new lot amount
 	ErrLotTooLarge = sdkerrors.Register(ModuleName, 12, "lot is greater than auction's max new lot amount")
+	// ErrBelowReservePrice error for when a bid would sell the lot below the auction's reserve price
+	ErrBelowReservePrice = sdkerrors.Register(ModuleName, 13, "bid is below auction's reserve price")
 )
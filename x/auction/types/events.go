@@ -2,17 +2,21 @@ package types
 
 // Events for the module
 const (
-	EventTypeAuctionStart = "auction_start"
-	EventTypeAuctionBid   = "auction_bid"
-	EventTypeAuctionClose = "auction_close"
+	EventTypeAuctionStart   = "auction_start"
+	EventTypeAuctionBid     = "auction_bid"
+	EventTypeAuctionClose   = "auction_close"
+	EventTypeAuctionExtend  = "auction_extend"
+	EventTypeAuctionRestart = "auction_restart"
 
-	AttributeValueCategory  = ModuleName
-	AttributeKeyAuctionID   = "auction_id"
-	AttributeKeyAuctionType = "auction_type"
-	AttributeKeyBidder      = "bidder"
-	AttributeKeyLot         = "lot"
-	AttributeKeyMaxBid      = "max_bid"
-	AttributeKeyBid         = "bid"
-	AttributeKeyEndTime     = "end_time"
-	AttributeKeyCloseBlock  = "close_block"
+	AttributeValueCategory   = ModuleName
+	AttributeKeyAuctionID    = "auction_id"
+	AttributeKeyAuctionType  = "auction_type"
+	AttributeKeyBidder       = "bidder"
+	AttributeKeyLot          = "lot"
+	AttributeKeyMaxBid       = "max_bid"
+	AttributeKeyBid          = "bid"
+	AttributeKeyEndTime      = "end_time"
+	AttributeKeyCloseBlock   = "close_block"
+	AttributeKeyPrice        = "price"
+	AttributeKeyReservePrice = "reserve_price"
 )
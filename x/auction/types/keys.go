@@ -30,6 +30,9 @@ var (
 	AuctionByTimeKeyPrefix = []byte{0x01} // prefix for keys that are part of the auctionsByTime index
 
 	NextAuctionIDKey = []byte{0x02} // key for the next auction id
+
+	BidHistoryKeyPrefix      = []byte{0x03} // prefix for keys that store the bids placed on auctions
+	AuctionByBidderKeyPrefix = []byte{0x04} // prefix for keys that are part of the auctionsByBidder index
 )
 
 // GetAuctionKey returns the bytes of an auction key
@@ -42,6 +45,16 @@ func GetAuctionByTimeKey(endTime time.Time, auctionID uint64) []byte {
 	return append(sdk.FormatTimeBytes(endTime), Uint64ToBytes(auctionID)...)
 }
 
+// GetBidHistoryKey returns the key for a bid placed on an auction, ordering bids by the order they were placed
+func GetBidHistoryKey(auctionID, bidIndex uint64) []byte {
+	return append(Uint64ToBytes(auctionID), Uint64ToBytes(bidIndex)...)
+}
+
+// GetAuctionByBidderKey returns the key for iterating the auctions a bidder is currently winning
+func GetAuctionByBidderKey(bidder sdk.AccAddress, auctionID uint64) []byte {
+	return append(bidder.Bytes(), Uint64ToBytes(auctionID)...)
+}
+
 // Uint64ToBytes converts a uint64 into fixed length bytes for use in store keys.
 func Uint64ToBytes(id uint64) []byte {
 	bz := make([]byte, 8)
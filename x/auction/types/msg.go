@@ -9,7 +9,10 @@ import (
 )
 
 // ensure Msg interface compliance at compile time
-var _ sdk.Msg = &MsgPlaceBid{}
+var (
+	_ sdk.Msg = &MsgPlaceBid{}
+	_ sdk.Msg = &MsgPlaceBids{}
+)
 
 // MsgPlaceBid is the message type used to place a bid on any type of auction.
 type MsgPlaceBid struct {
@@ -69,3 +72,90 @@ func (msg MsgPlaceBid) String() string {
 	Amount: %s
 `, msg.AuctionID, msg.Bidder, msg.Amount)
 }
+
+// AuctionBid is a bid or lot amount to be set on an auction.
+type AuctionBid struct {
+	AuctionID uint64   `json:"auction_id" yaml:"auction_id"`
+	Amount    sdk.Coin `json:"amount" yaml:"amount"` // The new bid or lot to be set on the auction.
+}
+
+// NewAuctionBid returns a new AuctionBid.
+func NewAuctionBid(auctionID uint64, amt sdk.Coin) AuctionBid {
+	return AuctionBid{
+		AuctionID: auctionID,
+		Amount:    amt,
+	}
+}
+
+// Validate performs a stateless validation of the bid.
+func (b AuctionBid) Validate() error {
+	if b.AuctionID == 0 {
+		return errors.New("auction id cannot be zero")
+	}
+	if !b.Amount.IsValid() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "bid amount %s", b.Amount)
+	}
+	return nil
+}
+
+// AuctionBids is a slice of AuctionBid
+type AuctionBids []AuctionBid
+
+// MsgPlaceBids is the message type used to place bids on several auctions at once.
+// The bids are placed in order and either all succeed or none are placed.
+type MsgPlaceBids struct {
+	Bidder sdk.AccAddress `json:"bidder" yaml:"bidder"`
+	Bids   AuctionBids    `json:"bids" yaml:"bids"`
+}
+
+// NewMsgPlaceBids returns a new MsgPlaceBids.
+func NewMsgPlaceBids(bidder sdk.AccAddress, bids AuctionBids) MsgPlaceBids {
+	return MsgPlaceBids{
+		Bidder: bidder,
+		Bids:   bids,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgPlaceBids) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgPlaceBids) Type() string { return "place_bids" }
+
+// ValidateBasic does a simple validation check that doesn't require access to state.
+func (msg MsgPlaceBids) ValidateBasic() error {
+	if msg.Bidder.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "bidder address cannot be empty")
+	}
+	if len(msg.Bidder) != sdk.AddrLen {
+		return fmt.Errorf("the expected bidder address length is %d, actual length is %d", sdk.AddrLen, len(msg.Bidder))
+	}
+	if len(msg.Bids) == 0 {
+		return errors.New("bids cannot be empty")
+	}
+	for _, bid := range msg.Bids {
+		if err := bid.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgPlaceBids) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgPlaceBids) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Bidder}
+}
+
+func (msg MsgPlaceBids) String() string {
+	// String implements the Stringer interface
+	return fmt.Sprintf(`Place Bids Message:
+	Bidder: %s
+	Bids: %v
+`, msg.Bidder, msg.Bids)
+}
@@ -57,3 +57,53 @@ func TestMsgPlaceBid_ValidateBasic(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgPlaceBids_ValidateBasic(t *testing.T) {
+	addr, err := sdk.AccAddressFromBech32(testAccAddress1)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		msg        MsgPlaceBids
+		expectPass bool
+	}{
+		{
+			"normal",
+			NewMsgPlaceBids(addr, AuctionBids{NewAuctionBid(1, c("token", 10)), NewAuctionBid(2, c("token", 20))}),
+			true,
+		},
+		{
+			"no bids",
+			NewMsgPlaceBids(addr, AuctionBids{}),
+			false,
+		},
+		{
+			"zero id",
+			NewMsgPlaceBids(addr, AuctionBids{NewAuctionBid(1, c("token", 10)), NewAuctionBid(0, c("token", 20))}),
+			false,
+		},
+		{
+			"empty address ",
+			NewMsgPlaceBids(nil, AuctionBids{NewAuctionBid(1, c("token", 10))}),
+			false,
+		},
+		{
+			"invalid address",
+			NewMsgPlaceBids(addr[:10], AuctionBids{NewAuctionBid(1, c("token", 10))}),
+			false,
+		},
+		{
+			"negative amount",
+			NewMsgPlaceBids(addr, AuctionBids{NewAuctionBid(1, sdk.Coin{Denom: "token", Amount: sdk.NewInt(-10)})}),
+			false,
+		},
+	}
+
+	for _, tc := range tests {
+		if tc.expectPass {
+			require.NoError(t, tc.msg.ValidateBasic(), tc.name)
+		} else {
+			require.Error(t, tc.msg.ValidateBasic(), tc.name)
+		}
+	}
+}
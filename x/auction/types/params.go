@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -19,38 +20,77 @@ const (
 	DefaultMaxAuctionDuration time.Duration = 2 * 24 * time.Hour
 	// DefaultBidDuration how long an auction gets extended when someone bids
 	DefaultBidDuration time.Duration = 1 * time.Hour
+	// DefaultDutchAuctionDuration how long the price of a dutch auction takes to decay
+	DefaultDutchAuctionDuration time.Duration = 6 * time.Hour
+	// DefaultDutchDecayCurve shape of the price decay of dutch auctions
+	DefaultDutchDecayCurve = LinearDecayCurve
+	// MaxConversionFactor is the largest number of decimal places of a reserve param lot or bid denom
+	MaxConversionFactor = 18
 )
 
 var (
 	// DefaultIncrement is the smallest percent change a new bid must have from the old one
 	DefaultIncrement sdk.Dec = sdk.MustNewDecFromStr("0.05")
+	// DefaultDutchStartPriceBuffer is the multiple of the market price dutch auctions start at
+	DefaultDutchStartPriceBuffer sdk.Dec = sdk.MustNewDecFromStr("1.2")
+	// DefaultDutchDecayRate is the per second price decrease of exponential dutch auctions
+	DefaultDutchDecayRate sdk.Dec = sdk.MustNewDecFromStr("0.0001")
+	// DefaultCollateralAuctionParams has no overrides, so all collateral auctions use the global params
+	DefaultCollateralAuctionParams CollateralAuctionParams
+	// DefaultReserveParams has no reserves, so collateral auctions can be won at any price
+	DefaultReserveParams ReserveParams
 	// ParamStoreKeyParams Param store key for auction params
-	KeyBidDuration         = []byte("BidDuration")
-	KeyMaxAuctionDuration  = []byte("MaxAuctionDuration")
-	KeyIncrementSurplus    = []byte("IncrementSurplus")
-	KeyIncrementDebt       = []byte("IncrementDebt")
-	KeyIncrementCollateral = []byte("IncrementCollateral")
+	KeyBidDuration             = []byte("BidDuration")
+	KeyMaxAuctionDuration      = []byte("MaxAuctionDuration")
+	KeyIncrementSurplus        = []byte("IncrementSurplus")
+	KeyIncrementDebt           = []byte("IncrementDebt")
+	KeyIncrementCollateral     = []byte("IncrementCollateral")
+	KeyDutchAuctionDuration    = []byte("DutchAuctionDuration")
+	KeyDutchStartPriceBuffer   = []byte("DutchStartPriceBuffer")
+	KeyDutchDecayCurve         = []byte("DutchDecayCurve")
+	KeyDutchDecayRate          = []byte("DutchDecayRate")
+	KeyCollateralAuctionParams = []byte("CollateralAuctionParams")
+	KeyReserveParams           = []byte("ReserveParams")
 )
 
 var _ subspace.ParamSet = &Params{}
 
 // Params is the governance parameters for the auction module.
 type Params struct {
-	MaxAuctionDuration  time.Duration `json:"max_auction_duration" yaml:"max_auction_duration"` // max length of auction
-	BidDuration         time.Duration `json:"bid_duration" yaml:"bid_duration"`                 // additional time added to the auction end time after each bid, capped by the expiry.
-	IncrementSurplus    sdk.Dec       `json:"increment_surplus" yaml:"increment_surplus"`       // percentage change (of auc.Bid) required for a new bid on a surplus auction
-	IncrementDebt       sdk.Dec       `json:"increment_debt" yaml:"increment_debt"`             // percentage change (of auc.Lot) required for a new bid on a debt auction
-	IncrementCollateral sdk.Dec       `json:"increment_collateral" yaml:"increment_collateral"` // percentage change (of auc.Bid or auc.Lot) required for a new bid on a collateral auction
+	MaxAuctionDuration    time.Duration `json:"max_auction_duration" yaml:"max_auction_duration"`         // max length of auction
+	BidDuration           time.Duration `json:"bid_duration" yaml:"bid_duration"`                         // additional time added to the auction end time after each bid, capped by the expiry.
+	IncrementSurplus      sdk.Dec       `json:"increment_surplus" yaml:"increment_surplus"`               // percentage change (of auc.Bid) required for a new bid on a surplus auction
+	IncrementDebt         sdk.Dec       `json:"increment_debt" yaml:"increment_debt"`                     // percentage change (of auc.Lot) required for a new bid on a debt auction
+	IncrementCollateral   sdk.Dec       `json:"increment_collateral" yaml:"increment_collateral"`         // percentage change (of auc.Bid or auc.Lot) required for a new bid on a collateral auction
+	DutchAuctionDuration  time.Duration `json:"dutch_auction_duration" yaml:"dutch_auction_duration"`     // length of a dutch collateral auction
+	DutchStartPriceBuffer sdk.Dec       `json:"dutch_start_price_buffer" yaml:"dutch_start_price_buffer"` // multiple of the market price a dutch collateral auction starts at
+	DutchDecayCurve       string        `json:"dutch_decay_curve" yaml:"dutch_decay_curve"`               // shape of the price decay of a dutch collateral auction, linear or exponential
+	DutchDecayRate        sdk.Dec       `json:"dutch_decay_rate" yaml:"dutch_decay_rate"`                 // per second price decrease of an exponential dutch collateral auction
+
+	CollateralAuctionParams CollateralAuctionParams `json:"collateral_auction_params" yaml:"collateral_auction_params"` // overrides of the collateral auction params, keyed by lot denom
+	ReserveParams           ReserveParams           `json:"reserve_params" yaml:"reserve_params"`                       // minimum prices collateral auction lots can be sold at, keyed by lot denom
 }
 
 // NewParams returns a new Params object.
-func NewParams(maxAuctionDuration, bidDuration time.Duration, incrementSurplus, incrementDebt, incrementCollateral sdk.Dec) Params {
+func NewParams(
+	maxAuctionDuration, bidDuration time.Duration, incrementSurplus, incrementDebt, incrementCollateral sdk.Dec,
+	dutchAuctionDuration time.Duration, dutchStartPriceBuffer sdk.Dec, dutchDecayCurve string, dutchDecayRate sdk.Dec,
+	collateralAuctionParams CollateralAuctionParams, reserveParams ReserveParams,
+) Params {
 	return Params{
 		MaxAuctionDuration:  maxAuctionDuration,
 		BidDuration:         bidDuration,
 		IncrementSurplus:    incrementSurplus,
 		IncrementDebt:       incrementDebt,
 		IncrementCollateral: incrementCollateral,
+
+		DutchAuctionDuration:  dutchAuctionDuration,
+		DutchStartPriceBuffer: dutchStartPriceBuffer,
+		DutchDecayCurve:       dutchDecayCurve,
+		DutchDecayRate:        dutchDecayRate,
+
+		CollateralAuctionParams: collateralAuctionParams,
+		ReserveParams:           reserveParams,
 	}
 }
 
@@ -62,6 +102,12 @@ func DefaultParams() Params {
 		DefaultIncrement,
 		DefaultIncrement,
 		DefaultIncrement,
+		DefaultDutchAuctionDuration,
+		DefaultDutchStartPriceBuffer,
+		DefaultDutchDecayCurve,
+		DefaultDutchDecayRate,
+		DefaultCollateralAuctionParams,
+		DefaultReserveParams,
 	)
 }
 
@@ -78,6 +124,12 @@ func (p *Params) ParamSetPairs() subspace.ParamSetPairs {
 		params.NewParamSetPair(KeyIncrementSurplus, &p.IncrementSurplus, validateIncrementSurplusParam),
 		params.NewParamSetPair(KeyIncrementDebt, &p.IncrementDebt, validateIncrementDebtParam),
 		params.NewParamSetPair(KeyIncrementCollateral, &p.IncrementCollateral, validateIncrementCollateralParam),
+		params.NewParamSetPair(KeyDutchAuctionDuration, &p.DutchAuctionDuration, validateDutchAuctionDurationParam),
+		params.NewParamSetPair(KeyDutchStartPriceBuffer, &p.DutchStartPriceBuffer, validateDutchStartPriceBufferParam),
+		params.NewParamSetPair(KeyDutchDecayCurve, &p.DutchDecayCurve, validateDutchDecayCurveParam),
+		params.NewParamSetPair(KeyDutchDecayRate, &p.DutchDecayRate, validateDutchDecayRateParam),
+		params.NewParamSetPair(KeyCollateralAuctionParams, &p.CollateralAuctionParams, validateCollateralAuctionParamsParam),
+		params.NewParamSetPair(KeyReserveParams, &p.ReserveParams, validateReserveParamsParam),
 	}
 }
 
@@ -95,8 +147,37 @@ func (p Params) String() string {
 	Bid Duration: %s
 	Increment Surplus: %s
 	Increment Debt: %s
-	Increment Collateral: %s`,
-		p.MaxAuctionDuration, p.BidDuration, p.IncrementSurplus, p.IncrementDebt, p.IncrementCollateral)
+	Increment Collateral: %s
+	Dutch Auction Duration: %s
+	Dutch Start Price Buffer: %s
+	Dutch Decay Curve: %s
+	Dutch Decay Rate: %s
+	Collateral Auction Params: %s
+	Reserve Params: %s`,
+		p.MaxAuctionDuration, p.BidDuration, p.IncrementSurplus, p.IncrementDebt, p.IncrementCollateral,
+		p.DutchAuctionDuration, p.DutchStartPriceBuffer, p.DutchDecayCurve, p.DutchDecayRate,
+		p.CollateralAuctionParams, p.ReserveParams)
+}
+
+// GetCollateralAuctionParam returns the collateral auction params for a lot denom.
+// If there is no override for the denom, the global collateral auction params are returned.
+func (p Params) GetCollateralAuctionParam(lotDenom string) CollateralAuctionParam {
+	for _, param := range p.CollateralAuctionParams {
+		if param.Denom == lotDenom {
+			return param
+		}
+	}
+	return NewCollateralAuctionParam(lotDenom, p.IncrementCollateral, p.BidDuration, p.MaxAuctionDuration)
+}
+
+// GetReserveParam returns the reserve param for a lot denom, and false if the denom has no reserve.
+func (p Params) GetReserveParam(lotDenom string) (ReserveParam, bool) {
+	for _, param := range p.ReserveParams {
+		if param.Denom == lotDenom {
+			return param, true
+		}
+	}
+	return ReserveParam{}, false
 }
 
 // Validate checks that the parameters have valid values.
@@ -121,7 +202,27 @@ func (p Params) Validate() error {
 		return err
 	}
 
-	return validateIncrementCollateralParam(p.IncrementCollateral)
+	if err := validateIncrementCollateralParam(p.IncrementCollateral); err != nil {
+		return err
+	}
+
+	if err := validateDutchAuctionDurationParam(p.DutchAuctionDuration); err != nil {
+		return err
+	}
+
+	if err := validateDutchStartPriceBufferParam(p.DutchStartPriceBuffer); err != nil {
+		return err
+	}
+
+	if err := validateDecayCurve(p.DutchDecayCurve, p.DutchDecayRate); err != nil {
+		return err
+	}
+
+	if err := validateCollateralAuctionParamsParam(p.CollateralAuctionParams); err != nil {
+		return err
+	}
+
+	return validateReserveParamsParam(p.ReserveParams)
 }
 
 func validateBidDurationParam(i interface{}) error {
@@ -200,3 +301,281 @@ func validateIncrementCollateralParam(i interface{}) error {
 
 	return nil
 }
+
+func validateDutchAuctionDurationParam(i interface{}) error {
+	dutchAuctionDuration, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if dutchAuctionDuration <= 0 {
+		return fmt.Errorf("dutch auction duration must be positive %d", dutchAuctionDuration)
+	}
+
+	return nil
+}
+
+func validateDutchStartPriceBufferParam(i interface{}) error {
+	buffer, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if buffer == emptyDec || buffer.IsNil() {
+		return errors.New("dutch auction start price buffer cannot be nil or empty")
+	}
+
+	if buffer.LT(sdk.OneDec()) {
+		return fmt.Errorf("dutch auction start price buffer cannot be less than one %s", buffer)
+	}
+
+	return nil
+}
+
+func validateDutchDecayCurveParam(i interface{}) error {
+	curve, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if curve != LinearDecayCurve && curve != ExponentialDecayCurve {
+		return fmt.Errorf("invalid dutch auction decay curve: %s", curve)
+	}
+
+	return nil
+}
+
+func validateDutchDecayRateParam(i interface{}) error {
+	rate, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if rate == emptyDec || rate.IsNil() {
+		return errors.New("dutch auction decay rate cannot be nil or empty")
+	}
+
+	if rate.IsNegative() || rate.GTE(sdk.OneDec()) {
+		return fmt.Errorf("dutch auction decay rate must be between 0 and 1 %s", rate)
+	}
+
+	return nil
+}
+
+// validateDecayCurve checks a decay curve and its rate, which is only used by exponential curves.
+func validateDecayCurve(curve string, rate sdk.Dec) error {
+	if err := validateDutchDecayCurveParam(curve); err != nil {
+		return err
+	}
+
+	if err := validateDutchDecayRateParam(rate); err != nil {
+		return err
+	}
+
+	if curve == ExponentialDecayCurve && rate.IsZero() {
+		return errors.New("exponential dutch auction decay rate must be positive")
+	}
+
+	return nil
+}
+
+func validateCollateralAuctionParamsParam(i interface{}) error {
+	collateralAuctionParams, ok := i.(CollateralAuctionParams)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return collateralAuctionParams.Validate()
+}
+
+// CollateralAuctionParam overrides the collateral auction params for auctions of a single lot denom
+type CollateralAuctionParam struct {
+	Denom               string        `json:"denom" yaml:"denom"`                               // lot denom the params apply to
+	IncrementCollateral sdk.Dec       `json:"increment_collateral" yaml:"increment_collateral"` // percentage change (of auc.Bid or auc.Lot) required for a new bid
+	BidDuration         time.Duration `json:"bid_duration" yaml:"bid_duration"`                 // additional time added to the auction end time after each bid, capped by the expiry.
+	MaxAuctionDuration  time.Duration `json:"max_auction_duration" yaml:"max_auction_duration"` // max length of auction
+}
+
+// NewCollateralAuctionParam returns a new CollateralAuctionParam
+func NewCollateralAuctionParam(denom string, incrementCollateral sdk.Dec, bidDuration, maxAuctionDuration time.Duration) CollateralAuctionParam {
+	return CollateralAuctionParam{
+		Denom:               denom,
+		IncrementCollateral: incrementCollateral,
+		BidDuration:         bidDuration,
+		MaxAuctionDuration:  maxAuctionDuration,
+	}
+}
+
+// String implements fmt.Stringer
+func (param CollateralAuctionParam) String() string {
+	return fmt.Sprintf(`Collateral Auction Param:
+	Denom: %s
+	Increment Collateral: %s
+	Bid Duration: %s
+	Max Auction Duration: %s`,
+		param.Denom, param.IncrementCollateral, param.BidDuration, param.MaxAuctionDuration)
+}
+
+// Validate performs basic validation of a collateral auction param
+func (param CollateralAuctionParam) Validate() error {
+	if err := sdk.ValidateDenom(param.Denom); err != nil {
+		return fmt.Errorf("collateral auction param denom invalid: %w", err)
+	}
+
+	if err := validateIncrementCollateralParam(param.IncrementCollateral); err != nil {
+		return fmt.Errorf("%s: %w", param.Denom, err)
+	}
+
+	if err := validateBidDurationParam(param.BidDuration); err != nil {
+		return fmt.Errorf("%s: %w", param.Denom, err)
+	}
+
+	if err := validateMaxAuctionDurationParam(param.MaxAuctionDuration); err != nil {
+		return fmt.Errorf("%s: %w", param.Denom, err)
+	}
+
+	if param.BidDuration > param.MaxAuctionDuration {
+		return fmt.Errorf("%s: bid duration param cannot be larger than max auction duration", param.Denom)
+	}
+
+	return nil
+}
+
+// CollateralAuctionParams array of CollateralAuctionParam
+type CollateralAuctionParams []CollateralAuctionParam
+
+// String implements fmt.Stringer
+func (caps CollateralAuctionParams) String() string {
+	out := "Collateral Auction Params\n"
+	for _, param := range caps {
+		out += fmt.Sprintf("%s\n", param)
+	}
+	return out
+}
+
+// Validate checks each override is valid and that there is at most one override per denom
+func (caps CollateralAuctionParams) Validate() error {
+	denoms := make(map[string]bool)
+	for _, param := range caps {
+		if err := param.Validate(); err != nil {
+			return err
+		}
+		if denoms[param.Denom] {
+			return fmt.Errorf("duplicate collateral auction param denom: %s", param.Denom)
+		}
+		denoms[param.Denom] = true
+	}
+	return nil
+}
+
+func validateReserveParamsParam(i interface{}) error {
+	reserveParams, ok := i.(ReserveParams)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return reserveParams.Validate()
+}
+
+// ReserveParam sets the minimum price collateral auctions of a single lot denom can sell their lot at.
+// The reserve price is the current price of the market multiplied by the reserve factor.
+// Conversion factors are the number of decimal places of the lot and bid denoms, used to convert the market price into a price per base unit.
+type ReserveParam struct {
+	Denom                string        `json:"denom" yaml:"denom"`                                   // lot denom the reserve applies to
+	MarketID             string        `json:"market_id" yaml:"market_id"`                           // pricefeed market of the lot denom, quoted in the bid denom
+	ReserveFactor        sdk.Dec       `json:"reserve_factor" yaml:"reserve_factor"`                 // fraction of the market price the lot cannot be sold below
+	LotConversionFactor  sdk.Int       `json:"lot_conversion_factor" yaml:"lot_conversion_factor"`   // decimal places of the lot denom
+	BidConversionFactor  sdk.Int       `json:"bid_conversion_factor" yaml:"bid_conversion_factor"`   // decimal places of the bid denom
+	MaxExtensionDuration time.Duration `json:"max_extension_duration" yaml:"max_extension_duration"` // how long an auction below the reserve price is extended for before it is restarted with a new reserve deadline
+}
+
+// NewReserveParam returns a new ReserveParam
+func NewReserveParam(denom, marketID string, reserveFactor sdk.Dec, lotConversionFactor, bidConversionFactor sdk.Int, maxExtensionDuration time.Duration) ReserveParam {
+	return ReserveParam{
+		Denom:                denom,
+		MarketID:             marketID,
+		ReserveFactor:        reserveFactor,
+		LotConversionFactor:  lotConversionFactor,
+		BidConversionFactor:  bidConversionFactor,
+		MaxExtensionDuration: maxExtensionDuration,
+	}
+}
+
+// String implements fmt.Stringer
+func (param ReserveParam) String() string {
+	return fmt.Sprintf(`Reserve Param:
+	Denom: %s
+	Market ID: %s
+	Reserve Factor: %s
+	Lot Conversion Factor: %s
+	Bid Conversion Factor: %s
+	Max Extension Duration: %s`,
+		param.Denom, param.MarketID, param.ReserveFactor, param.LotConversionFactor, param.BidConversionFactor, param.MaxExtensionDuration)
+}
+
+// Validate performs basic validation of a reserve param
+func (param ReserveParam) Validate() error {
+	if err := sdk.ValidateDenom(param.Denom); err != nil {
+		return fmt.Errorf("reserve param denom invalid: %w", err)
+	}
+
+	if strings.TrimSpace(param.MarketID) == "" {
+		return fmt.Errorf("%s: reserve param market id cannot be blank", param.Denom)
+	}
+
+	if param.ReserveFactor == emptyDec || param.ReserveFactor.IsNil() {
+		return fmt.Errorf("%s: reserve factor cannot be nil or empty", param.Denom)
+	}
+
+	if !param.ReserveFactor.IsPositive() || param.ReserveFactor.GT(sdk.OneDec()) {
+		return fmt.Errorf("%s: reserve factor must be between 0 and 1 %s", param.Denom, param.ReserveFactor)
+	}
+
+	if param.LotConversionFactor.BigInt() == nil || param.LotConversionFactor.IsNegative() || param.LotConversionFactor.GT(sdk.NewInt(MaxConversionFactor)) {
+		return fmt.Errorf("%s: lot conversion factor must be between 0 and %d %s", param.Denom, MaxConversionFactor, param.LotConversionFactor)
+	}
+
+	if param.BidConversionFactor.BigInt() == nil || param.BidConversionFactor.IsNegative() || param.BidConversionFactor.GT(sdk.NewInt(MaxConversionFactor)) {
+		return fmt.Errorf("%s: bid conversion factor must be between 0 and %d %s", param.Denom, MaxConversionFactor, param.BidConversionFactor)
+	}
+
+	if param.MaxExtensionDuration <= 0 {
+		return fmt.Errorf("%s: max extension duration must be positive %s", param.Denom, param.MaxExtensionDuration)
+	}
+
+	return nil
+}
+
+// ReservePrice converts a market price into the reserve price, in base units of the bid denom per base unit of the lot denom
+func (param ReserveParam) ReservePrice(marketPrice sdk.Dec) sdk.Dec {
+	lotUnits := sdk.NewDecFromInt(sdk.NewIntWithDecimal(1, int(param.LotConversionFactor.Int64())))
+	bidUnits := sdk.NewDecFromInt(sdk.NewIntWithDecimal(1, int(param.BidConversionFactor.Int64())))
+	return marketPrice.Mul(param.ReserveFactor).Mul(bidUnits).Quo(lotUnits)
+}
+
+// ReserveParams array of ReserveParam
+type ReserveParams []ReserveParam
+
+// String implements fmt.Stringer
+func (params ReserveParams) String() string {
+	out := "Reserve Params\n"
+	for _, param := range params {
+		out += fmt.Sprintf("%s\n", param)
+	}
+	return out
+}
+
+// Validate checks each reserve is valid and that there is at most one reserve per denom
+func (params ReserveParams) Validate() error {
+	denoms := make(map[string]bool)
+	for _, param := range params {
+		if err := param.Validate(); err != nil {
+			return err
+		}
+		if denoms[param.Denom] {
+			return fmt.Errorf("duplicate reserve param denom: %s", param.Denom)
+		}
+		denoms[param.Denom] = true
+	}
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -86,6 +87,336 @@ func TestParams_Validate(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"valid exponential dutch auction",
+			Params{
+				MaxAuctionDuration:    24 * time.Hour,
+				BidDuration:           1 * time.Hour,
+				IncrementSurplus:      d("0.05"),
+				IncrementDebt:         d("0.05"),
+				IncrementCollateral:   d("0.05"),
+				DutchAuctionDuration:  6 * time.Hour,
+				DutchStartPriceBuffer: d("1.2"),
+				DutchDecayCurve:       ExponentialDecayCurve,
+				DutchDecayRate:        d("0.001"),
+			},
+			false,
+		},
+		{
+			"zero dutch auction duration",
+			Params{
+				MaxAuctionDuration:    24 * time.Hour,
+				BidDuration:           1 * time.Hour,
+				IncrementSurplus:      d("0.05"),
+				IncrementDebt:         d("0.05"),
+				IncrementCollateral:   d("0.05"),
+				DutchAuctionDuration:  0,
+				DutchStartPriceBuffer: d("1.2"),
+				DutchDecayCurve:       LinearDecayCurve,
+				DutchDecayRate:        d("0"),
+			},
+			true,
+		},
+		{
+			"dutch start price buffer below one",
+			Params{
+				MaxAuctionDuration:    24 * time.Hour,
+				BidDuration:           1 * time.Hour,
+				IncrementSurplus:      d("0.05"),
+				IncrementDebt:         d("0.05"),
+				IncrementCollateral:   d("0.05"),
+				DutchAuctionDuration:  6 * time.Hour,
+				DutchStartPriceBuffer: d("0.9"),
+				DutchDecayCurve:       LinearDecayCurve,
+				DutchDecayRate:        d("0"),
+			},
+			true,
+		},
+		{
+			"invalid dutch decay curve",
+			Params{
+				MaxAuctionDuration:    24 * time.Hour,
+				BidDuration:           1 * time.Hour,
+				IncrementSurplus:      d("0.05"),
+				IncrementDebt:         d("0.05"),
+				IncrementCollateral:   d("0.05"),
+				DutchAuctionDuration:  6 * time.Hour,
+				DutchStartPriceBuffer: d("1.2"),
+				DutchDecayCurve:       "quadratic",
+				DutchDecayRate:        d("0"),
+			},
+			true,
+		},
+		{
+			"zero exponential dutch decay rate",
+			Params{
+				MaxAuctionDuration:    24 * time.Hour,
+				BidDuration:           1 * time.Hour,
+				IncrementSurplus:      d("0.05"),
+				IncrementDebt:         d("0.05"),
+				IncrementCollateral:   d("0.05"),
+				DutchAuctionDuration:  6 * time.Hour,
+				DutchStartPriceBuffer: d("1.2"),
+				DutchDecayCurve:       ExponentialDecayCurve,
+				DutchDecayRate:        d("0"),
+			},
+			true,
+		},
+		{
+			"valid collateral auction params",
+			Params{
+				MaxAuctionDuration:    24 * time.Hour,
+				BidDuration:           1 * time.Hour,
+				IncrementSurplus:      d("0.05"),
+				IncrementDebt:         d("0.05"),
+				IncrementCollateral:   d("0.05"),
+				DutchAuctionDuration:  6 * time.Hour,
+				DutchStartPriceBuffer: d("1.2"),
+				DutchDecayCurve:       LinearDecayCurve,
+				DutchDecayRate:        d("0"),
+				CollateralAuctionParams: CollateralAuctionParams{
+					NewCollateralAuctionParam("bnb", d("0.1"), 30*time.Minute, 6*time.Hour),
+					NewCollateralAuctionParam("xrpb", d("0.02"), 2*time.Hour, 72*time.Hour),
+				},
+			},
+			false,
+		},
+		{
+			"invalid collateral auction param denom",
+			Params{
+				MaxAuctionDuration:    24 * time.Hour,
+				BidDuration:           1 * time.Hour,
+				IncrementSurplus:      d("0.05"),
+				IncrementDebt:         d("0.05"),
+				IncrementCollateral:   d("0.05"),
+				DutchAuctionDuration:  6 * time.Hour,
+				DutchStartPriceBuffer: d("1.2"),
+				DutchDecayCurve:       LinearDecayCurve,
+				DutchDecayRate:        d("0"),
+				CollateralAuctionParams: CollateralAuctionParams{
+					NewCollateralAuctionParam("", d("0.1"), 30*time.Minute, 6*time.Hour),
+				},
+			},
+			true,
+		},
+		{
+			"negative collateral auction param increment",
+			Params{
+				MaxAuctionDuration:    24 * time.Hour,
+				BidDuration:           1 * time.Hour,
+				IncrementSurplus:      d("0.05"),
+				IncrementDebt:         d("0.05"),
+				IncrementCollateral:   d("0.05"),
+				DutchAuctionDuration:  6 * time.Hour,
+				DutchStartPriceBuffer: d("1.2"),
+				DutchDecayCurve:       LinearDecayCurve,
+				DutchDecayRate:        d("0"),
+				CollateralAuctionParams: CollateralAuctionParams{
+					NewCollateralAuctionParam("bnb", d("-0.1"), 30*time.Minute, 6*time.Hour),
+				},
+			},
+			true,
+		},
+		{
+			"collateral auction param bid>auction",
+			Params{
+				MaxAuctionDuration:    24 * time.Hour,
+				BidDuration:           1 * time.Hour,
+				IncrementSurplus:      d("0.05"),
+				IncrementDebt:         d("0.05"),
+				IncrementCollateral:   d("0.05"),
+				DutchAuctionDuration:  6 * time.Hour,
+				DutchStartPriceBuffer: d("1.2"),
+				DutchDecayCurve:       LinearDecayCurve,
+				DutchDecayRate:        d("0"),
+				CollateralAuctionParams: CollateralAuctionParams{
+					NewCollateralAuctionParam("bnb", d("0.1"), 12*time.Hour, 6*time.Hour),
+				},
+			},
+			true,
+		},
+		{
+			"duplicate collateral auction param denom",
+			Params{
+				MaxAuctionDuration:    24 * time.Hour,
+				BidDuration:           1 * time.Hour,
+				IncrementSurplus:      d("0.05"),
+				IncrementDebt:         d("0.05"),
+				IncrementCollateral:   d("0.05"),
+				DutchAuctionDuration:  6 * time.Hour,
+				DutchStartPriceBuffer: d("1.2"),
+				DutchDecayCurve:       LinearDecayCurve,
+				DutchDecayRate:        d("0"),
+				CollateralAuctionParams: CollateralAuctionParams{
+					NewCollateralAuctionParam("bnb", d("0.1"), 30*time.Minute, 6*time.Hour),
+					NewCollateralAuctionParam("bnb", d("0.02"), 2*time.Hour, 72*time.Hour),
+				},
+			},
+			true,
+		},
+		{
+			"valid reserve params",
+			Params{
+				MaxAuctionDuration:    24 * time.Hour,
+				BidDuration:           1 * time.Hour,
+				IncrementSurplus:      d("0.05"),
+				IncrementDebt:         d("0.05"),
+				IncrementCollateral:   d("0.05"),
+				DutchAuctionDuration:  6 * time.Hour,
+				DutchStartPriceBuffer: d("1.2"),
+				DutchDecayCurve:       LinearDecayCurve,
+				DutchDecayRate:        d("0"),
+				ReserveParams: ReserveParams{
+					NewReserveParam("bnb", "bnb:usd", d("0.8"), sdk.NewInt(8), sdk.NewInt(6), 24*time.Hour),
+				},
+			},
+			false,
+		},
+		{
+			"blank reserve param market id",
+			Params{
+				MaxAuctionDuration:    24 * time.Hour,
+				BidDuration:           1 * time.Hour,
+				IncrementSurplus:      d("0.05"),
+				IncrementDebt:         d("0.05"),
+				IncrementCollateral:   d("0.05"),
+				DutchAuctionDuration:  6 * time.Hour,
+				DutchStartPriceBuffer: d("1.2"),
+				DutchDecayCurve:       LinearDecayCurve,
+				DutchDecayRate:        d("0"),
+				ReserveParams: ReserveParams{
+					NewReserveParam("bnb", "", d("0.8"), sdk.NewInt(8), sdk.NewInt(6), 24*time.Hour),
+				},
+			},
+			true,
+		},
+		{
+			"zero reserve factor",
+			Params{
+				MaxAuctionDuration:    24 * time.Hour,
+				BidDuration:           1 * time.Hour,
+				IncrementSurplus:      d("0.05"),
+				IncrementDebt:         d("0.05"),
+				IncrementCollateral:   d("0.05"),
+				DutchAuctionDuration:  6 * time.Hour,
+				DutchStartPriceBuffer: d("1.2"),
+				DutchDecayCurve:       LinearDecayCurve,
+				DutchDecayRate:        d("0"),
+				ReserveParams: ReserveParams{
+					NewReserveParam("bnb", "bnb:usd", d("0"), sdk.NewInt(8), sdk.NewInt(6), 24*time.Hour),
+				},
+			},
+			true,
+		},
+		{
+			"reserve factor above one",
+			Params{
+				MaxAuctionDuration:    24 * time.Hour,
+				BidDuration:           1 * time.Hour,
+				IncrementSurplus:      d("0.05"),
+				IncrementDebt:         d("0.05"),
+				IncrementCollateral:   d("0.05"),
+				DutchAuctionDuration:  6 * time.Hour,
+				DutchStartPriceBuffer: d("1.2"),
+				DutchDecayCurve:       LinearDecayCurve,
+				DutchDecayRate:        d("0"),
+				ReserveParams: ReserveParams{
+					NewReserveParam("bnb", "bnb:usd", d("1.1"), sdk.NewInt(8), sdk.NewInt(6), 24*time.Hour),
+				},
+			},
+			true,
+		},
+		{
+			"negative reserve param conversion factor",
+			Params{
+				MaxAuctionDuration:    24 * time.Hour,
+				BidDuration:           1 * time.Hour,
+				IncrementSurplus:      d("0.05"),
+				IncrementDebt:         d("0.05"),
+				IncrementCollateral:   d("0.05"),
+				DutchAuctionDuration:  6 * time.Hour,
+				DutchStartPriceBuffer: d("1.2"),
+				DutchDecayCurve:       LinearDecayCurve,
+				DutchDecayRate:        d("0"),
+				ReserveParams: ReserveParams{
+					NewReserveParam("bnb", "bnb:usd", d("0.8"), sdk.NewInt(-8), sdk.NewInt(6), 24*time.Hour),
+				},
+			},
+			true,
+		},
+		{
+			"reserve param lot conversion factor out of range",
+			Params{
+				MaxAuctionDuration:    24 * time.Hour,
+				BidDuration:           1 * time.Hour,
+				IncrementSurplus:      d("0.05"),
+				IncrementDebt:         d("0.05"),
+				IncrementCollateral:   d("0.05"),
+				DutchAuctionDuration:  6 * time.Hour,
+				DutchStartPriceBuffer: d("1.2"),
+				DutchDecayCurve:       LinearDecayCurve,
+				DutchDecayRate:        d("0"),
+				ReserveParams: ReserveParams{
+					NewReserveParam("bnb", "bnb:usd", d("0.8"), sdk.NewInt(19), sdk.NewInt(6), 24*time.Hour),
+				},
+			},
+			true,
+		},
+		{
+			"reserve param bid conversion factor out of range",
+			Params{
+				MaxAuctionDuration:    24 * time.Hour,
+				BidDuration:           1 * time.Hour,
+				IncrementSurplus:      d("0.05"),
+				IncrementDebt:         d("0.05"),
+				IncrementCollateral:   d("0.05"),
+				DutchAuctionDuration:  6 * time.Hour,
+				DutchStartPriceBuffer: d("1.2"),
+				DutchDecayCurve:       LinearDecayCurve,
+				DutchDecayRate:        d("0"),
+				ReserveParams: ReserveParams{
+					NewReserveParam("bnb", "bnb:usd", d("0.8"), sdk.NewInt(8), sdk.NewIntWithDecimal(1, 20), 24*time.Hour),
+				},
+			},
+			true,
+		},
+		{
+			"zero reserve param max extension duration",
+			Params{
+				MaxAuctionDuration:    24 * time.Hour,
+				BidDuration:           1 * time.Hour,
+				IncrementSurplus:      d("0.05"),
+				IncrementDebt:         d("0.05"),
+				IncrementCollateral:   d("0.05"),
+				DutchAuctionDuration:  6 * time.Hour,
+				DutchStartPriceBuffer: d("1.2"),
+				DutchDecayCurve:       LinearDecayCurve,
+				DutchDecayRate:        d("0"),
+				ReserveParams: ReserveParams{
+					NewReserveParam("bnb", "bnb:usd", d("0.8"), sdk.NewInt(8), sdk.NewInt(6), 0),
+				},
+			},
+			true,
+		},
+		{
+			"duplicate reserve param denom",
+			Params{
+				MaxAuctionDuration:    24 * time.Hour,
+				BidDuration:           1 * time.Hour,
+				IncrementSurplus:      d("0.05"),
+				IncrementDebt:         d("0.05"),
+				IncrementCollateral:   d("0.05"),
+				DutchAuctionDuration:  6 * time.Hour,
+				DutchStartPriceBuffer: d("1.2"),
+				DutchDecayCurve:       LinearDecayCurve,
+				DutchDecayRate:        d("0"),
+				ReserveParams: ReserveParams{
+					NewReserveParam("bnb", "bnb:usd", d("0.8"), sdk.NewInt(8), sdk.NewInt(6), 24*time.Hour),
+					NewReserveParam("bnb", "bnb:usd:30", d("0.9"), sdk.NewInt(8), sdk.NewInt(6), 24*time.Hour),
+				},
+			},
+			true,
+		},
 		{
 			"zero value",
 			Params{},
@@ -103,3 +434,27 @@ func TestParams_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestParams_GetCollateralAuctionParam(t *testing.T) {
+	p := DefaultParams()
+	p.CollateralAuctionParams = CollateralAuctionParams{
+		NewCollateralAuctionParam("bnb", d("0.1"), 30*time.Minute, 6*time.Hour),
+	}
+
+	require.Equal(t, NewCollateralAuctionParam("bnb", d("0.1"), 30*time.Minute, 6*time.Hour), p.GetCollateralAuctionParam("bnb"))
+	require.Equal(t, NewCollateralAuctionParam("btcb", p.IncrementCollateral, p.BidDuration, p.MaxAuctionDuration), p.GetCollateralAuctionParam("btcb"))
+}
+
+func TestReserveParam_ReservePrice(t *testing.T) {
+	// 80% of $300 per bnb, converted to usdx base units (6 decimals) per bnb base unit (8 decimals)
+	param := NewReserveParam("bnb", "bnb:usd", d("0.8"), sdk.NewInt(8), sdk.NewInt(6), 24*time.Hour)
+	require.Equal(t, d("2.4"), param.ReservePrice(d("300")))
+
+	p := DefaultParams()
+	p.ReserveParams = ReserveParams{param}
+	found, ok := p.GetReserveParam("bnb")
+	require.True(t, ok)
+	require.Equal(t, param, found)
+	_, ok = p.GetReserveParam("btcb")
+	require.False(t, ok)
+}
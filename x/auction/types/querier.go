@@ -13,6 +13,10 @@ const (
 	QueryGetParams = "params"
 	// QueryNextAuctionID is the query path for querying the id of the next auction
 	QueryNextAuctionID = "next-auction-id"
+	// QueryGetBidHistory is the query path for querying the bids placed on an auction
+	QueryGetBidHistory = "bid-history"
+	// QueryGetAuctionsByBidder is the query path for querying the auctions a bidder is currently winning
+	QueryGetAuctionsByBidder = "auctions-by-bidder"
 )
 
 // QueryAuctionParams params for query /auction/auction
@@ -49,6 +53,22 @@ func NewQueryAllAuctionParams(page, limit int, aucType, aucDenom, aucPhase strin
 	}
 }
 
+// QueryAuctionsByBidderParams is the params for an auctions by bidder query
+type QueryAuctionsByBidderParams struct {
+	Page   int            `json:"page" yaml:"page"`
+	Limit  int            `json:"limit" yaml:"limit"`
+	Bidder sdk.AccAddress `json:"bidder" yaml:"bidder"`
+}
+
+// NewQueryAuctionsByBidderParams creates a new QueryAuctionsByBidderParams
+func NewQueryAuctionsByBidderParams(page, limit int, bidder sdk.AccAddress) QueryAuctionsByBidderParams {
+	return QueryAuctionsByBidderParams{
+		Page:   page,
+		Limit:  limit,
+		Bidder: bidder,
+	}
+}
+
 // AuctionWithPhase augmented type for collateral auctions which includes auction phase for querying
 type AuctionWithPhase struct {
 	Auction Auction `json:"auction" yaml:"auction"`
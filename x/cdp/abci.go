@@ -31,7 +31,7 @@ func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k Keeper) {
 			continue
 		}
 
-		err := k.UpdateFeesForAllCdps(ctx, cp.Type)
+		err := k.AccumulateInterest(ctx, cp.Type)
 		if err != nil {
 			panic(err)
 		}
@@ -52,9 +52,11 @@ func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k Keeper) {
 		return
 	}
 
-	err = k.DistributeSavingsRate(ctx, params.DebtParam.Denom)
-	if err != nil {
-		panic(err)
+	for _, dp := range params.DebtParams {
+		err = k.DistributeSavingsRate(ctx, dp.Denom)
+		if err != nil {
+			panic(err)
+		}
 	}
 
 	k.SetPreviousSavingsDistribution(ctx, ctx.BlockTime())
@@ -148,7 +148,7 @@ func (suite *ModuleTestSuite) TestBeginBlock() {
 	suite.Equal(len(suite.liquidations.btc), btcLiquidations)
 
 	acc = sk.GetModuleAccount(suite.ctx, auction.ModuleName)
-	suite.Equal(suite.liquidations.debt, acc.GetCoins().AmountOf("debt").Int64())
+	suite.Equal(suite.liquidations.debt, acc.GetCoins().AmountOf("debtusdx").Int64())
 
 }
 
@@ -158,14 +158,16 @@ func (suite *ModuleTestSuite) TestSeizeSingleCdpWithFees() {
 	suite.Equal(i(1000000000), suite.keeper.GetTotalPrincipal(suite.ctx, "xrp-a", "usdx"))
 	sk := suite.app.GetSupplyKeeper()
 	cdpMacc := sk.GetModuleAccount(suite.ctx, cdp.ModuleName)
-	suite.Equal(i(1000000000), cdpMacc.GetCoins().AmountOf("debt"))
+	suite.Equal(i(1000000000), cdpMacc.GetCoins().AmountOf("debtusdx"))
 	for i := 0; i < 100; i++ {
 		suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Second * 6))
 		cdp.BeginBlocker(suite.ctx, abci.RequestBeginBlock{Header: suite.ctx.BlockHeader()}, suite.keeper)
 	}
 
+	// the first begin blocker only records the accrual time, so interest accumulates for 99 blocks
 	cdpMacc = sk.GetModuleAccount(suite.ctx, cdp.ModuleName)
-	suite.Equal(i(1000000900), (cdpMacc.GetCoins().AmountOf("debt")))
+	suite.Equal(i(1000000891), (cdpMacc.GetCoins().AmountOf("debtusdx")))
+	suite.Equal(i(1000000891), suite.keeper.GetTotalPrincipal(suite.ctx, "xrp-a", "usdx"))
 	cdp, _ := suite.keeper.GetCDP(suite.ctx, "xrp-a", 1)
 
 	err = suite.keeper.SeizeCollateral(suite.ctx, cdp)
@@ -9,19 +9,32 @@ import (
 
 const (
 	BaseDigitFactor                         = keeper.BaseDigitFactor
+	AttributeKeyAmount                      = types.AttributeKeyAmount
 	AttributeKeyCdpID                       = types.AttributeKeyCdpID
 	AttributeKeyDeposit                     = types.AttributeKeyDeposit
+	AttributeKeyDepositor                   = types.AttributeKeyDepositor
 	AttributeKeyError                       = types.AttributeKeyError
+	AttributeKeyKeeper                      = types.AttributeKeyKeeper
+	AttributeKeyMarketID                    = types.AttributeKeyMarketID
+	AttributeKeyOwner                       = types.AttributeKeyOwner
+	AttributeKeyRecipient                   = types.AttributeKeyRecipient
+	AttributeKeyReward                      = types.AttributeKeyReward
+	AttributeKeyShares                      = types.AttributeKeyShares
 	AttributeValueCategory                  = types.AttributeValueCategory
 	DefaultParamspace                       = types.DefaultParamspace
 	EventTypeBeginBlockerFatal              = types.EventTypeBeginBlockerFatal
 	EventTypeCdpClose                       = types.EventTypeCdpClose
 	EventTypeCdpDeposit                     = types.EventTypeCdpDeposit
 	EventTypeCdpDraw                        = types.EventTypeCdpDraw
+	EventTypeCdpKeeperReward                = types.EventTypeCdpKeeperReward
 	EventTypeCdpLiquidation                 = types.EventTypeCdpLiquidation
 	EventTypeCdpRepay                       = types.EventTypeCdpRepay
+	EventTypeCdpTransfer                    = types.EventTypeCdpTransfer
 	EventTypeCdpWithdrawal                  = types.EventTypeCdpWithdrawal
 	EventTypeCreateCdp                      = types.EventTypeCreateCdp
+	EventTypeLiquidationPriceFallback       = types.EventTypeLiquidationPriceFallback
+	EventTypeSavingsDeposit                 = types.EventTypeSavingsDeposit
+	EventTypeSavingsWithdrawal              = types.EventTypeSavingsWithdrawal
 	LiquidatorMacc                          = types.LiquidatorMacc
 	ModuleName                              = types.ModuleName
 	QuerierRoute                            = types.QuerierRoute
@@ -31,19 +44,25 @@ const (
 	QueryGetCdps                            = types.QueryGetCdps
 	QueryGetCdpsByCollateralType            = types.QueryGetCdpsByCollateralType
 	QueryGetCdpsByCollateralization         = types.QueryGetCdpsByCollateralization
+	QueryGetCollateralTypeRisks             = types.QueryGetCollateralTypeRisks
 	QueryGetParams                          = types.QueryGetParams
 	QueryGetPreviousSavingsDistributionTime = types.QueryGetPreviousSavingsDistributionTime
+	QueryGetSavingsPosition                 = types.QueryGetSavingsPosition
 	QueryGetSavingsRateDistributed          = types.QueryGetSavingsRateDistributed
 	RestCollateralType                      = types.RestCollateralType
+	RestDenom                               = types.RestDenom
+	RestDepositor                           = types.RestDepositor
 	RestOwner                               = types.RestOwner
 	RestRatio                               = types.RestRatio
 	RouterKey                               = types.RouterKey
 	SavingsRateMacc                         = types.SavingsRateMacc
+	SavingsVaultMacc                        = types.SavingsVaultMacc
 	StoreKey                                = types.StoreKey
 )
 
 var (
 	// function aliases
+	CalculateInterestFactor            = keeper.CalculateInterestFactor
 	FilterCDPs                         = keeper.FilterCDPs
 	FindIntersection                   = keeper.FindIntersection
 	NewKeeper                          = keeper.NewKeeper
@@ -52,6 +71,7 @@ var (
 	CollateralRatioBytes               = types.CollateralRatioBytes
 	CollateralRatioIterKey             = types.CollateralRatioIterKey
 	CollateralRatioKey                 = types.CollateralRatioKey
+	DebtCoinDenom                      = types.DebtCoinDenom
 	DefaultGenesisState                = types.DefaultGenesisState
 	DefaultParams                      = types.DefaultParams
 	DenomIterKey                       = types.DenomIterKey
@@ -62,25 +82,38 @@ var (
 	NewAugmentedCDP                    = types.NewAugmentedCDP
 	NewCDP                             = types.NewCDP
 	NewCDPWithFees                     = types.NewCDPWithFees
+	NewCdpTotals                       = types.NewCdpTotals
 	NewCollateralParam                 = types.NewCollateralParam
+	NewCollateralTypeRisk              = types.NewCollateralTypeRisk
 	NewDebtParam                       = types.NewDebtParam
 	NewDeposit                         = types.NewDeposit
+	NewGenesisAccumulationTime         = types.NewGenesisAccumulationTime
 	NewGenesisState                    = types.NewGenesisState
 	NewMsgCreateCDP                    = types.NewMsgCreateCDP
 	NewMsgDeposit                      = types.NewMsgDeposit
+	NewMsgDepositSavings               = types.NewMsgDepositSavings
 	NewMsgDrawDebt                     = types.NewMsgDrawDebt
+	NewMsgLiquidate                    = types.NewMsgLiquidate
 	NewMsgRepayDebt                    = types.NewMsgRepayDebt
+	NewMsgTransferCDP                  = types.NewMsgTransferCDP
 	NewMsgWithdraw                     = types.NewMsgWithdraw
+	NewMsgWithdrawSavings              = types.NewMsgWithdrawSavings
 	NewParams                          = types.NewParams
 	NewQueryCdpDeposits                = types.NewQueryCdpDeposits
 	NewQueryCdpParams                  = types.NewQueryCdpParams
 	NewQueryCdpsByCollateralTypeParams = types.NewQueryCdpsByCollateralTypeParams
 	NewQueryCdpsByRatioParams          = types.NewQueryCdpsByRatioParams
 	NewQueryCdpsParams                 = types.NewQueryCdpsParams
+	NewQueryCollateralTypeRisksParams  = types.NewQueryCollateralTypeRisksParams
+	NewQuerySavingsPositionParams      = types.NewQuerySavingsPositionParams
+	NewSavingsDeposit                  = types.NewSavingsDeposit
+	NewSavingsPosition                 = types.NewSavingsPosition
 	ParamKeyTable                      = types.ParamKeyTable
 	ParseDecBytes                      = types.ParseDecBytes
 	RegisterCodec                      = types.RegisterCodec
 	RelativePow                        = types.RelativePow
+	SavingsDepositIterKey              = types.SavingsDepositIterKey
+	SavingsDepositKey                  = types.SavingsDepositKey
 	SortableDecBytes                   = types.SortableDecBytes
 	SplitCdpKey                        = types.SplitCdpKey
 	SplitCollateralRatioIterKey        = types.SplitCollateralRatioIterKey
@@ -94,6 +127,7 @@ var (
 	CdpIDKey                            = types.CdpIDKey
 	CdpIDKeyPrefix                      = types.CdpIDKeyPrefix
 	CdpKeyPrefix                        = types.CdpKeyPrefix
+	CdpTotalsPrefix                     = types.CdpTotalsPrefix
 	CollateralRatioIndexPrefix          = types.CollateralRatioIndexPrefix
 	DebtDenomKey                        = types.DebtDenomKey
 	DefaultCdpStartingID                = types.DefaultCdpStartingID
@@ -102,6 +136,7 @@ var (
 	DefaultDebtDenom                    = types.DefaultDebtDenom
 	DefaultDebtLot                      = types.DefaultDebtLot
 	DefaultDebtParam                    = types.DefaultDebtParam
+	DefaultDebtParams                   = types.DefaultDebtParams
 	DefaultDebtThreshold                = types.DefaultDebtThreshold
 	DefaultGlobalDebt                   = types.DefaultGlobalDebt
 	DefaultGovDenom                     = types.DefaultGovDenom
@@ -122,32 +157,36 @@ var (
 	ErrDepositNotAvailable              = types.ErrDepositNotAvailable
 	ErrDepositNotFound                  = types.ErrDepositNotFound
 	ErrExceedsDebtLimit                 = types.ErrExceedsDebtLimit
+	ErrInsufficientSavings              = types.ErrInsufficientSavings
 	ErrInvalidCollateral                = types.ErrInvalidCollateral
 	ErrInvalidCollateralLength          = types.ErrInvalidCollateralLength
 	ErrInvalidCollateralRatio           = types.ErrInvalidCollateralRatio
 	ErrInvalidDebtRequest               = types.ErrInvalidDebtRequest
 	ErrInvalidDeposit                   = types.ErrInvalidDeposit
 	ErrInvalidPayment                   = types.ErrInvalidPayment
+	ErrInvalidSavingsDeposit            = types.ErrInvalidSavingsDeposit
 	ErrInvalidWithdrawAmount            = types.ErrInvalidWithdrawAmount
 	ErrLoadingAugmentedCDP              = types.ErrLoadingAugmentedCDP
+	ErrNotLiquidatable                  = types.ErrNotLiquidatable
 	ErrPricefeedDown                    = types.ErrPricefeedDown
+	ErrSavingsDepositNotFound           = types.ErrSavingsDepositNotFound
 	GovDenomKey                         = types.GovDenomKey
+	InterestFactorPrefix                = types.InterestFactorPrefix
 	KeyCircuitBreaker                   = types.KeyCircuitBreaker
 	KeyCollateralParams                 = types.KeyCollateralParams
-	KeyDebtLot                          = types.KeyDebtLot
-	KeyDebtParam                        = types.KeyDebtParam
-	KeyDebtThreshold                    = types.KeyDebtThreshold
+	KeyDebtParams                       = types.KeyDebtParams
 	KeyDistributionFrequency            = types.KeyDistributionFrequency
 	KeyGlobalDebtLimit                  = types.KeyGlobalDebtLimit
 	KeySavingsRateDistributed           = types.KeySavingsRateDistributed
-	KeySurplusLot                       = types.KeySurplusLot
-	KeySurplusThreshold                 = types.KeySurplusThreshold
 	MaxSortableDec                      = types.MaxSortableDec
 	ModuleCdc                           = types.ModuleCdc
+	PreviousAccrualTimePrefix           = types.PreviousAccrualTimePrefix
 	PreviousDistributionTimeKey         = types.PreviousDistributionTimeKey
 	PricefeedStatusKeyPrefix            = types.PricefeedStatusKeyPrefix
 	PrincipalKeyPrefix                  = types.PrincipalKeyPrefix
-	SavingsRateDistributedKey           = types.SavingsRateDistributedKey
+	SavingsDepositPrefix                = types.SavingsDepositPrefix
+	SavingsRateDistributedPrefix        = types.SavingsRateDistributedPrefix
+	SavingsSharesPrefix                 = types.SavingsSharesPrefix
 )
 
 type (
@@ -158,18 +197,27 @@ type (
 	AugmentedCDPs                   = types.AugmentedCDPs
 	CDP                             = types.CDP
 	CDPs                            = types.CDPs
+	CdpTotals                       = types.CdpTotals
 	CollateralParam                 = types.CollateralParam
 	CollateralParams                = types.CollateralParams
+	CollateralTypeRisk              = types.CollateralTypeRisk
+	CollateralTypeRisks             = types.CollateralTypeRisks
 	DebtParam                       = types.DebtParam
 	DebtParams                      = types.DebtParams
 	Deposit                         = types.Deposit
 	Deposits                        = types.Deposits
+	GenesisAccumulationTime         = types.GenesisAccumulationTime
+	GenesisAccumulationTimes        = types.GenesisAccumulationTimes
 	GenesisState                    = types.GenesisState
 	MsgCreateCDP                    = types.MsgCreateCDP
 	MsgDeposit                      = types.MsgDeposit
+	MsgDepositSavings               = types.MsgDepositSavings
 	MsgDrawDebt                     = types.MsgDrawDebt
+	MsgLiquidate                    = types.MsgLiquidate
 	MsgRepayDebt                    = types.MsgRepayDebt
+	MsgTransferCDP                  = types.MsgTransferCDP
 	MsgWithdraw                     = types.MsgWithdraw
+	MsgWithdrawSavings              = types.MsgWithdrawSavings
 	Params                          = types.Params
 	PricefeedKeeper                 = types.PricefeedKeeper
 	QueryCdpDeposits                = types.QueryCdpDeposits
@@ -177,5 +225,10 @@ type (
 	QueryCdpsByCollateralTypeParams = types.QueryCdpsByCollateralTypeParams
 	QueryCdpsByRatioParams          = types.QueryCdpsByRatioParams
 	QueryCdpsParams                 = types.QueryCdpsParams
+	QueryCollateralTypeRisksParams  = types.QueryCollateralTypeRisksParams
+	QuerySavingsPositionParams      = types.QuerySavingsPositionParams
+	SavingsDeposit                  = types.SavingsDeposit
+	SavingsDeposits                 = types.SavingsDeposits
+	SavingsPosition                 = types.SavingsPosition
 	SupplyKeeper                    = types.SupplyKeeper
 )
@@ -43,6 +43,8 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		QueryGetAccounts(queryRoute, cdc),
 		QueryGetSavingsRateDistributed(queryRoute, cdc),
 		QueryGetSavingsRateDistTime(queryRoute, cdc),
+		QuerySavingsPositionCmd(queryRoute, cdc),
+		QueryCollateralTypeRisksCmd(queryRoute, cdc),
 	)...)
 
 	return cdpQueryCmd
@@ -285,11 +287,11 @@ func QueryGetAccounts(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	}
 }
 
-// QueryGetSavingsRateDistributed queries the total amount of savings rate distributed in USDX
+// QueryGetSavingsRateDistributed queries the total amount of savings rate distributed for each debt asset
 func QueryGetSavingsRateDistributed(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
 		Use:   "savings-rate-dist",
-		Short: "get total amount of savings rate distributed in USDX",
+		Short: "get total amount of savings rate distributed for each debt asset",
 		Long:  "get total amount of savings rate distributed",
 		Args:  cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -303,9 +305,9 @@ func QueryGetSavingsRateDistributed(queryRoute string, cdc *codec.Codec) *cobra.
 			cliCtx = cliCtx.WithHeight(height)
 
 			// Decode and print results
-			var out sdk.Int
+			var out sdk.Coins
 			if err := cdc.UnmarshalJSON(res, &out); err != nil {
-				return fmt.Errorf("failed to unmarshal sdk.Int: %w", err)
+				return fmt.Errorf("failed to unmarshal sdk.Coins: %w", err)
 			}
 			return cliCtx.PrintOutput(out)
 		},
@@ -337,3 +339,87 @@ func QueryGetSavingsRateDistTime(queryRoute string, cdc *codec.Codec) *cobra.Com
 		},
 	}
 }
+
+// QuerySavingsPositionCmd returns the command handler for querying a depositor's savings position
+func QuerySavingsPositionCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "savings-position [depositor-addr] [denom]",
+		Short: "get the savings deposit of a depositor",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Get the savings vault shares of a depositor and the amount they can currently be withdrawn for.
+
+Example:
+$ %s query %s savings-position kava15qdefkmwswysgg4qxgqpqr35k3m49pkx2jdfnw usdx
+`, version.ClientName, types.ModuleName)),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// Prepare params for querier
+			depositor, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			bz, err := cdc.MarshalJSON(types.NewQuerySavingsPositionParams(depositor, args[1]))
+			if err != nil {
+				return err
+			}
+
+			// Query
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryGetSavingsPosition)
+			res, height, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+			cliCtx = cliCtx.WithHeight(height)
+
+			// Decode and print results
+			var position types.SavingsPosition
+			cdc.MustUnmarshalJSON(res, &position)
+			return cliCtx.PrintOutput(position)
+		},
+	}
+}
+
+// QueryCollateralTypeRisksCmd returns the command handler for querying the debt limit utilisation and collateralization of collateral types
+func QueryCollateralTypeRisksCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "risk [collateral-type]",
+		Short: "get the debt limit utilisation and collateralization of collateral types",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Get the total principal, fees, remaining debt limit, collateral value and collateralization ratio of the cdps of a collateral type.
+Returns every collateral type if none is given. Figures are per collateral type; there is no system-wide collateralization ratio.
+
+Example:
+$ %s query %s risk
+$ %s query %s risk bnb-a
+`, version.ClientName, types.ModuleName, version.ClientName, types.ModuleName)),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// Prepare params for querier
+			collateralType := ""
+			if len(args) > 0 {
+				collateralType = args[0]
+			}
+			bz, err := cdc.MarshalJSON(types.NewQueryCollateralTypeRisksParams(collateralType))
+			if err != nil {
+				return err
+			}
+
+			// Query
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryGetCollateralTypeRisks)
+			res, height, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+			cliCtx = cliCtx.WithHeight(height)
+
+			// Decode and print results
+			var risks types.CollateralTypeRisks
+			cdc.MustUnmarshalJSON(res, &risks)
+			return cliCtx.PrintOutput(risks)
+		},
+	}
+}
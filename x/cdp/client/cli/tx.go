@@ -31,6 +31,10 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 		GetCmdWithdraw(cdc),
 		GetCmdDraw(cdc),
 		GetCmdRepay(cdc),
+		GetCmdLiquidate(cdc),
+		GetCmdTransfer(cdc),
+		GetCmdDepositSavings(cdc),
+		GetCmdWithdrawSavings(cdc),
 	)...)
 
 	return cdpTxCmd
@@ -202,3 +206,127 @@ $ %s tx %s repay atom-a 1000usdx --from myKeyName
 		},
 	}
 }
+
+// GetCmdLiquidate cli command for liquidating a cdp.
+func GetCmdLiquidate(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "liquidate [cdp-owner-address] [collateral-type]",
+		Short: "liquidate a cdp that is below its liquidation ratio",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Liquidate a cdp that is below the liquidation ratio of its collateral type, receiving a reward out of the seized collateral.
+
+Example:
+$ %s tx %s liquidate kava1y70y90wzmnf00e63efk2lycgqwepthdmyzsfzm atom-a --from myKeyName
+`, version.ClientName, types.ModuleName)),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			borrower, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgLiquidate(cliCtx.GetFromAddress(), borrower, args[1])
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdTransfer returns the command handler for transferring a cdp to a new owner
+func GetCmdTransfer(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "transfer [recipient-address] [collateral-type]",
+		Short: "transfer a cdp to a new owner",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Transfer a cdp, along with the owner's deposit, to a new owner. The recipient cannot already have a cdp of the same collateral type.
+
+Example:
+$ %s tx %s transfer kava1y70y90wzmnf00e63efk2lycgqwepthdmyzsfzm atom-a --from myKeyName
+`, version.ClientName, types.ModuleName)),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			recipient, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgTransferCDP(cliCtx.GetFromAddress(), recipient, args[1])
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdDepositSavings returns the command handler for depositing savings
+func GetCmdDepositSavings(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "deposit-savings [amount]",
+		Short: "deposit debt coins into the savings vault",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Deposit debt coins into the savings vault in exchange for shares. Shares grow in value as the savings rate is distributed to the vault.
+
+Example:
+$ %s tx %s deposit-savings 1000000usdx --from myKeyName
+`, version.ClientName, types.ModuleName)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			amount, err := sdk.ParseCoin(args[0])
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgDepositSavings(cliCtx.GetFromAddress(), amount)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdWithdrawSavings returns the command handler for withdrawing savings
+func GetCmdWithdrawSavings(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "withdraw-savings [amount]",
+		Short: "withdraw debt coins from the savings vault",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Withdraw debt coins from the savings vault, redeeming the shares they are worth.
+
+Example:
+$ %s tx %s withdraw-savings 1000000usdx --from myKeyName
+`, version.ClientName, types.ModuleName)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			amount, err := sdk.ParseCoin(args[0])
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgWithdrawSavings(cliCtx.GetFromAddress(), amount)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
@@ -65,3 +65,26 @@ type PostRepayReq struct {
 	CollateralType string         `json:"collateral_type" yaml:"collateral_type"`
 	Payment        sdk.Coin       `json:"payment" yaml:"payment"`
 }
+
+// PostLiquidateReq defines the properties of cdp liquidation request's body.
+type PostLiquidateReq struct {
+	BaseReq        rest.BaseReq   `json:"base_req" yaml:"base_req"`
+	Keeper         sdk.AccAddress `json:"keeper" yaml:"keeper"`
+	Borrower       sdk.AccAddress `json:"borrower" yaml:"borrower"`
+	CollateralType string         `json:"collateral_type" yaml:"collateral_type"`
+}
+
+// PostTransferReq defines the properties of cdp transfer request's body.
+type PostTransferReq struct {
+	BaseReq        rest.BaseReq   `json:"base_req" yaml:"base_req"`
+	Owner          sdk.AccAddress `json:"owner" yaml:"owner"`
+	Recipient      sdk.AccAddress `json:"recipient" yaml:"recipient"`
+	CollateralType string         `json:"collateral_type" yaml:"collateral_type"`
+}
+
+// PostSavingsReq defines the properties of a savings deposit or withdrawal request's body.
+type PostSavingsReq struct {
+	BaseReq   rest.BaseReq   `json:"base_req" yaml:"base_req"`
+	Depositor sdk.AccAddress `json:"depositor" yaml:"depositor"`
+	Amount    sdk.Coin       `json:"amount" yaml:"amount"`
+}
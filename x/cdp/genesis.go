@@ -28,6 +28,10 @@ func InitGenesis(ctx sdk.Context, k Keeper, pk types.PricefeedKeeper, sk types.S
 	if savingsRateMacc == nil {
 		panic(fmt.Sprintf("%s module account has not been set", SavingsRateMacc))
 	}
+	savingsVaultMacc := sk.GetModuleAccount(ctx, SavingsVaultMacc)
+	if savingsVaultMacc == nil {
+		panic(fmt.Sprintf("%s module account has not been set", SavingsVaultMacc))
+	}
 
 	// validate denoms - check that any collaterals in the params are in the pricefeed,
 	// pricefeed MUST call InitGenesis before cdp
@@ -57,9 +61,15 @@ func InitGenesis(ctx sdk.Context, k Keeper, pk types.PricefeedKeeper, sk types.S
 
 	k.SetParams(ctx, gs.Params)
 
-	// set the per second fee rate for each collateral type
+	// set the total principal for each collateral type
 	for _, cp := range gs.Params.CollateralParams {
-		k.SetTotalPrincipal(ctx, cp.Type, gs.Params.DebtParam.Denom, sdk.ZeroInt())
+		k.SetTotalPrincipal(ctx, cp.Type, cp.DebtLimit.Denom, sdk.ZeroInt())
+	}
+
+	// set the interest accumulation state for each collateral type
+	for _, gat := range gs.PreviousAccumulationTimes {
+		k.SetPreviousAccrualTime(ctx, gat.CollateralType, gat.PreviousAccumulationTime)
+		k.SetInterestFactor(ctx, gat.CollateralType, gat.InterestFactor)
 	}
 
 	// add cdps
@@ -72,7 +82,7 @@ func InitGenesis(ctx sdk.Context, k Keeper, pk types.PricefeedKeeper, sk types.S
 			panic(fmt.Sprintf("error setting cdp: %v", err))
 		}
 		k.IndexCdpByOwner(ctx, cdp)
-		ratio := k.CalculateCollateralToDebtRatio(ctx, cdp.Collateral, cdp.Type, cdp.GetTotalPrincipal())
+		ratio := k.CalculateCollateralToDebtRatio(ctx, cdp.Collateral, cdp.Type, cdp.GetNormalizedPrincipal())
 		k.IndexCdpByCollateralRatio(ctx, cdp.Type, cdp.ID, ratio)
 		k.IncrementTotalPrincipal(ctx, cdp.Type, cdp.GetTotalPrincipal())
 	}
@@ -89,33 +99,53 @@ func InitGenesis(ctx sdk.Context, k Keeper, pk types.PricefeedKeeper, sk types.S
 		k.SetDeposit(ctx, d)
 	}
 
-	k.SetSavingsRateDistributed(ctx, gs.SavingsRateDistributed)
+	for _, dp := range gs.Params.DebtParams {
+		k.SetSavingsRateDistributed(ctx, dp.Denom, gs.SavingsRateDistributed.AmountOf(dp.Denom))
+	}
+
+	// add savings deposits, the total shares of each denom are the sum of its deposits' shares
+	for _, sd := range gs.SavingsDeposits {
+		_, found := k.GetDebtParam(ctx, sd.Denom)
+		if !found {
+			panic(fmt.Sprintf("savings deposit denom %s is not a debt denom", sd.Denom))
+		}
+		k.SetSavingsDeposit(ctx, sd)
+		k.SetTotalSavingsShares(ctx, sd.Denom, k.GetTotalSavingsShares(ctx, sd.Denom).Add(sd.Shares))
+	}
 }
 
 // ExportGenesis export genesis state for cdp module
 func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
 	params := k.GetParams(ctx)
 
+	// export synchronized cdps so that the total principal of each collateral type matches the sum of its cdps,
+	// without writing the synchronized cdps to the store
 	cdps := CDPs{}
 	deposits := Deposits{}
-	k.IterateAllCdps(ctx, func(cdp CDP) (stop bool) {
-		cdps = append(cdps, cdp)
-		k.IterateDeposits(ctx, cdp.ID, func(deposit Deposit) (stop bool) {
-			deposits = append(deposits, deposit)
-			return false
-		})
-		return false
-	})
+	for _, cdp := range k.GetAllCdps(ctx) {
+		cdps = append(cdps, k.GetSynchronizedCdp(ctx, cdp))
+		deposits = append(deposits, k.GetDeposits(ctx, cdp.ID)...)
+	}
 
 	cdpID := k.GetNextCdpID(ctx)
 	debtDenom := k.GetDebtDenom(ctx)
 	govDenom := k.GetGovDenom(ctx)
-	savingsRateDist := k.GetSavingsRateDistributed(ctx)
+	savingsRateDist := k.GetAllSavingsRateDistributed(ctx)
 
 	previousDistributionTime, found := k.GetPreviousSavingsDistribution(ctx)
 	if !found {
 		previousDistributionTime = DefaultPreviousDistributionTime
 	}
 
-	return NewGenesisState(params, cdps, deposits, cdpID, debtDenom, govDenom, previousDistributionTime, savingsRateDist)
+	var prevAccumTimes GenesisAccumulationTimes
+	for _, cp := range params.CollateralParams {
+		previousAccrualTime, found := k.GetPreviousAccrualTime(ctx, cp.Type)
+		if !found {
+			continue
+		}
+		interestFactor := k.GetInterestFactor(ctx, cp.Type)
+		prevAccumTimes = append(prevAccumTimes, NewGenesisAccumulationTime(cp.Type, previousAccrualTime, interestFactor))
+	}
+
+	return NewGenesisState(params, cdps, deposits, cdpID, debtDenom, govDenom, previousDistributionTime, savingsRateDist, prevAccumTimes, k.GetAllSavingsDeposits(ctx))
 }
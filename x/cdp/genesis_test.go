@@ -9,6 +9,9 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmtime "github.com/tendermint/tendermint/types/time"
+
 	"github.com/kava-labs/kava/app"
 	"github.com/kava-labs/kava/x/cdp"
 )
@@ -29,7 +32,9 @@ func (suite *GenesisTestSuite) TestInvalidGenState() {
 		debtDenom       string
 		govDenom        string
 		prevDistTime    time.Time
-		savingsRateDist sdk.Int
+		savingsRateDist sdk.Coins
+		prevAccumTimes  cdp.GenesisAccumulationTimes
+		savingsDeposits cdp.SavingsDeposits
 	}
 	type errArgs struct {
 		expectPass bool
@@ -102,18 +107,76 @@ func (suite *GenesisTestSuite) TestInvalidGenState() {
 				debtDenom:       cdp.DefaultDebtDenom,
 				govDenom:        cdp.DefaultGovDenom,
 				prevDistTime:    cdp.DefaultPreviousDistributionTime,
-				savingsRateDist: sdk.NewInt(-100),
+				savingsRateDist: sdk.Coins{sdk.Coin{Denom: "usdx", Amount: sdk.NewInt(-100)}},
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "invalid savings rate distributed",
+			},
+		},
+		{
+			name: "invalid interest factor",
+			args: args{
+				params:          cdp.DefaultParams(),
+				cdps:            cdp.CDPs{},
+				deposits:        cdp.Deposits{},
+				debtDenom:       cdp.DefaultDebtDenom,
+				govDenom:        cdp.DefaultGovDenom,
+				prevDistTime:    cdp.DefaultPreviousDistributionTime,
+				savingsRateDist: cdp.DefaultSavingsRateDistributed,
+				prevAccumTimes: cdp.GenesisAccumulationTimes{
+					cdp.NewGenesisAccumulationTime("bnb-a", time.Unix(1, 0), sdk.MustNewDecFromStr("0.9")),
+				},
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "interest factor for bnb-a should be ≥ 1.0",
+			},
+		},
+		{
+			name: "duplicate savings deposit",
+			args: args{
+				params:          cdp.DefaultParams(),
+				cdps:            cdp.CDPs{},
+				deposits:        cdp.Deposits{},
+				debtDenom:       cdp.DefaultDebtDenom,
+				govDenom:        cdp.DefaultGovDenom,
+				prevDistTime:    cdp.DefaultPreviousDistributionTime,
+				savingsRateDist: cdp.DefaultSavingsRateDistributed,
+				savingsDeposits: cdp.SavingsDeposits{
+					cdp.NewSavingsDeposit(sdk.AccAddress("test1"), "usdx", sdk.NewInt(100)),
+					cdp.NewSavingsDeposit(sdk.AccAddress("test1"), "usdx", sdk.NewInt(50)),
+				},
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "duplicate usdx savings deposit",
+			},
+		},
+		{
+			name: "invalid savings deposit shares",
+			args: args{
+				params:          cdp.DefaultParams(),
+				cdps:            cdp.CDPs{},
+				deposits:        cdp.Deposits{},
+				debtDenom:       cdp.DefaultDebtDenom,
+				govDenom:        cdp.DefaultGovDenom,
+				prevDistTime:    cdp.DefaultPreviousDistributionTime,
+				savingsRateDist: cdp.DefaultSavingsRateDistributed,
+				savingsDeposits: cdp.SavingsDeposits{
+					cdp.NewSavingsDeposit(sdk.AccAddress("test1"), "usdx", sdk.ZeroInt()),
+				},
 			},
 			errArgs: errArgs{
 				expectPass: false,
-				contains:   "savings rate distributed should not be negative",
+				contains:   "savings deposit shares must be positive",
 			},
 		},
 	}
 	for _, tc := range testCases {
 		suite.Run(tc.name, func() {
 			gs := cdp.NewGenesisState(tc.args.params, tc.args.cdps, tc.args.deposits, tc.args.startingID,
-				tc.args.debtDenom, tc.args.govDenom, tc.args.prevDistTime, tc.args.savingsRateDist)
+				tc.args.debtDenom, tc.args.govDenom, tc.args.prevDistTime, tc.args.savingsRateDist, tc.args.prevAccumTimes, tc.args.savingsDeposits)
 			err := gs.Validate()
 			if tc.errArgs.expectPass {
 				suite.Require().NoError(err)
@@ -151,6 +214,45 @@ func (suite *GenesisTestSuite) TestValidGenState() {
 
 }
 
+func (suite *GenesisTestSuite) TestExportGenesis() {
+	tApp := app.NewTestApp()
+	cdpGS := NewCDPGenStateMulti()
+	gs := cdp.GenesisState{}
+	cdp.ModuleCdc.UnmarshalJSON(cdpGS["cdp"], &gs)
+	gs.CDPs = cdps()
+	gs.StartingCdpID = uint64(5)
+	tApp.InitializeFromGenesisStates(
+		NewPricefeedGenStateMulti(),
+		app.GenesisState{"cdp": cdp.ModuleCdc.MustMarshalJSON(gs)},
+	)
+	ctx := tApp.NewContext(true, abci.Header{Height: 1, Time: tmtime.Now()})
+	keeper := tApp.GetCDPKeeper()
+
+	// accrue a year of interest without synchronizing the cdps
+	suite.Require().NoError(keeper.AccumulateInterest(ctx, "xrp-a"))
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(365 * 24 * time.Hour))
+	suite.Require().NoError(keeper.AccumulateInterest(ctx, "xrp-a"))
+	storedCdp, found := keeper.GetCDP(ctx, "xrp-a", 4)
+	suite.Require().True(found)
+
+	exportedGS := cdp.ExportGenesis(ctx, keeper)
+
+	// the exported cdp includes the interest accumulated since it was last synchronized
+	var exportedCdp cdp.CDP
+	for _, c := range exportedGS.CDPs {
+		if c.ID == 4 {
+			exportedCdp = c
+		}
+	}
+	suite.Equal(keeper.GetSynchronizedCdp(ctx, storedCdp), exportedCdp)
+	suite.True(exportedCdp.AccumulatedFees.IsGTE(storedCdp.AccumulatedFees.Add(sdk.NewInt64Coin("usdx", 1))))
+
+	// exporting does not write the synchronized cdp to the store
+	cdpAfterExport, found := keeper.GetCDP(ctx, "xrp-a", 4)
+	suite.Require().True(found)
+	suite.Equal(storedCdp, cdpAfterExport)
+}
+
 func TestGenesisTestSuite(t *testing.T) {
 	suite.Run(t, new(GenesisTestSuite))
 }
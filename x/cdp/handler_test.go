@@ -55,6 +55,20 @@ func (suite *HandlerTestSuite) TestMsgCreateCdp() {
 
 }
 
+func (suite *HandlerTestSuite) TestMsgLiquidate() {
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+	ak := suite.app.GetAccountKeeper()
+	acc := ak.NewAccountWithAddress(suite.ctx, addrs[0])
+	acc.SetCoins(cs(c("xrp", 200000000)))
+	ak.SetAccount(suite.ctx, acc)
+	_, err := suite.handler(suite.ctx, cdp.NewMsgCreateCDP(addrs[0], c("xrp", 200000000), c("usdx", 10000000), "xrp-a"))
+	suite.Require().NoError(err)
+
+	res, err := suite.handler(suite.ctx, cdp.NewMsgLiquidate(addrs[1], addrs[0], "xrp-a"))
+	suite.Require().Error(err)
+	suite.Require().Nil(res)
+}
+
 func (suite *HandlerTestSuite) TestInvalidMsg() {
 	res, err := suite.handler(suite.ctx, sdk.NewTestMsg())
 	suite.Require().Error(err)
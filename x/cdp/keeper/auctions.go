@@ -2,6 +2,7 @@ package keeper
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/kava-labs/kava/x/cdp/types"
 )
@@ -56,7 +57,7 @@ func (k Keeper) CreateAuctionsFromDeposit(
 		unallocatedDebt = unallocatedDebt.Sub(sdk.OneInt())
 	}
 
-	debtDenom := k.GetDebtDenom(ctx)
+	debtDenom := k.GetDebtCoinDenom(ctx, principalDenom)
 	numAuctions := numberOfAuctions.Int64()
 
 	// create whole auctions
@@ -71,10 +72,10 @@ func (k Keeper) CreateAuctionsFromDeposit(
 
 		penalty := k.ApplyLiquidationPenalty(ctx, collateralType, debtAmount)
 
-		_, err := k.auctionKeeper.StartCollateralAuction(
-			ctx, types.LiquidatorMacc, sdk.NewCoin(collateral.Denom, auctionSize),
-			sdk.NewCoin(principalDenom, debtAmount.Add(penalty)), []sdk.AccAddress{returnAddr},
-			[]sdk.Int{auctionSize}, sdk.NewCoin(debtDenom, debtAmount),
+		err := k.startCollateralAuction(
+			ctx, collateralType, sdk.NewCoin(collateral.Denom, auctionSize),
+			sdk.NewCoin(principalDenom, debtAmount.Add(penalty)), returnAddr,
+			sdk.NewCoin(debtDenom, debtAmount),
 		)
 
 		if err != nil {
@@ -97,62 +98,108 @@ func (k Keeper) CreateAuctionsFromDeposit(
 
 	penalty := k.ApplyLiquidationPenalty(ctx, collateralType, lastAuctionDebt)
 
-	_, err := k.auctionKeeper.StartCollateralAuction(
-		ctx, types.LiquidatorMacc, sdk.NewCoin(collateral.Denom, lastAuctionCollateral),
-		sdk.NewCoin(principalDenom, lastAuctionDebt.Add(penalty)), []sdk.AccAddress{returnAddr},
-		[]sdk.Int{lastAuctionCollateral}, sdk.NewCoin(debtDenom, lastAuctionDebt),
+	return k.startCollateralAuction(
+		ctx, collateralType, sdk.NewCoin(collateral.Denom, lastAuctionCollateral),
+		sdk.NewCoin(principalDenom, lastAuctionDebt.Add(penalty)), returnAddr,
+		sdk.NewCoin(debtDenom, lastAuctionDebt),
 	)
+}
+
+// startCollateralAuction starts a dutch or a forward/reverse auction for the lot, depending on the collateral type's params
+func (k Keeper) startCollateralAuction(ctx sdk.Context, collateralType string, lot, maxBid sdk.Coin, returnAddr sdk.AccAddress, debt sdk.Coin) error {
+	cp, found := k.GetCollateral(ctx, collateralType)
+	if !found {
+		return sdkerrors.Wrap(types.ErrCollateralNotSupported, collateralType)
+	}
+
+	if !cp.DutchAuction {
+		_, err := k.auctionKeeper.StartCollateralAuction(
+			ctx, types.LiquidatorMacc, lot, maxBid, []sdk.AccAddress{returnAddr}, []sdk.Int{lot.Amount}, debt,
+		)
+		return err
+	}
 
+	price, err := k.getAuctionPrice(ctx, cp)
+	if err != nil {
+		return err
+	}
+	_, err = k.auctionKeeper.StartDutchCollateralAuction(
+		ctx, types.LiquidatorMacc, lot, maxBid, []sdk.AccAddress{returnAddr}, []sdk.Int{lot.Amount}, debt, price,
+	)
 	return err
 }
 
-// NetSurplusAndDebt burns surplus and debt coins equal to the minimum of surplus and debt balances held by the liquidator module account
+// getAuctionPrice returns the liquidation market price of the collateral in internal units,
+// ie the amount of the debt asset's smallest unit one unit of collateral is worth
+func (k Keeper) getAuctionPrice(ctx sdk.Context, cp types.CollateralParam) (sdk.Dec, error) {
+	price, err := k.pricefeedKeeper.GetCurrentPrice(ctx, cp.LiquidationMarketID)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	dp, found := k.GetDebtParam(ctx, cp.DebtLimit.Denom)
+	if !found {
+		return sdk.Dec{}, sdkerrors.Wrap(types.ErrDebtNotSupported, cp.DebtLimit.Denom)
+	}
+	debtUnits := sdk.NewDecFromInt(sdk.NewIntWithDecimal(1, int(dp.ConversionFactor.Int64())))
+	collateralUnits := sdk.NewDecFromInt(sdk.NewIntWithDecimal(1, int(cp.ConversionFactor.Int64())))
+	return price.Price.Mul(debtUnits).Quo(collateralUnits), nil
+}
+
+// NetSurplusAndDebt burns surplus and debt coins of the input debt asset equal to the minimum of surplus and debt balances
+// held by the liquidator module account
 // for example, if there is 1000 debt and 100 surplus, 100 surplus and 100 debt are burned, netting to 900 debt
-func (k Keeper) NetSurplusAndDebt(ctx sdk.Context) error {
-	totalSurplus := k.GetTotalSurplus(ctx, types.LiquidatorMacc)
-	debt := k.GetTotalDebt(ctx, types.LiquidatorMacc)
+func (k Keeper) NetSurplusAndDebt(ctx sdk.Context, principalDenom string) error {
+	totalSurplus := k.GetTotalSurplus(ctx, types.LiquidatorMacc, principalDenom)
+	debt := k.GetTotalDebt(ctx, types.LiquidatorMacc, principalDenom)
 	netAmount := sdk.MinInt(totalSurplus, debt)
 	if netAmount.IsZero() {
 		return nil
 	}
 
 	// burn debt coins equal to netAmount
-	err := k.supplyKeeper.BurnCoins(ctx, types.LiquidatorMacc, sdk.NewCoins(sdk.NewCoin(k.GetDebtDenom(ctx), netAmount)))
+	err := k.supplyKeeper.BurnCoins(ctx, types.LiquidatorMacc, sdk.NewCoins(sdk.NewCoin(k.GetDebtCoinDenom(ctx, principalDenom), netAmount)))
 	if err != nil {
 		return err
 	}
 
 	// burn stable coins equal to min(balance, netAmount)
-	dp := k.GetParams(ctx).DebtParam
-	balance := k.supplyKeeper.GetModuleAccount(ctx, types.LiquidatorMacc).GetCoins().AmountOf(dp.Denom)
+	balance := k.supplyKeeper.GetModuleAccount(ctx, types.LiquidatorMacc).GetCoins().AmountOf(principalDenom)
 	burnAmount := sdk.MinInt(balance, netAmount)
-	return k.supplyKeeper.BurnCoins(ctx, types.LiquidatorMacc, sdk.NewCoins(sdk.NewCoin(dp.Denom, burnAmount)))
+	return k.supplyKeeper.BurnCoins(ctx, types.LiquidatorMacc, sdk.NewCoins(sdk.NewCoin(principalDenom, burnAmount)))
 }
 
-// GetTotalSurplus returns the total amount of surplus tokens held by the liquidator module account
-func (k Keeper) GetTotalSurplus(ctx sdk.Context, accountName string) sdk.Int {
+// GetTotalSurplus returns the total amount of surplus tokens of the input debt asset held by the liquidator module account
+func (k Keeper) GetTotalSurplus(ctx sdk.Context, accountName, principalDenom string) sdk.Int {
 	acc := k.supplyKeeper.GetModuleAccount(ctx, accountName)
-	dp := k.GetParams(ctx).DebtParam
-	return acc.GetCoins().AmountOf(dp.Denom)
+	return acc.GetCoins().AmountOf(principalDenom)
 }
 
-// GetTotalDebt returns the total amount of debt tokens held by the liquidator module account
-func (k Keeper) GetTotalDebt(ctx sdk.Context, accountName string) sdk.Int {
+// GetTotalDebt returns the total amount of debt tokens of the input debt asset held by the liquidator module account
+func (k Keeper) GetTotalDebt(ctx sdk.Context, accountName, principalDenom string) sdk.Int {
 	acc := k.supplyKeeper.GetModuleAccount(ctx, accountName)
-	return acc.GetCoins().AmountOf(k.GetDebtDenom(ctx))
+	return acc.GetCoins().AmountOf(k.GetDebtCoinDenom(ctx, principalDenom))
 }
 
-// RunSurplusAndDebtAuctions nets the surplus and debt balances and then creates surplus or debt auctions if the remaining balance is above the auction threshold parameter
+// RunSurplusAndDebtAuctions nets the surplus and debt balances of each debt asset and then creates surplus or debt auctions
+// if the remaining balance is above the auction threshold parameter of that debt asset
 func (k Keeper) RunSurplusAndDebtAuctions(ctx sdk.Context) error {
-	if err := k.NetSurplusAndDebt(ctx); err != nil {
+	for _, dp := range k.GetParams(ctx).DebtParams {
+		if err := k.runSurplusAndDebtAuctions(ctx, dp); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (k Keeper) runSurplusAndDebtAuctions(ctx sdk.Context, dp types.DebtParam) error {
+	if err := k.NetSurplusAndDebt(ctx, dp.Denom); err != nil {
 		return err
 	}
-	remainingDebt := k.GetTotalDebt(ctx, types.LiquidatorMacc)
-	params := k.GetParams(ctx)
+	remainingDebt := k.GetTotalDebt(ctx, types.LiquidatorMacc, dp.Denom)
 
-	if remainingDebt.GTE(params.DebtAuctionThreshold) {
-		debtLot := sdk.NewCoin(k.GetDebtDenom(ctx), params.DebtAuctionLot)
-		bidCoin := sdk.NewCoin(params.DebtParam.Denom, debtLot.Amount)
+	if remainingDebt.GTE(dp.DebtAuctionThreshold) {
+		debtLot := sdk.NewCoin(k.GetDebtCoinDenom(ctx, dp.Denom), dp.DebtAuctionLot)
+		bidCoin := sdk.NewCoin(dp.Denom, debtLot.Amount)
 		initialLot := sdk.NewCoin(k.GetGovDenom(ctx), debtLot.Amount.Mul(sdk.NewInt(dump)))
 
 		_, err := k.auctionKeeper.StartDebtAuction(ctx, types.LiquidatorMacc, bidCoin, initialLot, debtLot)
@@ -161,12 +208,12 @@ func (k Keeper) RunSurplusAndDebtAuctions(ctx sdk.Context) error {
 		}
 	}
 
-	surplus := k.supplyKeeper.GetModuleAccount(ctx, types.LiquidatorMacc).GetCoins().AmountOf(params.DebtParam.Denom)
-	if !surplus.GTE(params.SurplusAuctionThreshold) {
+	surplus := k.GetTotalSurplus(ctx, types.LiquidatorMacc, dp.Denom)
+	if !surplus.GTE(dp.SurplusAuctionThreshold) {
 		return nil
 	}
 
-	surplusLot := sdk.NewCoin(params.DebtParam.Denom, sdk.MinInt(params.SurplusAuctionLot, surplus))
+	surplusLot := sdk.NewCoin(dp.Denom, sdk.MinInt(dp.SurplusAuctionLot, surplus))
 	_, err := k.auctionKeeper.StartSurplusAuction(ctx, types.LiquidatorMacc, surplusLot, k.GetGovDenom(ctx))
 	return err
 }
@@ -48,18 +48,18 @@ func (suite *AuctionTestSuite) SetupTest() {
 
 func (suite *AuctionTestSuite) TestNetDebtSurplus() {
 	sk := suite.app.GetSupplyKeeper()
-	err := sk.MintCoins(suite.ctx, types.LiquidatorMacc, cs(c("debt", 100)))
+	err := sk.MintCoins(suite.ctx, types.LiquidatorMacc, cs(c("debtusdx", 100)))
 	suite.NoError(err)
 	err = sk.MintCoins(suite.ctx, types.LiquidatorMacc, cs(c("usdx", 10)))
 	suite.NoError(err)
-	suite.NotPanics(func() { suite.keeper.NetSurplusAndDebt(suite.ctx) })
+	suite.NotPanics(func() { suite.keeper.NetSurplusAndDebt(suite.ctx, "usdx") })
 	acc := sk.GetModuleAccount(suite.ctx, types.LiquidatorMacc)
-	suite.Equal(cs(c("debt", 90)), acc.GetCoins())
+	suite.Equal(cs(c("debtusdx", 90)), acc.GetCoins())
 }
 
 func (suite *AuctionTestSuite) TestCollateralAuction() {
 	sk := suite.app.GetSupplyKeeper()
-	err := sk.MintCoins(suite.ctx, types.LiquidatorMacc, cs(c("debt", 21000000000), c("bnb", 190000000000)))
+	err := sk.MintCoins(suite.ctx, types.LiquidatorMacc, cs(c("debtusdx", 21000000000), c("bnb", 190000000000)))
 	suite.Require().NoError(err)
 	testDeposit := types.NewDeposit(1, suite.addrs[0], c("bnb", 190000000000))
 	err = suite.keeper.AuctionCollateral(suite.ctx, types.Deposits{testDeposit}, "bnb-a", i(21000000000), "usdx")
@@ -70,7 +70,7 @@ func (suite *AuctionTestSuite) TestSurplusAuction() {
 	sk := suite.app.GetSupplyKeeper()
 	err := sk.MintCoins(suite.ctx, types.LiquidatorMacc, cs(c("usdx", 600000000000)))
 	suite.NoError(err)
-	err = sk.MintCoins(suite.ctx, types.LiquidatorMacc, cs(c("debt", 100000000000)))
+	err = sk.MintCoins(suite.ctx, types.LiquidatorMacc, cs(c("debtusdx", 100000000000)))
 	suite.NoError(err)
 	suite.keeper.RunSurplusAndDebtAuctions(suite.ctx)
 	acc := sk.GetModuleAccount(suite.ctx, auction.ModuleName)
@@ -83,20 +83,20 @@ func (suite *AuctionTestSuite) TestDebtAuction() {
 	sk := suite.app.GetSupplyKeeper()
 	err := sk.MintCoins(suite.ctx, types.LiquidatorMacc, cs(c("usdx", 100000000000)))
 	suite.NoError(err)
-	err = sk.MintCoins(suite.ctx, types.LiquidatorMacc, cs(c("debt", 200000000000)))
+	err = sk.MintCoins(suite.ctx, types.LiquidatorMacc, cs(c("debtusdx", 200000000000)))
 	suite.NoError(err)
 	suite.keeper.RunSurplusAndDebtAuctions(suite.ctx)
 	acc := sk.GetModuleAccount(suite.ctx, auction.ModuleName)
-	suite.Equal(cs(c("debt", 10000000000)), acc.GetCoins())
+	suite.Equal(cs(c("debtusdx", 10000000000)), acc.GetCoins())
 	acc = sk.GetModuleAccount(suite.ctx, types.LiquidatorMacc)
-	suite.Equal(cs(c("debt", 90000000000)), acc.GetCoins())
+	suite.Equal(cs(c("debtusdx", 90000000000)), acc.GetCoins())
 }
 
 func (suite *AuctionTestSuite) TestGetTotalSurplus() {
 	sk := suite.app.GetSupplyKeeper()
 
 	// liquidator account has zero coins
-	suite.Require().Equal(sdk.NewInt(0), suite.keeper.GetTotalSurplus(suite.ctx, types.LiquidatorMacc))
+	suite.Require().Equal(sdk.NewInt(0), suite.keeper.GetTotalSurplus(suite.ctx, types.LiquidatorMacc, "usdx"))
 
 	// mint some coins
 	err := sk.MintCoins(suite.ctx, types.LiquidatorMacc, cs(c("usdx", 100e6)))
@@ -105,51 +105,51 @@ func (suite *AuctionTestSuite) TestGetTotalSurplus() {
 	suite.Require().NoError(err)
 
 	// liquidator account has 300e6 total usdx
-	suite.Require().Equal(sdk.NewInt(300e6), suite.keeper.GetTotalSurplus(suite.ctx, types.LiquidatorMacc))
+	suite.Require().Equal(sdk.NewInt(300e6), suite.keeper.GetTotalSurplus(suite.ctx, types.LiquidatorMacc, "usdx"))
 
 	// mint some debt
-	err = sk.MintCoins(suite.ctx, types.LiquidatorMacc, cs(c("debt", 500e6)))
+	err = sk.MintCoins(suite.ctx, types.LiquidatorMacc, cs(c("debtusdx", 500e6)))
 	suite.Require().NoError(err)
 
 	// liquidator account still has 300e6 total usdx -- debt balance is ignored
-	suite.Require().Equal(sdk.NewInt(300e6), suite.keeper.GetTotalSurplus(suite.ctx, types.LiquidatorMacc))
+	suite.Require().Equal(sdk.NewInt(300e6), suite.keeper.GetTotalSurplus(suite.ctx, types.LiquidatorMacc, "usdx"))
 
 	// burn some usdx
 	err = sk.BurnCoins(suite.ctx, types.LiquidatorMacc, cs(c("usdx", 50e6)))
 	suite.Require().NoError(err)
 
 	// liquidator usdx decreases
-	suite.Require().Equal(sdk.NewInt(250e6), suite.keeper.GetTotalSurplus(suite.ctx, types.LiquidatorMacc))
+	suite.Require().Equal(sdk.NewInt(250e6), suite.keeper.GetTotalSurplus(suite.ctx, types.LiquidatorMacc, "usdx"))
 }
 
 func (suite *AuctionTestSuite) TestGetTotalDebt() {
 	sk := suite.app.GetSupplyKeeper()
 
 	// liquidator account has zero debt
-	suite.Require().Equal(sdk.NewInt(0), suite.keeper.GetTotalSurplus(suite.ctx, types.LiquidatorMacc))
+	suite.Require().Equal(sdk.NewInt(0), suite.keeper.GetTotalSurplus(suite.ctx, types.LiquidatorMacc, "usdx"))
 
 	// mint some debt
-	err := sk.MintCoins(suite.ctx, types.LiquidatorMacc, cs(c("debt", 100e6)))
+	err := sk.MintCoins(suite.ctx, types.LiquidatorMacc, cs(c("debtusdx", 100e6)))
 	suite.Require().NoError(err)
-	err = sk.MintCoins(suite.ctx, types.LiquidatorMacc, cs(c("debt", 200e6)))
+	err = sk.MintCoins(suite.ctx, types.LiquidatorMacc, cs(c("debtusdx", 200e6)))
 	suite.Require().NoError(err)
 
 	// liquidator account has 300e6 total debt
-	suite.Require().Equal(sdk.NewInt(300e6), suite.keeper.GetTotalDebt(suite.ctx, types.LiquidatorMacc))
+	suite.Require().Equal(sdk.NewInt(300e6), suite.keeper.GetTotalDebt(suite.ctx, types.LiquidatorMacc, "usdx"))
 
 	// mint some usdx
 	err = sk.MintCoins(suite.ctx, types.LiquidatorMacc, cs(c("usdx", 500e6)))
 	suite.Require().NoError(err)
 
 	// liquidator account still has 300e6 total debt -- usdx balance is ignored
-	suite.Require().Equal(sdk.NewInt(300e6), suite.keeper.GetTotalDebt(suite.ctx, types.LiquidatorMacc))
+	suite.Require().Equal(sdk.NewInt(300e6), suite.keeper.GetTotalDebt(suite.ctx, types.LiquidatorMacc, "usdx"))
 
 	// burn some debt
-	err = sk.BurnCoins(suite.ctx, types.LiquidatorMacc, cs(c("debt", 50e6)))
+	err = sk.BurnCoins(suite.ctx, types.LiquidatorMacc, cs(c("debtusdx", 50e6)))
 	suite.Require().NoError(err)
 
 	// liquidator debt decreases
-	suite.Require().Equal(sdk.NewInt(250e6), suite.keeper.GetTotalDebt(suite.ctx, types.LiquidatorMacc))
+	suite.Require().Equal(sdk.NewInt(250e6), suite.keeper.GetTotalDebt(suite.ctx, types.LiquidatorMacc, "usdx"))
 }
 
 func TestAuctionTestSuite(t *testing.T) {
@@ -1,14 +1,17 @@
 package keeper
 
 import (
+	"errors"
 	"fmt"
 	"sort"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/kava-labs/kava/x/cdp/types"
+	pricefeedtypes "github.com/kava-labs/kava/x/pricefeed/types"
 )
 
 // BaseDigitFactor is 10**18, used during coin calculations
@@ -41,7 +44,7 @@ func (k Keeper) AddCdp(ctx sdk.Context, owner sdk.AccAddress, collateral sdk.Coi
 
 	// send coins from the owners account to the cdp module
 	id := k.GetNextCdpID(ctx)
-	cdp := types.NewCDP(id, owner, collateral, collateralType, principal, ctx.BlockHeader().Time)
+	cdp := types.NewCDP(id, owner, collateral, collateralType, principal, ctx.BlockHeader().Time, k.GetInterestFactor(ctx, collateralType))
 	deposit := types.NewDeposit(cdp.ID, owner, collateral)
 	err = k.supplyKeeper.SendCoinsFromAccountToModule(ctx, owner, types.ModuleName, sdk.NewCoins(collateral))
 	if err != nil {
@@ -59,7 +62,7 @@ func (k Keeper) AddCdp(ctx sdk.Context, owner sdk.AccAddress, collateral sdk.Coi
 	}
 
 	// mint the corresponding amount of debt coins
-	err = k.MintDebtCoins(ctx, types.ModuleName, k.GetDebtDenom(ctx), principal)
+	err = k.MintDebtCoins(ctx, types.ModuleName, k.GetDebtCoinDenom(ctx, principal.Denom), principal)
 	if err != nil {
 		panic(err)
 	}
@@ -68,7 +71,7 @@ func (k Keeper) AddCdp(ctx sdk.Context, owner sdk.AccAddress, collateral sdk.Coi
 	k.IncrementTotalPrincipal(ctx, collateralType, principal)
 
 	// set the cdp, deposit, and indexes in the store
-	collateralToDebtRatio := k.CalculateCollateralToDebtRatio(ctx, collateral, cdp.Type, principal)
+	collateralToDebtRatio := k.CalculateCollateralToDebtRatio(ctx, collateral, cdp.Type, cdp.GetNormalizedPrincipal())
 	err = k.SetCdpAndCollateralRatioIndex(ctx, cdp, collateralToDebtRatio)
 	if err != nil {
 		return err
@@ -194,8 +197,13 @@ func (k Keeper) SetCDP(ctx sdk.Context, cdp types.CDP) error {
 	if !found {
 		return sdkerrors.Wrapf(types.ErrDenomPrefixNotFound, "%s", cdp.Collateral.Denom)
 	}
+	previous, found := k.GetCDP(ctx, cdp.Type, cdp.ID)
+	if found {
+		k.removeFromCdpTotals(ctx, previous)
+	}
 	bz := k.cdc.MustMarshalBinaryLengthPrefixed(cdp)
 	store.Set(types.CdpKey(db, cdp.ID), bz)
+	k.addToCdpTotals(ctx, cdp)
 	return nil
 }
 
@@ -206,6 +214,10 @@ func (k Keeper) DeleteCDP(ctx sdk.Context, cdp types.CDP) error {
 	if !found {
 		return sdkerrors.Wrapf(types.ErrDenomPrefixNotFound, "%s", cdp.Collateral.Denom)
 	}
+	previous, found := k.GetCDP(ctx, cdp.Type, cdp.ID)
+	if found {
+		k.removeFromCdpTotals(ctx, previous)
+	}
 	store.Delete(types.CdpKey(db, cdp.ID))
 	return nil
 
@@ -318,6 +330,11 @@ func (k Keeper) GetDebtDenom(ctx sdk.Context) (denom string) {
 	return
 }
 
+// GetDebtCoinDenom returns the denom of the debt coins that track the debt drawn in the input principal denom
+func (k Keeper) GetDebtCoinDenom(ctx sdk.Context, principalDenom string) string {
+	return types.DebtCoinDenom(k.GetDebtDenom(ctx), principalDenom)
+}
+
 // GetGovDenom returns the denom of the governance token
 func (k Keeper) GetGovDenom(ctx sdk.Context) (denom string) {
 	store := prefix.NewStore(ctx.KVStore(k.key), types.GovDenomKey)
@@ -388,20 +405,26 @@ func (k Keeper) ValidatePrincipalDraw(ctx sdk.Context, principal sdk.Coin, expec
 	return nil
 }
 
-// ValidateDebtLimit validates that the input debt amount does not exceed the global debt limit or the debt limit for that collateral
+// ValidateDebtLimit validates that the input debt amount does not exceed the debt limit for that collateral, or the global debt limit
+// for the total debt drawn in that denom across all collateral types
 func (k Keeper) ValidateDebtLimit(ctx sdk.Context, collateralType string, principal sdk.Coin) error {
 	cp, found := k.GetCollateral(ctx, collateralType)
 	if !found {
 		return sdkerrors.Wrap(types.ErrCollateralNotSupported, collateralType)
 	}
+	if cp.DebtLimit.Denom != principal.Denom {
+		return sdkerrors.Wrapf(types.ErrInvalidDebtRequest, "collateral type %s can only be used to draw %s, got %s", collateralType, cp.DebtLimit.Denom, principal.Denom)
+	}
 	totalPrincipal := k.GetTotalPrincipal(ctx, collateralType, principal.Denom).Add(principal.Amount)
 	collateralLimit := cp.DebtLimit.Amount
 	if totalPrincipal.GT(collateralLimit) {
 		return sdkerrors.Wrapf(types.ErrExceedsDebtLimit, "debt increase %s > collateral debt limit %s", sdk.NewCoins(sdk.NewCoin(principal.Denom, totalPrincipal)), sdk.NewCoins(sdk.NewCoin(principal.Denom, collateralLimit)))
 	}
-	globalLimit := k.GetParams(ctx).GlobalDebtLimit.Amount
-	if totalPrincipal.GT(globalLimit) {
-		return sdkerrors.Wrapf(types.ErrExceedsDebtLimit, "debt increase %s > global debt limit  %s", sdk.NewCoin(principal.Denom, totalPrincipal), sdk.NewCoin(principal.Denom, globalLimit))
+	// the global debt limit applies to the principal drawn in the debt denom against every collateral type
+	globalPrincipal := k.getDenomTotalPrincipal(ctx, principal.Denom).Add(principal.Amount)
+	globalLimit := k.GetParams(ctx).GlobalDebtLimit.AmountOf(principal.Denom)
+	if globalPrincipal.GT(globalLimit) {
+		return sdkerrors.Wrapf(types.ErrExceedsDebtLimit, "debt increase %s > global debt limit  %s", sdk.NewCoin(principal.Denom, globalPrincipal), sdk.NewCoin(principal.Denom, globalLimit))
 	}
 	return nil
 }
@@ -431,8 +454,14 @@ func (k Keeper) CalculateCollateralToDebtRatio(ctx sdk.Context, collateral sdk.C
 	return collateralBaseUnits.Quo(debtTotal)
 }
 
-// LoadAugmentedCDP creates a new augmented CDP from an existing CDP
+// LoadAugmentedCDP creates a new augmented CDP from an existing CDP, including any fees
+// accumulated since the cdp was last synchronized
 func (k Keeper) LoadAugmentedCDP(ctx sdk.Context, cdp types.CDP) types.AugmentedCDP {
+	newFees := k.CalculateNewInterest(ctx, cdp)
+	if newFees.IsPositive() {
+		cdp.AccumulatedFees = cdp.AccumulatedFees.Add(newFees)
+		cdp.InterestFactor = k.GetInterestFactor(ctx, cdp.Type)
+	}
 	// calculate collateralization ratio
 	collateralizationRatio, err := k.CalculateCollateralizationRatio(ctx, cdp.Collateral, cdp.Type, cdp.Principal, cdp.AccumulatedFees, liquidation)
 	if err != nil {
@@ -452,22 +481,12 @@ func (k Keeper) CalculateCollateralizationRatio(ctx sdk.Context, collateral sdk.
 	if collateral.IsZero() {
 		return sdk.ZeroDec(), nil
 	}
-	var marketID string
-	switch pfType {
-	case spot:
-		marketID = k.getSpotMarketID(ctx, collateralType)
-	case liquidation:
-		marketID = k.getliquidationMarketID(ctx, collateralType)
-	default:
-		return sdk.Dec{}, pfType.IsValid()
-	}
-
-	price, err := k.pricefeedKeeper.GetCurrentPrice(ctx, marketID)
+	price, err := k.getPrice(ctx, collateralType, pfType)
 	if err != nil {
 		return sdk.Dec{}, err
 	}
 	collateralBaseUnits := k.convertCollateralToBaseUnits(ctx, collateral, collateralType)
-	collateralValue := collateralBaseUnits.Mul(price.Price)
+	collateralValue := collateralBaseUnits.Mul(price)
 
 	prinicpalBaseUnits := k.convertDebtToBaseUnits(ctx, principal)
 	principalTotal := prinicpalBaseUnits
@@ -481,23 +500,59 @@ func (k Keeper) CalculateCollateralizationRatio(ctx sdk.Context, collateral sdk.
 // CalculateCollateralizationRatioFromAbsoluteRatio takes a coin's denom and an absolute ratio and returns the respective collateralization ratio
 func (k Keeper) CalculateCollateralizationRatioFromAbsoluteRatio(ctx sdk.Context, collateralType string, absoluteRatio sdk.Dec, pfType pricefeedType) (sdk.Dec, error) {
 	// get price of collateral
-	var marketID string
+	price, err := k.getPrice(ctx, collateralType, pfType)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	// convert absolute ratio to collateralization ratio
+	respectiveCollateralRatio := absoluteRatio.Quo(price)
+	return respectiveCollateralRatio, nil
+}
+
+// getPrice returns the price of the collateral type for the input pricefeed type
+func (k Keeper) getPrice(ctx sdk.Context, collateralType string, pfType pricefeedType) (sdk.Dec, error) {
 	switch pfType {
 	case spot:
-		marketID = k.getSpotMarketID(ctx, collateralType)
+		price, err := k.pricefeedKeeper.GetCurrentPrice(ctx, k.getSpotMarketID(ctx, collateralType))
+		if err != nil {
+			return sdk.Dec{}, err
+		}
+		return price.Price, nil
 	case liquidation:
-		marketID = k.getliquidationMarketID(ctx, collateralType)
+		return k.getLiquidationPrice(ctx, k.getliquidationMarketID(ctx, collateralType), k.getLiquidationTWAPWindow(ctx, collateralType))
 	default:
 		return sdk.Dec{}, pfType.IsValid()
 	}
+}
 
+// getLiquidationPrice returns the time weighted average price of the liquidation market over the twap window,
+// or the current price of the liquidation market if the window is zero or longer than the market's price history.
+// Falling back to the current price is logged and emits an event, as liquidations then have no protection from price manipulation.
+func (k Keeper) getLiquidationPrice(ctx sdk.Context, marketID string, twapWindow time.Duration) (sdk.Dec, error) {
+	if twapWindow > 0 {
+		twap, err := k.pricefeedKeeper.GetTWAP(ctx, marketID, twapWindow)
+		if err == nil {
+			return twap, nil
+		}
+		if !errors.Is(err, pricefeedtypes.ErrInsufficientPriceHistory) {
+			return sdk.Dec{}, err
+		}
+		// the twap window can't be checked against the pricefeed's price history length when params are validated
+		k.Logger(ctx).Error(fmt.Sprintf("using the current price of %s for liquidations: %v", marketID, err))
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeLiquidationPriceFallback,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+				sdk.NewAttribute(types.AttributeKeyMarketID, marketID),
+				sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+			),
+		)
+	}
 	price, err := k.pricefeedKeeper.GetCurrentPrice(ctx, marketID)
 	if err != nil {
 		return sdk.Dec{}, err
 	}
-	// convert absolute ratio to collateralization ratio
-	respectiveCollateralRatio := absoluteRatio.Quo(price.Price)
-	return respectiveCollateralRatio, nil
+	return price.Price, nil
 }
 
 // SetMarketStatus sets the status of the input market, true means the market is up and running, false means it is down
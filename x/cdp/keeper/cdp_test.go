@@ -83,7 +83,7 @@ func (suite *CdpTestSuite) TestAddCdp() {
 	suite.Equal(i(10000000), tp)
 	sk := suite.app.GetSupplyKeeper()
 	macc := sk.GetModuleAccount(suite.ctx, types.ModuleName)
-	suite.Equal(cs(c("debt", 10000000), c("xrp", 100000000)), macc.GetCoins())
+	suite.Equal(cs(c("debtusdx", 10000000), c("xrp", 100000000)), macc.GetCoins())
 	acc = ak.GetAccount(suite.ctx, addrs[0])
 	suite.Equal(cs(c("usdx", 10000000), c("xrp", 100000000), c("btc", 500000000)), acc.GetCoins())
 
@@ -97,7 +97,7 @@ func (suite *CdpTestSuite) TestAddCdp() {
 	tp = suite.keeper.GetTotalPrincipal(suite.ctx, "btc-a", "usdx")
 	suite.Equal(i(100000000), tp)
 	macc = sk.GetModuleAccount(suite.ctx, types.ModuleName)
-	suite.Equal(cs(c("debt", 110000000), c("xrp", 100000000), c("btc", 500000000)), macc.GetCoins())
+	suite.Equal(cs(c("debtusdx", 110000000), c("xrp", 100000000), c("btc", 500000000)), macc.GetCoins())
 	acc = ak.GetAccount(suite.ctx, addrs[0])
 	suite.Equal(cs(c("usdx", 110000000), c("xrp", 100000000)), acc.GetCoins())
 
@@ -107,6 +107,48 @@ func (suite *CdpTestSuite) TestAddCdp() {
 	suite.Require().True(errors.Is(err, types.ErrCdpAlreadyExists))
 }
 
+func (suite *CdpTestSuite) TestAddCdpMultipleDebtAssets() {
+	// add a second debt asset that can be drawn against bnb using a new collateral type
+	params := suite.keeper.GetParams(suite.ctx)
+	params.GlobalDebtLimit = params.GlobalDebtLimit.Add(c("eurx", 100000000000))
+	eurxDebtParam := params.DebtParams[0]
+	eurxDebtParam.Denom = "eurx"
+	eurxDebtParam.ReferenceAsset = "eur"
+	params.DebtParams = append(params.DebtParams, eurxDebtParam)
+	bnbCollateralParam := params.CollateralParams[2]
+	bnbCollateralParam.Type = "bnb-b"
+	bnbCollateralParam.Prefix = 0x23
+	bnbCollateralParam.DebtLimit = c("eurx", 100000000000)
+	params.CollateralParams = append(params.CollateralParams, bnbCollateralParam)
+	suite.Require().NoError(params.Validate())
+	suite.keeper.SetParams(suite.ctx, params)
+
+	_, addrs := app.GeneratePrivKeyAddressPairs(1)
+	ak := suite.app.GetAccountKeeper()
+	acc := ak.NewAccountWithAddress(suite.ctx, addrs[0])
+	acc.SetCoins(cs(c("bnb", 200000000000)))
+	ak.SetAccount(suite.ctx, acc)
+
+	// each collateral type can only be used to draw its own debt asset
+	err := suite.keeper.AddCdp(suite.ctx, addrs[0], c("bnb", 100000000000), c("usdx", 10000000), "bnb-b")
+	suite.Require().True(errors.Is(err, types.ErrInvalidDebtRequest))
+
+	err = suite.keeper.AddCdp(suite.ctx, addrs[0], c("bnb", 100000000000), c("usdx", 10000000), "bnb-a")
+	suite.Require().NoError(err)
+	err = suite.keeper.AddCdp(suite.ctx, addrs[0], c("bnb", 100000000000), c("eurx", 20000000), "bnb-b")
+	suite.Require().NoError(err)
+
+	suite.Equal(i(10000000), suite.keeper.GetTotalPrincipal(suite.ctx, "bnb-a", "usdx"))
+	suite.Equal(i(20000000), suite.keeper.GetTotalPrincipal(suite.ctx, "bnb-b", "eurx"))
+
+	// debt coins are tracked separately for each debt asset
+	sk := suite.app.GetSupplyKeeper()
+	macc := sk.GetModuleAccount(suite.ctx, types.ModuleName)
+	suite.Equal(cs(c("bnb", 200000000000), c("debteurx", 20000000), c("debtusdx", 10000000)), macc.GetCoins())
+	acc = ak.GetAccount(suite.ctx, addrs[0])
+	suite.Equal(cs(c("eurx", 20000000), c("usdx", 10000000)), acc.GetCoins())
+}
+
 func (suite *CdpTestSuite) TestGetSetCollateralTypeByte() {
 	_, found := suite.keeper.GetCollateralTypePrefix(suite.ctx, "lol-a")
 	suite.False(found)
@@ -131,7 +173,7 @@ func (suite *CdpTestSuite) TestGetNextCdpID() {
 
 func (suite *CdpTestSuite) TestGetSetCdp() {
 	_, addrs := app.GeneratePrivKeyAddressPairs(1)
-	cdp := types.NewCDP(types.DefaultCdpStartingID, addrs[0], c("xrp", 1), "xrp-a", c("usdx", 1), tmtime.Canonical(time.Now()))
+	cdp := types.NewCDP(types.DefaultCdpStartingID, addrs[0], c("xrp", 1), "xrp-a", c("usdx", 1), tmtime.Canonical(time.Now()), sdk.OneDec())
 	err := suite.keeper.SetCDP(suite.ctx, cdp)
 	suite.NoError(err)
 
@@ -147,7 +189,7 @@ func (suite *CdpTestSuite) TestGetSetCdp() {
 
 func (suite *CdpTestSuite) TestGetSetCdpId() {
 	_, addrs := app.GeneratePrivKeyAddressPairs(2)
-	cdp := types.NewCDP(types.DefaultCdpStartingID, addrs[0], c("xrp", 1), "xrp-a", c("usdx", 1), tmtime.Canonical(time.Now()))
+	cdp := types.NewCDP(types.DefaultCdpStartingID, addrs[0], c("xrp", 1), "xrp-a", c("usdx", 1), tmtime.Canonical(time.Now()), sdk.OneDec())
 	err := suite.keeper.SetCDP(suite.ctx, cdp)
 	suite.NoError(err)
 	suite.keeper.IndexCdpByOwner(suite.ctx, cdp)
@@ -162,7 +204,7 @@ func (suite *CdpTestSuite) TestGetSetCdpId() {
 
 func (suite *CdpTestSuite) TestGetSetCdpByOwnerAndCollateralType() {
 	_, addrs := app.GeneratePrivKeyAddressPairs(2)
-	cdp := types.NewCDP(types.DefaultCdpStartingID, addrs[0], c("xrp", 1), "xrp-a", c("usdx", 1), tmtime.Canonical(time.Now()))
+	cdp := types.NewCDP(types.DefaultCdpStartingID, addrs[0], c("xrp", 1), "xrp-a", c("usdx", 1), tmtime.Canonical(time.Now()), sdk.OneDec())
 	err := suite.keeper.SetCDP(suite.ctx, cdp)
 	suite.NoError(err)
 	suite.keeper.IndexCdpByOwner(suite.ctx, cdp)
@@ -178,17 +220,17 @@ func (suite *CdpTestSuite) TestGetSetCdpByOwnerAndCollateralType() {
 
 func (suite *CdpTestSuite) TestCalculateCollateralToDebtRatio() {
 	_, addrs := app.GeneratePrivKeyAddressPairs(1)
-	cdp := types.NewCDP(types.DefaultCdpStartingID, addrs[0], c("xrp", 3), "xrp-a", c("usdx", 1), tmtime.Canonical(time.Now()))
+	cdp := types.NewCDP(types.DefaultCdpStartingID, addrs[0], c("xrp", 3), "xrp-a", c("usdx", 1), tmtime.Canonical(time.Now()), sdk.OneDec())
 	cr := suite.keeper.CalculateCollateralToDebtRatio(suite.ctx, cdp.Collateral, cdp.Type, cdp.Principal)
 	suite.Equal(sdk.MustNewDecFromStr("3.0"), cr)
-	cdp = types.NewCDP(types.DefaultCdpStartingID, addrs[0], c("xrp", 1), "xrp-a", c("usdx", 2), tmtime.Canonical(time.Now()))
+	cdp = types.NewCDP(types.DefaultCdpStartingID, addrs[0], c("xrp", 1), "xrp-a", c("usdx", 2), tmtime.Canonical(time.Now()), sdk.OneDec())
 	cr = suite.keeper.CalculateCollateralToDebtRatio(suite.ctx, cdp.Collateral, cdp.Type, cdp.Principal)
 	suite.Equal(sdk.MustNewDecFromStr("0.5"), cr)
 }
 
 func (suite *CdpTestSuite) TestSetCdpByCollateralRatio() {
 	_, addrs := app.GeneratePrivKeyAddressPairs(1)
-	cdp := types.NewCDP(types.DefaultCdpStartingID, addrs[0], c("xrp", 3), "xrp-a", c("usdx", 1), tmtime.Canonical(time.Now()))
+	cdp := types.NewCDP(types.DefaultCdpStartingID, addrs[0], c("xrp", 3), "xrp-a", c("usdx", 1), tmtime.Canonical(time.Now()), sdk.OneDec())
 	cr := suite.keeper.CalculateCollateralToDebtRatio(suite.ctx, cdp.Collateral, cdp.Type, cdp.Principal)
 	suite.NotPanics(func() { suite.keeper.IndexCdpByCollateralRatio(suite.ctx, cdp.Type, cdp.ID, cr) })
 }
@@ -280,6 +322,23 @@ func (suite *CdpTestSuite) TestValidatePrincipal() {
 	suite.NoError(err)
 }
 
+func (suite *CdpTestSuite) TestValidateDebtLimitAcrossCollateralTypes() {
+	params := suite.keeper.GetParams(suite.ctx)
+	params.GlobalDebtLimit = sdk.NewCoins(sdk.NewInt64Coin("usdx", 600000000000))
+	suite.keeper.SetParams(suite.ctx, params)
+	suite.keeper.SetTotalPrincipal(suite.ctx, "btc-a", "usdx", sdk.NewInt(400000000000))
+
+	// within the xrp-a debt limit, but the usdx drawn across all collateral types would exceed the global debt limit
+	err := suite.keeper.ValidateDebtLimit(suite.ctx, "xrp-a", sdk.NewInt64Coin("usdx", 300000000000))
+	suite.Require().True(errors.Is(err, types.ErrExceedsDebtLimit))
+	err = suite.keeper.ValidateDebtLimit(suite.ctx, "xrp-a", sdk.NewInt64Coin("usdx", 200000000000))
+	suite.NoError(err)
+
+	risk, err := suite.keeper.GetCollateralTypeRisk(suite.ctx, "xrp-a")
+	suite.NoError(err)
+	suite.Equal(sdk.NewInt64Coin("usdx", 200000000000), risk.DebtHeadroom)
+}
+
 func (suite *CdpTestSuite) TestCalculateCollateralizationRatio() {
 	c := cdps()[1]
 	err := suite.keeper.SetCDP(suite.ctx, c)
@@ -298,20 +357,20 @@ func (suite *CdpTestSuite) TestCalculateCollateralizationRatio() {
 
 func (suite *CdpTestSuite) TestMintBurnDebtCoins() {
 	cd := cdps()[1]
-	err := suite.keeper.MintDebtCoins(suite.ctx, types.ModuleName, suite.keeper.GetDebtDenom(suite.ctx), cd.Principal)
+	err := suite.keeper.MintDebtCoins(suite.ctx, types.ModuleName, suite.keeper.GetDebtCoinDenom(suite.ctx, cd.Principal.Denom), cd.Principal)
 	suite.NoError(err)
 	suite.Require().Panics(func() {
-		_ = suite.keeper.MintDebtCoins(suite.ctx, "notamodule", suite.keeper.GetDebtDenom(suite.ctx), cd.Principal)
+		_ = suite.keeper.MintDebtCoins(suite.ctx, "notamodule", suite.keeper.GetDebtCoinDenom(suite.ctx, cd.Principal.Denom), cd.Principal)
 	})
 
 	sk := suite.app.GetSupplyKeeper()
 	acc := sk.GetModuleAccount(suite.ctx, types.ModuleName)
-	suite.Equal(cs(c("debt", 10000000)), acc.GetCoins())
+	suite.Equal(cs(c("debtusdx", 10000000)), acc.GetCoins())
 
-	err = suite.keeper.BurnDebtCoins(suite.ctx, types.ModuleName, suite.keeper.GetDebtDenom(suite.ctx), cd.Principal)
+	err = suite.keeper.BurnDebtCoins(suite.ctx, types.ModuleName, suite.keeper.GetDebtCoinDenom(suite.ctx, cd.Principal.Denom), cd.Principal)
 	suite.NoError(err)
 	suite.Require().Panics(func() {
-		_ = suite.keeper.BurnDebtCoins(suite.ctx, "notamodule", suite.keeper.GetDebtDenom(suite.ctx), cd.Principal)
+		_ = suite.keeper.BurnDebtCoins(suite.ctx, "notamodule", suite.keeper.GetDebtCoinDenom(suite.ctx, cd.Principal.Denom), cd.Principal)
 	})
 	sk = suite.app.GetSupplyKeeper()
 	acc = sk.GetModuleAccount(suite.ctx, types.ModuleName)
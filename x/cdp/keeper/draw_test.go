@@ -68,7 +68,7 @@ func (suite *DrawTestSuite) TestAddRepayPrincipal() {
 	suite.Equal(i(20000000), tp)
 	sk := suite.app.GetSupplyKeeper()
 	acc := sk.GetModuleAccount(suite.ctx, types.ModuleName)
-	suite.Equal(cs(c("xrp", 400000000), c("debt", 20000000)), acc.GetCoins())
+	suite.Equal(cs(c("xrp", 400000000), c("debtusdx", 20000000)), acc.GetCoins())
 
 	err = suite.keeper.AddPrincipal(suite.ctx, suite.addrs[0], "xrp-a", c("susd", 10000000))
 	suite.Require().True(errors.Is(err, types.ErrInvalidDebtRequest))
@@ -95,7 +95,7 @@ func (suite *DrawTestSuite) TestAddRepayPrincipal() {
 	suite.Equal(ts[0], t)
 	sk = suite.app.GetSupplyKeeper()
 	acc = sk.GetModuleAccount(suite.ctx, types.ModuleName)
-	suite.Equal(cs(c("xrp", 400000000), c("debt", 10000000)), acc.GetCoins())
+	suite.Equal(cs(c("xrp", 400000000), c("debtusdx", 10000000)), acc.GetCoins())
 
 	err = suite.keeper.RepayPrincipal(suite.ctx, suite.addrs[0], "xrp-a", c("xusd", 10000000))
 	suite.Require().True(errors.Is(err, types.ErrInvalidPayment))
@@ -130,20 +130,22 @@ func (suite *DrawTestSuite) TestRepayPrincipalOverpay() {
 }
 
 func (suite *DrawTestSuite) TestAddRepayPrincipalFees() {
-	err := suite.keeper.AddCdp(suite.ctx, suite.addrs[2], c("xrp", 1000000000000), c("usdx", 100000000000), "xrp-a")
+	err := suite.keeper.AccumulateInterest(suite.ctx, "xrp-a")
+	suite.NoError(err)
+	err = suite.keeper.AddCdp(suite.ctx, suite.addrs[2], c("xrp", 1000000000000), c("usdx", 100000000000), "xrp-a")
 	suite.NoError(err)
 	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Minute * 10))
-	err = suite.keeper.UpdateFeesForAllCdps(suite.ctx, "xrp-a")
+	err = suite.keeper.AccumulateInterest(suite.ctx, "xrp-a")
 	suite.NoError(err)
 	err = suite.keeper.AddPrincipal(suite.ctx, suite.addrs[2], "xrp-a", c("usdx", 10000000))
 	suite.NoError(err)
 	t, _ := suite.keeper.GetCDP(suite.ctx, "xrp-a", uint64(2))
-	suite.Equal(c("usdx", 92827), t.AccumulatedFees)
+	suite.Equal(c("usdx", 92826), t.AccumulatedFees)
 	err = suite.keeper.RepayPrincipal(suite.ctx, suite.addrs[2], "xrp-a", c("usdx", 100))
 	suite.NoError(err)
 	t, _ = suite.keeper.GetCDP(suite.ctx, "xrp-a", uint64(2))
-	suite.Equal(c("usdx", 92727), t.AccumulatedFees)
-	err = suite.keeper.RepayPrincipal(suite.ctx, suite.addrs[2], "xrp-a", c("usdx", 100010092727))
+	suite.Equal(c("usdx", 92726), t.AccumulatedFees)
+	err = suite.keeper.RepayPrincipal(suite.ctx, suite.addrs[2], "xrp-a", c("usdx", 100010092726))
 	suite.NoError(err)
 	_, f := suite.keeper.GetCDP(suite.ctx, "xrp-a", uint64(2))
 	suite.False(f)
@@ -152,12 +154,12 @@ func (suite *DrawTestSuite) TestAddRepayPrincipalFees() {
 	suite.NoError(err)
 
 	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Second * 31536000)) // move forward one year in time
-	err = suite.keeper.UpdateFeesForAllCdps(suite.ctx, "xrp-a")
+	err = suite.keeper.AccumulateInterest(suite.ctx, "xrp-a")
 	suite.NoError(err)
 	err = suite.keeper.AddPrincipal(suite.ctx, suite.addrs[2], "xrp-a", c("usdx", 100000000))
 	suite.NoError(err)
 	t, _ = suite.keeper.GetCDP(suite.ctx, "xrp-a", uint64(3))
-	suite.Equal(c("usdx", 5000000), t.AccumulatedFees)
+	suite.Equal(c("usdx", 4999999), t.AccumulatedFees)
 }
 
 func (suite *DrawTestSuite) TestPricefeedFailure() {
@@ -2,100 +2,177 @@ package keeper
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/kava-labs/kava/x/cdp/types"
 )
 
-// CalculateFees returns the fees accumulated since fees were last calculated based on
-// the input amount of outstanding debt (principal) and the number of periods (seconds) that have passed
-func (k Keeper) CalculateFees(ctx sdk.Context, principal sdk.Coin, periods sdk.Int, collateralType string) sdk.Coin {
-	// how fees are calculated:
-	// feesAccumulated = (outstandingDebt * (feeRate^periods)) - outstandingDebt
+// CalculateInterestFactor returns the factor by which debt grows over the input number of periods (seconds)
+// when compounded at the input per second fee rate, ie feeRate^periods
+func CalculateInterestFactor(feePerSecond sdk.Dec, periods sdk.Int) sdk.Dec {
 	// Note that since we can't do x^y using sdk.Decimal, we are converting to int and using RelativePow
-	feePerSecond := k.getFeeRate(ctx, collateralType)
-	scalar := sdk.NewInt(1000000000000000000)
+	scalar := sdk.NewInt(BaseDigitFactor)
 	feeRateInt := feePerSecond.Mul(sdk.NewDecFromInt(scalar)).TruncateInt()
-	accumulator := sdk.NewDecFromInt(types.RelativePow(feeRateInt, periods, scalar)).Mul(sdk.SmallestDec())
-	feesAccumulated := (sdk.NewDecFromInt(principal.Amount).Mul(accumulator)).Sub(sdk.NewDecFromInt(principal.Amount))
-	newFees := sdk.NewCoin(principal.Denom, feesAccumulated.TruncateInt())
-	return newFees
+	return sdk.NewDecFromInt(types.RelativePow(feeRateInt, periods, scalar)).Mul(sdk.SmallestDec())
 }
 
-// UpdateFeesForAllCdps updates the fees for each of the CDPs
-func (k Keeper) UpdateFeesForAllCdps(ctx sdk.Context, collateralType string) error {
-	var iterationErr error
-	k.IterateCdpsByCollateralType(ctx, collateralType, func(cdp types.CDP) bool {
-		oldCollateralToDebtRatio := k.CalculateCollateralToDebtRatio(ctx, cdp.Collateral, cdp.Type, cdp.GetTotalPrincipal())
-		// periods = bblock timestamp - fees updated
-		periods := sdk.NewInt(ctx.BlockTime().Unix()).Sub(sdk.NewInt(cdp.FeesUpdated.Unix()))
-
-		newFees := k.CalculateFees(ctx, cdp.Principal, periods, collateralType)
-
-		// exit without updating fees if amount has rounded down to zero
-		// cdp will get updated next block when newFees, newFeesSavings, newFeesSurplus >0
-		if newFees.IsZero() {
-			return false
-		}
+// AccumulateInterest updates the global interest factor of the input collateral type based on the time elapsed since
+// interest was last accumulated and adds the resulting fees to the total principal of the collateral type.
+// Individual cdps are brought up to date lazily, see SynchronizeInterest.
+func (k Keeper) AccumulateInterest(ctx sdk.Context, collateralType string) error {
+	previousAccrualTime, found := k.GetPreviousAccrualTime(ctx, collateralType)
+	if !found {
+		k.SetPreviousAccrualTime(ctx, collateralType, ctx.BlockTime())
+		return nil
+	}
+	periods := sdk.NewInt(ctx.BlockTime().Unix()).Sub(sdk.NewInt(previousAccrualTime.Unix()))
+	if !periods.IsPositive() {
+		return nil
+	}
 
-		dp, found := k.GetDebtParam(ctx, cdp.Principal.Denom)
-		if !found {
-			return false
-		}
-		savingsRate := dp.SavingsRate
+	feePerSecond := k.getFeeRate(ctx, collateralType)
+	dp, found := k.GetDebtParam(ctx, k.getPrincipalDenom(ctx, collateralType))
+	if !found {
+		return sdkerrors.Wrap(types.ErrDebtNotSupported, k.getPrincipalDenom(ctx, collateralType))
+	}
+	totalPrincipalPrior := k.GetTotalPrincipal(ctx, collateralType, dp.Denom)
+	// no interest accrues when there is no outstanding debt or the stability fee is zero
+	if totalPrincipalPrior.IsZero() || feePerSecond.Equal(sdk.OneDec()) {
+		k.SetPreviousAccrualTime(ctx, collateralType, ctx.BlockTime())
+		return nil
+	}
 
-		newFeesSavings := sdk.NewDecFromInt(newFees.Amount).Mul(savingsRate).RoundInt()
-		newFeesSurplus := newFees.Amount.Sub(newFeesSavings)
+	interestFactor := CalculateInterestFactor(feePerSecond, periods)
+	newFees := sdk.NewDecFromInt(totalPrincipalPrior).Mul(interestFactor).TruncateInt().Sub(totalPrincipalPrior)
 
-		// similar to checking for rounding to zero of all fees, but in this case we
-		// need to handle cases where we expect surplus or savings fees to be zero, namely
-		// if newFeesSavings = 0, check if savings rate is not zero
-		// if newFeesSurplus = 0, check if savings rate is not one
-		if (newFeesSavings.IsZero() && !savingsRate.IsZero()) || (newFeesSurplus.IsZero() && !savingsRate.Equal(sdk.OneDec())) {
-			return false
-		}
+	// exit without updating the accrual time if the fees have rounded down to zero,
+	// they will be accumulated in a later block once they are large enough
+	if !newFees.IsPositive() {
+		return nil
+	}
 
-		// mint debt coins to the cdp account
-		err := k.MintDebtCoins(ctx, types.ModuleName, k.GetDebtDenom(ctx), newFees)
-		if err != nil {
-			iterationErr = err
-			return true
-		}
+	newFeesSavings := sdk.NewDecFromInt(newFees).Mul(dp.SavingsRate).RoundInt()
+	newFeesSurplus := newFees.Sub(newFeesSavings)
 
-		previousDebt := k.GetTotalPrincipal(ctx, cdp.Type, dp.Denom)
-		newDebt := previousDebt.Add(newFees.Amount)
-		k.SetTotalPrincipal(ctx, cdp.Type, dp.Denom, newDebt)
+	// mint debt coins to the cdp account
+	err := k.MintDebtCoins(ctx, types.ModuleName, k.GetDebtCoinDenom(ctx, dp.Denom), sdk.NewCoin(dp.Denom, newFees))
+	if err != nil {
+		return err
+	}
 
-		// mint surplus coins divided between the liquidator and savings module accounts.
+	// mint surplus coins divided between the liquidator and savings module accounts.
+	if newFeesSurplus.IsPositive() {
 		err = k.supplyKeeper.MintCoins(ctx, types.LiquidatorMacc, sdk.NewCoins(sdk.NewCoin(dp.Denom, newFeesSurplus)))
 		if err != nil {
-			iterationErr = err
-			return true
+			return err
 		}
-
+	}
+	if newFeesSavings.IsPositive() {
 		err = k.supplyKeeper.MintCoins(ctx, types.SavingsRateMacc, sdk.NewCoins(sdk.NewCoin(dp.Denom, newFeesSavings)))
 		if err != nil {
-			iterationErr = err
-			return true
+			return err
 		}
+	}
 
-		// now add the new fees to the accumulated fees for the cdp
-		cdp.AccumulatedFees = cdp.AccumulatedFees.Add(newFees)
+	// the interest factor grows by the fees actually added to the total principal, so that the
+	// fees later settled on individual cdps never exceed the total
+	totalPrincipalNew := totalPrincipalPrior.Add(newFees)
+	effectiveFactor := sdk.NewDecFromInt(totalPrincipalNew).Quo(sdk.NewDecFromInt(totalPrincipalPrior))
+	k.SetTotalPrincipal(ctx, collateralType, dp.Denom, totalPrincipalNew)
+	k.SetInterestFactor(ctx, collateralType, k.GetInterestFactor(ctx, collateralType).Mul(effectiveFactor))
+	k.SetPreviousAccrualTime(ctx, collateralType, ctx.BlockTime())
+	return nil
+}
 
-		// and set the fees updated time to the current block time since we just updated it
-		cdp.FeesUpdated = ctx.BlockTime()
-		collateralToDebtRatio := k.CalculateCollateralToDebtRatio(ctx, cdp.Collateral, cdp.Type, cdp.GetTotalPrincipal())
-		k.RemoveCdpCollateralRatioIndex(ctx, cdp.Type, cdp.ID, oldCollateralToDebtRatio)
-		err = k.SetCdpAndCollateralRatioIndex(ctx, cdp, collateralToDebtRatio)
-		if err != nil {
-			iterationErr = err
-			return true
-		}
-		return false // this returns true when you want to stop iterating. Since we want to iterate through all we return false
-	})
-	return iterationErr
+// CalculateNewInterest returns the fees that have accumulated on the input cdp since it was last synchronized
+// with the interest factor of its collateral type
+func (k Keeper) CalculateNewInterest(ctx sdk.Context, cdp types.CDP) sdk.Coin {
+	newFees := sdk.NewCoin(cdp.AccumulatedFees.Denom, sdk.ZeroInt())
+	globalInterestFactor := k.GetInterestFactor(ctx, cdp.Type)
+	if cdp.InterestFactor.IsNil() || !cdp.InterestFactor.IsPositive() || globalInterestFactor.LTE(cdp.InterestFactor) {
+		return newFees
+	}
+	totalPrincipal := cdp.GetTotalPrincipal().Amount
+	cdpInterestFactor := globalInterestFactor.Quo(cdp.InterestFactor)
+	newFees.Amount = sdk.NewDecFromInt(totalPrincipal).Mul(cdpInterestFactor).TruncateInt().Sub(totalPrincipal)
+	return newFees
+}
+
+// SynchronizeInterest adds the fees accumulated since the cdp was last synchronized to the cdp, updates the cdp
+// and its collateral ratio index in the store and returns the updated cdp
+func (k Keeper) SynchronizeInterest(ctx sdk.Context, cdp types.CDP) (types.CDP, error) {
+	syncedCdp := k.GetSynchronizedCdp(ctx, cdp)
+	// leave the cdp untouched if the fees have rounded down to zero so that they keep accumulating
+	if syncedCdp.AccumulatedFees.IsEqual(cdp.AccumulatedFees) {
+		return cdp, nil
+	}
+
+	oldCollateralToDebtRatio := k.CalculateCollateralToDebtRatio(ctx, cdp.Collateral, cdp.Type, cdp.GetNormalizedPrincipal())
+	k.RemoveCdpCollateralRatioIndex(ctx, cdp.Type, cdp.ID, oldCollateralToDebtRatio)
+
+	collateralToDebtRatio := k.CalculateCollateralToDebtRatio(ctx, syncedCdp.Collateral, syncedCdp.Type, syncedCdp.GetNormalizedPrincipal())
+	err := k.SetCdpAndCollateralRatioIndex(ctx, syncedCdp, collateralToDebtRatio)
+	if err != nil {
+		return types.CDP{}, err
+	}
+	return syncedCdp, nil
+}
+
+// GetSynchronizedCdp returns the input cdp with the fees accumulated since it was last synchronized added, without
+// updating the store. The cdp is returned unchanged if the new fees have rounded down to zero.
+func (k Keeper) GetSynchronizedCdp(ctx sdk.Context, cdp types.CDP) types.CDP {
+	newFees := k.CalculateNewInterest(ctx, cdp)
+	if newFees.IsZero() {
+		return cdp
+	}
+
+	cdp.AccumulatedFees = cdp.AccumulatedFees.Add(newFees)
+	cdp.InterestFactor = k.GetInterestFactor(ctx, cdp.Type)
+	cdp.FeesUpdated = ctx.BlockTime()
+	if previousAccrualTime, found := k.GetPreviousAccrualTime(ctx, cdp.Type); found {
+		cdp.FeesUpdated = previousAccrualTime
+	}
+	return cdp
+}
+
+// GetInterestFactor returns the cumulative interest factor of the input collateral type, or one if interest has never accrued
+func (k Keeper) GetInterestFactor(ctx sdk.Context, collateralType string) sdk.Dec {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.InterestFactorPrefix)
+	bz := store.Get([]byte(collateralType))
+	if bz == nil {
+		return sdk.OneDec()
+	}
+	var interestFactor sdk.Dec
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &interestFactor)
+	return interestFactor
+}
+
+// SetInterestFactor sets the cumulative interest factor of the input collateral type
+func (k Keeper) SetInterestFactor(ctx sdk.Context, collateralType string, interestFactor sdk.Dec) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.InterestFactorPrefix)
+	store.Set([]byte(collateralType), k.cdc.MustMarshalBinaryLengthPrefixed(interestFactor))
+}
+
+// GetPreviousAccrualTime returns the time interest was last accumulated for the input collateral type
+func (k Keeper) GetPreviousAccrualTime(ctx sdk.Context, collateralType string) (time.Time, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PreviousAccrualTimePrefix)
+	bz := store.Get([]byte(collateralType))
+	if bz == nil {
+		return time.Time{}, false
+	}
+	var accrualTime time.Time
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &accrualTime)
+	return accrualTime, true
+}
+
+// SetPreviousAccrualTime sets the time interest was last accumulated for the input collateral type
+func (k Keeper) SetPreviousAccrualTime(ctx sdk.Context, collateralType string, accrualTime time.Time) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PreviousAccrualTimePrefix)
+	store.Set([]byte(collateralType), k.cdc.MustMarshalBinaryLengthPrefixed(accrualTime))
 }
 
 // IncrementTotalPrincipal increments the total amount of debt that has been drawn with that collateral type
@@ -126,6 +203,18 @@ func (k Keeper) GetTotalPrincipal(ctx sdk.Context, collateralType, principalDeno
 	return total
 }
 
+// getDenomTotalPrincipal returns the total amount of principal that has been drawn in a debt denom across all collateral types
+func (k Keeper) getDenomTotalPrincipal(ctx sdk.Context, principalDenom string) sdk.Int {
+	total := sdk.ZeroInt()
+	for _, cp := range k.GetParams(ctx).CollateralParams {
+		if cp.DebtLimit.Denom != principalDenom {
+			continue
+		}
+		total = total.Add(k.GetTotalPrincipal(ctx, cp.Type, principalDenom))
+	}
+	return total
+}
+
 // SetTotalPrincipal sets the total amount of principal that has been drawn for the input collateral
 func (k Keeper) SetTotalPrincipal(ctx sdk.Context, collateralType, principalDenom string, total sdk.Int) {
 	store := prefix.NewStore(ctx.KVStore(k.key), types.PrincipalKeyPrefix)
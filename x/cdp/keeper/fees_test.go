@@ -66,34 +66,100 @@ func (suite *FeeTestSuite) createCdps() {
 
 }
 
-// TestUpdateFees tests the functionality for updating the fees for CDPs
-func (suite *FeeTestSuite) TestUpdateFees() {
+// TestAccumulateInterest tests the functionality for accumulating interest for a collateral type
+func (suite *FeeTestSuite) TestAccumulateInterest() {
 	// this helper function creates two CDPs with id 1 and 2 respectively, each with zero fees
 	suite.createCdps()
 
-	// move the context forward in time so that cdps will have fees accumulate if CalculateFees is called
+	// the first call only records the accrual time
+	err := suite.keeper.AccumulateInterest(suite.ctx, "xrp-a")
+	suite.NoError(err)
+	suite.Equal(sdk.OneDec(), suite.keeper.GetInterestFactor(suite.ctx, "xrp-a"))
+	accrualTime, found := suite.keeper.GetPreviousAccrualTime(suite.ctx, "xrp-a")
+	suite.True(found)
+	suite.Equal(suite.ctx.BlockTime(), accrualTime)
+
+	// move the context forward in time so that interest accumulates
 	// note - time must be moved forward by a sufficient amount in order for additional
 	// fees to accumulate, in this example 600 seconds
-	oldtime := suite.ctx.BlockTime()
 	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Second * 600))
-	err := suite.keeper.UpdateFeesForAllCdps(suite.ctx, "xrp-a")
+	err = suite.keeper.AccumulateInterest(suite.ctx, "xrp-a")
 	suite.NoError(err) // check that we don't have any error
 
-	// cdp we expect fees to accumulate for
-	cdp1, found := suite.keeper.GetCDP(suite.ctx, "xrp-a", 1)
-	suite.True(found)
-	// check fees are not zero
-	// check that the fees have been updated
-	suite.False(cdp1.AccumulatedFees.IsZero())
-	// now check that we have the correct amount of fees overall (22 USDX for this scenario)
-	suite.Equal(sdk.NewInt(22), cdp1.AccumulatedFees.Amount)
-	suite.Equal(suite.ctx.BlockTime(), cdp1.FeesUpdated)
-	// cdp we expect fees to not accumulate for because of rounding to zero
-	cdp2, found := suite.keeper.GetCDP(suite.ctx, "xrp-a", 2)
-	suite.True(found)
-	// check fees are zero
+	// the interest factor grows by the stability fee compounded over 600 seconds, after rounding
+	expectedFactor := sdk.NewDec(34000031).Quo(sdk.NewDec(34000000))
+	suite.Equal(expectedFactor, suite.keeper.GetInterestFactor(suite.ctx, "xrp-a"))
+	accrualTime, _ = suite.keeper.GetPreviousAccrualTime(suite.ctx, "xrp-a")
+	suite.Equal(suite.ctx.BlockTime(), accrualTime)
+
+	// total principal includes the interest of both cdps (31 USDX for this scenario) without touching them
+	suite.Equal(i(34000031), suite.keeper.GetTotalPrincipal(suite.ctx, "xrp-a", "usdx"))
+	cdp1, _ := suite.keeper.GetCDP(suite.ctx, "xrp-a", 1)
+	suite.True(cdp1.AccumulatedFees.IsZero())
+	suite.Equal(sdk.OneDec(), cdp1.InterestFactor)
+}
+
+// TestSynchronizeInterest tests that fees are settled on a cdp when it is synchronized
+func (suite *FeeTestSuite) TestSynchronizeInterest() {
+	suite.createCdps()
+	err := suite.keeper.AccumulateInterest(suite.ctx, "xrp-a")
+	suite.NoError(err)
+	oldtime := suite.ctx.BlockTime()
+
+	// interest that rounds down to zero leaves the cdp untouched
+	suite.ctx = suite.ctx.WithBlockTime(oldtime.Add(time.Second))
+	err = suite.keeper.AccumulateInterest(suite.ctx, "xrp-a")
+	suite.NoError(err)
+	cdp2, _ := suite.keeper.GetCDP(suite.ctx, "xrp-a", 2)
+	cdp2, err = suite.keeper.SynchronizeInterest(suite.ctx, cdp2)
+	suite.NoError(err)
 	suite.True(cdp2.AccumulatedFees.IsZero())
 	suite.Equal(oldtime, cdp2.FeesUpdated)
+
+	suite.ctx = suite.ctx.WithBlockTime(oldtime.Add(time.Second * 600))
+	err = suite.keeper.AccumulateInterest(suite.ctx, "xrp-a")
+	suite.NoError(err)
+
+	cdp1, _ := suite.keeper.GetCDP(suite.ctx, "xrp-a", 1)
+	suite.Equal(c("usdx", 21), suite.keeper.CalculateNewInterest(suite.ctx, cdp1))
+	cdp1, err = suite.keeper.SynchronizeInterest(suite.ctx, cdp1)
+	suite.NoError(err)
+	// now check that we have the correct amount of fees overall (21 USDX for this scenario, its share of the 31 USDX total)
+	suite.Equal(c("usdx", 21), cdp1.AccumulatedFees)
+	suite.Equal(suite.ctx.BlockTime(), cdp1.FeesUpdated)
+	suite.Equal(suite.keeper.GetInterestFactor(suite.ctx, "xrp-a"), cdp1.InterestFactor)
+	storedCdp, _ := suite.keeper.GetCDP(suite.ctx, "xrp-a", 1)
+	suite.Equal(cdp1, storedCdp)
+
+	// synchronizing again is a no-op
+	suite.True(suite.keeper.CalculateNewInterest(suite.ctx, cdp1).IsZero())
+}
+
+// TestCollateralRatioIndexWithInterest tests that the collateral ratio index accounts for unsynchronized interest
+func (suite *FeeTestSuite) TestCollateralRatioIndexWithInterest() {
+	suite.createCdps()
+	err := suite.keeper.AccumulateInterest(suite.ctx, "xrp-a")
+	suite.NoError(err)
+
+	// cdp 1 has a collateral:debt ratio of 200/24 = 8.333...
+	cdp1, _ := suite.keeper.GetCDP(suite.ctx, "xrp-a", 1)
+	ratio := suite.keeper.CalculateCollateralToDebtRatio(suite.ctx, cdp1.Collateral, cdp1.Type, cdp1.Principal)
+	suite.Equal(0, len(suite.keeper.GetAllCdpsByCollateralTypeAndRatio(suite.ctx, "xrp-a", ratio)))
+
+	// after a year of interest the cdp's real ratio has fallen below its original ratio
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Second * 31536000))
+	err = suite.keeper.AccumulateInterest(suite.ctx, "xrp-a")
+	suite.NoError(err)
+	cdps := suite.keeper.GetAllCdpsByCollateralTypeAndRatio(suite.ctx, "xrp-a", ratio)
+	suite.Equal(1, len(cdps))
+	suite.Equal(uint64(1), cdps[0].ID)
+
+	// synchronizing the cdp does not change which cdps are below the ratio
+	_, err = suite.keeper.SynchronizeInterest(suite.ctx, cdps[0])
+	suite.NoError(err)
+	cdps = suite.keeper.GetAllCdpsByCollateralTypeAndRatio(suite.ctx, "xrp-a", ratio)
+	suite.Equal(1, len(cdps))
+	suite.Equal(c("usdx", 1200000), cdps[0].AccumulatedFees)
 }
 
 func TestFeeTestSuite(t *testing.T) {
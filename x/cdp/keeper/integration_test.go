@@ -40,11 +40,7 @@ func NewPricefeedGenState(asset string, price sdk.Dec) app.GenesisState {
 func NewCDPGenState(asset string, liquidationRatio sdk.Dec) app.GenesisState {
 	cdpGenesis := cdp.GenesisState{
 		Params: cdp.Params{
-			GlobalDebtLimit:              sdk.NewInt64Coin("usdx", 1000000000000),
-			SurplusAuctionThreshold:      cdp.DefaultSurplusThreshold,
-			SurplusAuctionLot:            cdp.DefaultSurplusLot,
-			DebtAuctionThreshold:         cdp.DefaultDebtThreshold,
-			DebtAuctionLot:               cdp.DefaultDebtLot,
+			GlobalDebtLimit:              sdk.NewCoins(sdk.NewInt64Coin("usdx", 1000000000000)),
 			SavingsDistributionFrequency: cdp.DefaultSavingsDistributionFrequency,
 			CollateralParams: cdp.CollateralParams{
 				{
@@ -61,12 +57,18 @@ func NewCDPGenState(asset string, liquidationRatio sdk.Dec) app.GenesisState {
 					LiquidationMarketID: asset + ":usd",
 				},
 			},
-			DebtParam: cdp.DebtParam{
-				Denom:            "usdx",
-				ReferenceAsset:   "usd",
-				ConversionFactor: i(6),
-				DebtFloor:        i(10000000),
-				SavingsRate:      d("0.9"),
+			DebtParams: cdp.DebtParams{
+				{
+					Denom:                   "usdx",
+					ReferenceAsset:          "usd",
+					ConversionFactor:        i(6),
+					DebtFloor:               i(10000000),
+					SavingsRate:             d("0.9"),
+					SurplusAuctionThreshold: cdp.DefaultSurplusThreshold,
+					SurplusAuctionLot:       cdp.DefaultSurplusLot,
+					DebtAuctionThreshold:    cdp.DefaultDebtThreshold,
+					DebtAuctionLot:          cdp.DefaultDebtLot,
+				},
 			},
 		},
 		StartingCdpID:            cdp.DefaultCdpStartingID,
@@ -113,11 +115,7 @@ func NewPricefeedGenStateMulti() app.GenesisState {
 func NewCDPGenStateMulti() app.GenesisState {
 	cdpGenesis := cdp.GenesisState{
 		Params: cdp.Params{
-			GlobalDebtLimit:              sdk.NewInt64Coin("usdx", 1500000000000),
-			SurplusAuctionThreshold:      cdp.DefaultSurplusThreshold,
-			SurplusAuctionLot:            cdp.DefaultSurplusLot,
-			DebtAuctionThreshold:         cdp.DefaultDebtThreshold,
-			DebtAuctionLot:               cdp.DefaultDebtLot,
+			GlobalDebtLimit:              sdk.NewCoins(sdk.NewInt64Coin("usdx", 1500000000000)),
 			SavingsDistributionFrequency: cdp.DefaultSavingsDistributionFrequency,
 			CollateralParams: cdp.CollateralParams{
 				{
@@ -160,12 +158,18 @@ func NewCDPGenStateMulti() app.GenesisState {
 					ConversionFactor:    i(8),
 				},
 			},
-			DebtParam: cdp.DebtParam{
-				Denom:            "usdx",
-				ReferenceAsset:   "usd",
-				ConversionFactor: i(6),
-				DebtFloor:        i(10000000),
-				SavingsRate:      d("0.95"),
+			DebtParams: cdp.DebtParams{
+				{
+					Denom:                   "usdx",
+					ReferenceAsset:          "usd",
+					ConversionFactor:        i(6),
+					DebtFloor:               i(10000000),
+					SavingsRate:             d("0.95"),
+					SurplusAuctionThreshold: cdp.DefaultSurplusThreshold,
+					SurplusAuctionLot:       cdp.DefaultSurplusLot,
+					DebtAuctionThreshold:    cdp.DefaultDebtThreshold,
+					DebtAuctionLot:          cdp.DefaultDebtLot,
+				},
 			},
 		},
 		StartingCdpID:            cdp.DefaultCdpStartingID,
@@ -180,11 +184,7 @@ func NewCDPGenStateMulti() app.GenesisState {
 func NewCDPGenStateHighDebtLimit() app.GenesisState {
 	cdpGenesis := cdp.GenesisState{
 		Params: cdp.Params{
-			GlobalDebtLimit:              sdk.NewInt64Coin("usdx", 100000000000000),
-			SurplusAuctionThreshold:      cdp.DefaultSurplusThreshold,
-			SurplusAuctionLot:            cdp.DefaultSurplusLot,
-			DebtAuctionThreshold:         cdp.DefaultDebtThreshold,
-			DebtAuctionLot:               cdp.DefaultDebtLot,
+			GlobalDebtLimit:              sdk.NewCoins(sdk.NewInt64Coin("usdx", 100000000000000)),
 			SavingsDistributionFrequency: cdp.DefaultSavingsDistributionFrequency,
 			CollateralParams: cdp.CollateralParams{
 				{
@@ -214,12 +214,18 @@ func NewCDPGenStateHighDebtLimit() app.GenesisState {
 					ConversionFactor:    i(8),
 				},
 			},
-			DebtParam: cdp.DebtParam{
-				Denom:            "usdx",
-				ReferenceAsset:   "usd",
-				ConversionFactor: i(6),
-				DebtFloor:        i(10000000),
-				SavingsRate:      d("0.95"),
+			DebtParams: cdp.DebtParams{
+				{
+					Denom:                   "usdx",
+					ReferenceAsset:          "usd",
+					ConversionFactor:        i(6),
+					DebtFloor:               i(10000000),
+					SavingsRate:             d("0.95"),
+					SurplusAuctionThreshold: cdp.DefaultSurplusThreshold,
+					SurplusAuctionLot:       cdp.DefaultSurplusLot,
+					DebtAuctionThreshold:    cdp.DefaultDebtThreshold,
+					DebtAuctionLot:          cdp.DefaultDebtLot,
+				},
 			},
 		},
 		StartingCdpID:            cdp.DefaultCdpStartingID,
@@ -233,10 +239,10 @@ func NewCDPGenStateHighDebtLimit() app.GenesisState {
 
 func cdps() (cdps cdp.CDPs) {
 	_, addrs := app.GeneratePrivKeyAddressPairs(3)
-	c1 := cdp.NewCDP(uint64(1), addrs[0], sdk.NewCoin("xrp", sdk.NewInt(10000000)), "xrp-a", sdk.NewCoin("usdx", sdk.NewInt(8000000)), tmtime.Canonical(time.Now()))
-	c2 := cdp.NewCDP(uint64(2), addrs[1], sdk.NewCoin("xrp", sdk.NewInt(100000000)), "xrp-a", sdk.NewCoin("usdx", sdk.NewInt(10000000)), tmtime.Canonical(time.Now()))
-	c3 := cdp.NewCDP(uint64(3), addrs[1], sdk.NewCoin("btc", sdk.NewInt(1000000000)), "btc-a", sdk.NewCoin("usdx", sdk.NewInt(10000000)), tmtime.Canonical(time.Now()))
-	c4 := cdp.NewCDP(uint64(4), addrs[2], sdk.NewCoin("xrp", sdk.NewInt(1000000000)), "xrp-a", sdk.NewCoin("usdx", sdk.NewInt(500000000)), tmtime.Canonical(time.Now()))
+	c1 := cdp.NewCDP(uint64(1), addrs[0], sdk.NewCoin("xrp", sdk.NewInt(10000000)), "xrp-a", sdk.NewCoin("usdx", sdk.NewInt(8000000)), tmtime.Canonical(time.Now()), sdk.OneDec())
+	c2 := cdp.NewCDP(uint64(2), addrs[1], sdk.NewCoin("xrp", sdk.NewInt(100000000)), "xrp-a", sdk.NewCoin("usdx", sdk.NewInt(10000000)), tmtime.Canonical(time.Now()), sdk.OneDec())
+	c3 := cdp.NewCDP(uint64(3), addrs[1], sdk.NewCoin("btc", sdk.NewInt(1000000000)), "btc-a", sdk.NewCoin("usdx", sdk.NewInt(10000000)), tmtime.Canonical(time.Now()), sdk.OneDec())
+	c4 := cdp.NewCDP(uint64(4), addrs[2], sdk.NewCoin("xrp", sdk.NewInt(1000000000)), "xrp-a", sdk.NewCoin("usdx", sdk.NewInt(500000000)), tmtime.Canonical(time.Now()), sdk.OneDec())
 	cdps = append(cdps, c1, c2, c3, c4)
 	return
 }
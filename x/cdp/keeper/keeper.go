@@ -8,6 +8,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/params/subspace"
 
+	"github.com/tendermint/tendermint/libs/log"
+
 	"github.com/kava-labs/kava/x/cdp/types"
 )
 
@@ -42,6 +44,11 @@ func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, paramstore subspace.Subspace,
 	}
 }
 
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
 // CdpDenomIndexIterator returns an sdk.Iterator for all cdps with matching collateral denom
 func (k Keeper) CdpDenomIndexIterator(ctx sdk.Context, collateralType string) sdk.Iterator {
 	store := prefix.NewStore(ctx.KVStore(k.key), types.CdpKeyPrefix)
@@ -94,8 +101,11 @@ func (k Keeper) IterateCdpsByCollateralType(ctx sdk.Context, collateralType stri
 
 // IterateCdpsByCollateralRatio iterate over cdps with collateral denom equal to denom and
 // collateral:debt ratio LESS THAN targetRatio and performs a callback function.
+// The index is keyed by the ratio of collateral to normalized debt, so the target ratio is
+// scaled by the interest factor of the collateral type to account for fees that have not been synchronized.
 func (k Keeper) IterateCdpsByCollateralRatio(ctx sdk.Context, collateralType string, targetRatio sdk.Dec, cb func(cdp types.CDP) (stop bool)) {
-	iterator := k.CdpCollateralRatioIndexIterator(ctx, collateralType, targetRatio)
+	normalizedRatio := targetRatio.Mul(k.GetInterestFactor(ctx, collateralType))
+	iterator := k.CdpCollateralRatioIndexIterator(ctx, collateralType, normalizedRatio)
 
 	defer iterator.Close()
 	for ; iterator.Valid(); iterator.Next() {
@@ -111,18 +121,18 @@ func (k Keeper) IterateCdpsByCollateralRatio(ctx sdk.Context, collateralType str
 	}
 }
 
-// SetSavingsRateDistributed sets the SavingsRateDistributed in the store
-func (k Keeper) SetSavingsRateDistributed(ctx sdk.Context, totalDistributed sdk.Int) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.SavingsRateDistributedKey)
+// SetSavingsRateDistributed sets the SavingsRateDistributed of the input debt denom in the store
+func (k Keeper) SetSavingsRateDistributed(ctx sdk.Context, denom string, totalDistributed sdk.Int) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.SavingsRateDistributedPrefix)
 	bz := k.cdc.MustMarshalBinaryLengthPrefixed(totalDistributed)
-	store.Set([]byte{}, bz)
+	store.Set([]byte(denom), bz)
 }
 
-// GetSavingsRateDistributed gets the SavingsRateDistributed from the store
-func (k Keeper) GetSavingsRateDistributed(ctx sdk.Context) sdk.Int {
+// GetSavingsRateDistributed gets the SavingsRateDistributed of the input debt denom from the store
+func (k Keeper) GetSavingsRateDistributed(ctx sdk.Context, denom string) sdk.Int {
 	savingsRateDistributed := sdk.ZeroInt()
-	store := prefix.NewStore(ctx.KVStore(k.key), types.SavingsRateDistributedKey)
-	bz := store.Get([]byte{})
+	store := prefix.NewStore(ctx.KVStore(k.key), types.SavingsRateDistributedPrefix)
+	bz := store.Get([]byte(denom))
 	if bz == nil {
 		return savingsRateDistributed
 	}
@@ -130,3 +140,12 @@ func (k Keeper) GetSavingsRateDistributed(ctx sdk.Context) sdk.Int {
 	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &savingsRateDistributed)
 	return savingsRateDistributed
 }
+
+// GetAllSavingsRateDistributed returns the savings rate distributed for each debt denom
+func (k Keeper) GetAllSavingsRateDistributed(ctx sdk.Context) sdk.Coins {
+	savingsRateDistributed := sdk.NewCoins()
+	for _, dp := range k.GetParams(ctx).DebtParams {
+		savingsRateDistributed = savingsRateDistributed.Add(sdk.NewCoin(dp.Denom, k.GetSavingsRateDistributed(ctx, dp.Denom)))
+	}
+	return savingsRateDistributed
+}
@@ -40,9 +40,13 @@ func (suite *KeeperTestSuite) TestGetSetSavingsRateDistributed() {
 
 	// Set savings rate distributed value
 	savingsRateDist := sdk.NewInt(555000555000)
-	suite.keeper.SetSavingsRateDistributed(suite.ctx, savingsRateDist)
+	suite.keeper.SetSavingsRateDistributed(suite.ctx, "usdx", savingsRateDist)
 
 	// Check store's savings rate distributed value
-	s := suite.keeper.GetSavingsRateDistributed(suite.ctx)
+	s := suite.keeper.GetSavingsRateDistributed(suite.ctx, "usdx")
 	suite.Equal(savingsRateDist, s)
+
+	// Savings rate distributed is tracked separately for each debt denom
+	suite.Equal(sdk.ZeroInt(), suite.keeper.GetSavingsRateDistributed(suite.ctx, "eurx"))
+	suite.Equal(cs(c("usdx", 555000555000)), suite.keeper.GetAllSavingsRateDistributed(suite.ctx))
 }
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -43,9 +44,11 @@ func (k Keeper) GetCollateralTypes(ctx sdk.Context) []string {
 
 // GetDebtParam returns the debt param with matching denom
 func (k Keeper) GetDebtParam(ctx sdk.Context, denom string) (types.DebtParam, bool) {
-	dp := k.GetParams(ctx).DebtParam
-	if dp.Denom == denom {
-		return dp, true
+	params := k.GetParams(ctx)
+	for _, dp := range params.DebtParams {
+		if dp.Denom == denom {
+			return dp, true
+		}
 	}
 	return types.DebtParam{}, false
 }
@@ -88,6 +91,14 @@ func (k Keeper) getliquidationMarketID(ctx sdk.Context, collateralType string) s
 	return cp.LiquidationMarketID
 }
 
+func (k Keeper) getLiquidationTWAPWindow(ctx sdk.Context, collateralType string) time.Duration {
+	cp, found := k.GetCollateral(ctx, collateralType)
+	if !found {
+		panic(fmt.Sprintf("collateral not found: %s", collateralType))
+	}
+	return cp.LiquidationTWAPWindow
+}
+
 func (k Keeper) getLiquidationRatio(ctx sdk.Context, collateralType string) sdk.Dec {
 	cp, found := k.GetCollateral(ctx, collateralType)
 	if !found {
@@ -104,6 +115,14 @@ func (k Keeper) getLiquidationPenalty(ctx sdk.Context, collateralType string) sd
 	return cp.LiquidationPenalty
 }
 
+func (k Keeper) getPrincipalDenom(ctx sdk.Context, collateralType string) string {
+	cp, found := k.GetCollateral(ctx, collateralType)
+	if !found {
+		panic(fmt.Sprintf("collateral not found: %s", collateralType))
+	}
+	return cp.DebtLimit.Denom
+}
+
 func (k Keeper) getAuctionSize(ctx sdk.Context, collateralType string) sdk.Int {
 	cp, found := k.GetCollateral(ctx, collateralType)
 	if !found {
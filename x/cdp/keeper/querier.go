@@ -36,6 +36,10 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			return queryGetSavingsRateDistributed(ctx, req, keeper)
 		case types.QueryGetPreviousSavingsDistributionTime:
 			return queryGetPreviousSavingsDistributionTime(ctx, req, keeper)
+		case types.QueryGetSavingsPosition:
+			return queryGetSavingsPosition(ctx, req, keeper)
+		case types.QueryGetCollateralTypeRisks:
+			return queryGetCollateralTypeRisks(ctx, req, keeper)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown %s query endpoint %s", types.ModuleName, path[0])
 		}
@@ -173,11 +177,13 @@ func queryGetAccounts(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]
 	cdpAccAccount := keeper.supplyKeeper.GetModuleAccount(ctx, types.ModuleName)
 	liquidatorAccAccount := keeper.supplyKeeper.GetModuleAccount(ctx, types.LiquidatorMacc)
 	savingsRateAccAccount := keeper.supplyKeeper.GetModuleAccount(ctx, types.SavingsRateMacc)
+	savingsVaultAccAccount := keeper.supplyKeeper.GetModuleAccount(ctx, types.SavingsVaultMacc)
 
 	accounts := []supply.ModuleAccount{
 		*cdpAccAccount.(*supply.ModuleAccount),
 		*liquidatorAccAccount.(*supply.ModuleAccount),
 		*savingsRateAccAccount.(*supply.ModuleAccount),
+		*savingsVaultAccAccount.(*supply.ModuleAccount),
 	}
 
 	// Encode results
@@ -190,8 +196,8 @@ func queryGetAccounts(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]
 
 // query get savings rate distributed in the cdp store
 func queryGetSavingsRateDistributed(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
-	// Get savings rate distributed
-	savingsRateDist := keeper.GetSavingsRateDistributed(ctx)
+	// Get savings rate distributed for each debt asset
+	savingsRateDist := keeper.GetAllSavingsRateDistributed(ctx)
 
 	// Encode results
 	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, savingsRateDist)
@@ -218,6 +224,53 @@ func queryGetPreviousSavingsDistributionTime(ctx sdk.Context, req abci.RequestQu
 	return bz, nil
 }
 
+// query the savings deposit of a depositor and the amount it can currently be withdrawn for
+func queryGetSavingsPosition(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	var requestParams types.QuerySavingsPositionParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &requestParams)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	deposit, found := keeper.GetSavingsDeposit(ctx, requestParams.Denom, requestParams.Depositor)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrSavingsDepositNotFound, "depositor %s, denom %s", requestParams.Depositor, requestParams.Denom)
+	}
+	position := types.NewSavingsPosition(deposit.Depositor, deposit.Shares, keeper.GetSavingsDepositValue(ctx, deposit))
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, position)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
+// query the debt limit utilisation and collateralization of one or every collateral type
+func queryGetCollateralTypeRisks(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	var requestParams types.QueryCollateralTypeRisksParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &requestParams)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	var risks types.CollateralTypeRisks
+	if requestParams.CollateralType == "" {
+		risks = keeper.GetAllCollateralTypeRisks(ctx)
+	} else {
+		risk, err := keeper.GetCollateralTypeRisk(ctx, requestParams.CollateralType)
+		if err != nil {
+			return nil, err
+		}
+		risks = types.CollateralTypeRisks{risk}
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, risks)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
 // query cdps in store and filter by request params
 func queryGetCdps(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
 	var params types.QueryCdpsParams
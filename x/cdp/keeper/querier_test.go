@@ -287,7 +287,7 @@ func (suite *QuerierTestSuite) TestQueryAccounts() {
 
 	var accounts []supply.ModuleAccount
 	suite.Require().Nil(supply.ModuleCdc.UnmarshalJSON(bz, &accounts))
-	suite.Require().Equal(3, len(accounts))
+	suite.Require().Equal(4, len(accounts))
 
 	findByName := func(name string) bool {
 		for _, account := range accounts {
@@ -301,6 +301,7 @@ func (suite *QuerierTestSuite) TestQueryAccounts() {
 	suite.Require().True(findByName("cdp"))
 	suite.Require().True(findByName("liquidator"))
 	suite.Require().True(findByName("savings"))
+	suite.Require().True(findByName("savings_vault"))
 }
 
 func (suite *QuerierTestSuite) TestQuerySavingsRateDistributed() {
@@ -309,9 +310,61 @@ func (suite *QuerierTestSuite) TestQuerySavingsRateDistributed() {
 	suite.Nil(err)
 	suite.NotNil(bz)
 
-	var distAmount sdk.Int
+	var distAmount sdk.Coins
 	suite.Nil(types.ModuleCdc.UnmarshalJSON(bz, &distAmount))
-	suite.True(sdk.ZeroInt().Equal(distAmount))
+	suite.True(distAmount.IsZero())
+}
+
+func (suite *QuerierTestSuite) TestQuerySavingsPosition() {
+	ctx := suite.ctx.WithIsCheckTx(false)
+	depositor := suite.cdps[0].Owner
+	suite.Require().NoError(suite.keeper.DepositSavings(ctx, depositor, c("usdx", 1000)))
+
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryGetSavingsPosition}, "/"),
+		Data: types.ModuleCdc.MustMarshalJSON(types.NewQuerySavingsPositionParams(depositor, "usdx")),
+	}
+	bz, err := suite.querier(ctx, []string{types.QueryGetSavingsPosition}, query)
+	suite.Require().NoError(err)
+
+	var position types.SavingsPosition
+	suite.Require().NoError(types.ModuleCdc.UnmarshalJSON(bz, &position))
+	suite.Equal(types.NewSavingsPosition(depositor, sdk.NewInt(1000000000), c("usdx", 1000)), position)
+
+	query.Data = types.ModuleCdc.MustMarshalJSON(types.NewQuerySavingsPositionParams(suite.addrs[99], "usdx"))
+	_, err = suite.querier(ctx, []string{types.QueryGetSavingsPosition}, query)
+	suite.Error(err)
+}
+
+func (suite *QuerierTestSuite) TestQueryCollateralTypeRisks() {
+	ctx := suite.ctx.WithIsCheckTx(false)
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryGetCollateralTypeRisks}, "/"),
+		Data: types.ModuleCdc.MustMarshalJSON(types.NewQueryCollateralTypeRisksParams("")),
+	}
+	bz, err := suite.querier(ctx, []string{types.QueryGetCollateralTypeRisks}, query)
+	suite.Require().NoError(err)
+
+	var risks types.CollateralTypeRisks
+	suite.Require().NoError(types.ModuleCdc.UnmarshalJSON(bz, &risks))
+	suite.Require().Len(risks, len(suite.keeper.GetParams(ctx).CollateralParams))
+	totalCdps := uint64(0)
+	for _, risk := range risks {
+		totalCdps += risk.CdpCount
+	}
+	suite.Equal(uint64(len(suite.cdps)), totalCdps)
+
+	query.Data = types.ModuleCdc.MustMarshalJSON(types.NewQueryCollateralTypeRisksParams(suite.cdps[0].Type))
+	bz, err = suite.querier(ctx, []string{types.QueryGetCollateralTypeRisks}, query)
+	suite.Require().NoError(err)
+	suite.Require().NoError(types.ModuleCdc.UnmarshalJSON(bz, &risks))
+	suite.Require().Len(risks, 1)
+	suite.Equal(suite.cdps[0].Type, risks[0].CollateralType)
+	suite.Equal(suite.keeper.GetTotalPrincipal(ctx, suite.cdps[0].Type, "usdx"), risks[0].Principal.Amount.Add(risks[0].AccumulatedFees.Amount))
+
+	query.Data = types.ModuleCdc.MustMarshalJSON(types.NewQueryCollateralTypeRisksParams("lol-a"))
+	_, err = suite.querier(ctx, []string{types.QueryGetCollateralTypeRisks}, query)
+	suite.Error(err)
 }
 
 func (suite *QuerierTestSuite) TestFindIntersection() {
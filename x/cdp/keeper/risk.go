@@ -0,0 +1,112 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/kava-labs/kava/x/cdp/types"
+)
+
+// GetCollateralTypeRisk returns the debt limit utilisation and collateralization of the cdps of a collateral type.
+// Debt is read from the total principal of the collateral type, and collateral from the running totals kept as cdps are updated,
+// so no cdps are iterated over. Collateral values are left at zero if the price of the collateral type is not available.
+func (k Keeper) GetCollateralTypeRisk(ctx sdk.Context, collateralType string) (types.CollateralTypeRisk, error) {
+	cp, found := k.GetCollateral(ctx, collateralType)
+	if !found {
+		return types.CollateralTypeRisk{}, sdkerrors.Wrap(types.ErrCollateralNotSupported, collateralType)
+	}
+	debtDenom := cp.DebtLimit.Denom
+
+	totals := k.GetCdpTotals(ctx, collateralType)
+	collateral := sdk.NewCoin(cp.Denom, totals.Collateral)
+	principal := sdk.NewCoin(debtDenom, totals.Principal)
+
+	// total principal includes the fees of every cdp of the collateral type, whether or not they have been synchronized
+	totalPrincipal := k.GetTotalPrincipal(ctx, collateralType, debtDenom)
+	fees := sdk.NewCoin(debtDenom, sdk.MaxInt(totalPrincipal.Sub(principal.Amount), sdk.ZeroInt()))
+
+	// mirror ValidateDebtLimit, which checks the collateral type's total principal against its debt limit,
+	// and the total principal of the debt denom across all collateral types against the global debt limit
+	globalHeadroom := k.GetParams(ctx).GlobalDebtLimit.AmountOf(debtDenom).Sub(k.getDenomTotalPrincipal(ctx, debtDenom))
+	headroomAmount := sdk.MinInt(cp.DebtLimit.Amount.Sub(totalPrincipal), globalHeadroom)
+	headroom := sdk.NewCoin(debtDenom, sdk.MaxInt(headroomAmount, sdk.ZeroInt()))
+
+	spotValue := sdk.NewCoin(debtDenom, sdk.ZeroInt())
+	price, err := k.getPrice(ctx, collateralType, spot)
+	if err == nil {
+		spotValue = k.convertCollateralToDebt(ctx, collateral, collateralType, debtDenom, price)
+	}
+	liquidationValue := sdk.NewCoin(debtDenom, sdk.ZeroInt())
+	collateralizationRatio := sdk.ZeroDec()
+	price, err = k.getPrice(ctx, collateralType, liquidation)
+	if err == nil {
+		liquidationValue = k.convertCollateralToDebt(ctx, collateral, collateralType, debtDenom, price)
+		if totalPrincipal.IsPositive() {
+			collateralizationRatio = sdk.NewDecFromInt(liquidationValue.Amount).QuoInt(totalPrincipal)
+		}
+	}
+
+	return types.NewCollateralTypeRisk(
+		collateralType, principal, fees, cp.DebtLimit, headroom, collateral,
+		spotValue, liquidationValue, collateralizationRatio, totals.CdpCount,
+	), nil
+}
+
+// GetAllCollateralTypeRisks returns the debt limit utilisation and collateralization of every collateral type.
+// There is no system-wide figure, as collateral types can back different debt denoms.
+func (k Keeper) GetAllCollateralTypeRisks(ctx sdk.Context) types.CollateralTypeRisks {
+	var risks types.CollateralTypeRisks
+	for _, cp := range k.GetParams(ctx).CollateralParams {
+		risk, err := k.GetCollateralTypeRisk(ctx, cp.Type)
+		if err != nil {
+			panic(err)
+		}
+		risks = append(risks, risk)
+	}
+	return risks
+}
+
+// GetCdpTotals returns the running totals of the cdps of a collateral type
+func (k Keeper) GetCdpTotals(ctx sdk.Context, collateralType string) types.CdpTotals {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.CdpTotalsPrefix)
+	bz := store.Get([]byte(collateralType))
+	if bz == nil {
+		return types.NewCdpTotals(sdk.ZeroInt(), sdk.ZeroInt(), 0)
+	}
+	var totals types.CdpTotals
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &totals)
+	return totals
+}
+
+// SetCdpTotals sets the running totals of the cdps of a collateral type
+func (k Keeper) SetCdpTotals(ctx sdk.Context, collateralType string, totals types.CdpTotals) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.CdpTotalsPrefix)
+	store.Set([]byte(collateralType), k.cdc.MustMarshalBinaryLengthPrefixed(totals))
+}
+
+// addToCdpTotals adds a cdp that is being stored to the running totals of its collateral type
+func (k Keeper) addToCdpTotals(ctx sdk.Context, cdp types.CDP) {
+	totals := k.GetCdpTotals(ctx, cdp.Type)
+	totals.Collateral = totals.Collateral.Add(cdp.Collateral.Amount)
+	totals.Principal = totals.Principal.Add(cdp.Principal.Amount)
+	totals.CdpCount++
+	k.SetCdpTotals(ctx, cdp.Type, totals)
+}
+
+// removeFromCdpTotals removes a stored cdp that is being replaced or deleted from the running totals of its collateral type
+func (k Keeper) removeFromCdpTotals(ctx sdk.Context, cdp types.CDP) {
+	totals := k.GetCdpTotals(ctx, cdp.Type)
+	totals.Collateral = totals.Collateral.Sub(cdp.Collateral.Amount)
+	totals.Principal = totals.Principal.Sub(cdp.Principal.Amount)
+	totals.CdpCount--
+	k.SetCdpTotals(ctx, cdp.Type, totals)
+}
+
+// convertCollateralToDebt returns the value of the input collateral in debt coins at the input price
+func (k Keeper) convertCollateralToDebt(ctx sdk.Context, collateral sdk.Coin, collateralType, debtDenom string, price sdk.Dec) sdk.Coin {
+	dp, _ := k.GetDebtParam(ctx, debtDenom)
+	valueBaseUnits := k.convertCollateralToBaseUnits(ctx, collateral, collateralType).Mul(price)
+	value := valueBaseUnits.MulInt(sdk.NewIntWithDecimal(1, int(dp.ConversionFactor.Int64())))
+	return sdk.NewCoin(debtDenom, value.TruncateInt())
+}
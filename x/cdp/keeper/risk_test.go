@@ -0,0 +1,123 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmtime "github.com/tendermint/tendermint/types/time"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/cdp/keeper"
+	"github.com/kava-labs/kava/x/cdp/types"
+)
+
+type RiskTestSuite struct {
+	suite.Suite
+
+	keeper keeper.Keeper
+	app    app.TestApp
+	ctx    sdk.Context
+	addrs  []sdk.AccAddress
+}
+
+func (suite *RiskTestSuite) SetupTest() {
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, abci.Header{Height: 1, Time: tmtime.Now()})
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+	authGS := app.NewAuthGenState(
+		addrs,
+		[]sdk.Coins{
+			cs(c("xrp", 500000000)),
+			cs(c("xrp", 500000000)),
+		})
+	tApp.InitializeFromGenesisStates(
+		authGS,
+		NewPricefeedGenStateMulti(),
+		NewCDPGenStateMulti(),
+	)
+	suite.app = tApp
+	suite.keeper = tApp.GetCDPKeeper()
+	suite.ctx = ctx
+	suite.addrs = addrs
+
+	suite.Require().NoError(suite.keeper.AddCdp(suite.ctx, addrs[0], c("xrp", 400000000), c("usdx", 10000000), "xrp-a"))
+	suite.Require().NoError(suite.keeper.AddCdp(suite.ctx, addrs[1], c("xrp", 200000000), c("usdx", 10000000), "xrp-a"))
+}
+
+func (suite *RiskTestSuite) TestGetCollateralTypeRisk() {
+	risk, err := suite.keeper.GetCollateralTypeRisk(suite.ctx, "xrp-a")
+	suite.Require().NoError(err)
+
+	// 600 xrp at $0.25 backs 20 usdx of debt
+	expectedRisk := types.NewCollateralTypeRisk(
+		"xrp-a",
+		c("usdx", 20000000),
+		c("usdx", 0),
+		c("usdx", 500000000000),
+		c("usdx", 500000000000-20000000),
+		c("xrp", 600000000),
+		c("usdx", 150000000),
+		c("usdx", 150000000),
+		sdk.MustNewDecFromStr("7.5"),
+		2,
+	)
+	suite.Equal(expectedRisk, risk)
+
+	risk, err = suite.keeper.GetCollateralTypeRisk(suite.ctx, "btc-a")
+	suite.Require().NoError(err)
+	suite.Equal(uint64(0), risk.CdpCount)
+	suite.Equal(c("usdx", 500000000000), risk.DebtHeadroom)
+	suite.Equal(sdk.ZeroDec(), risk.CollateralizationRatio)
+
+	_, err = suite.keeper.GetCollateralTypeRisk(suite.ctx, "lol-a")
+	suite.Require().True(errors.Is(err, types.ErrCollateralNotSupported))
+}
+
+func (suite *RiskTestSuite) TestGetCollateralTypeRiskFees() {
+	suite.Require().NoError(suite.keeper.AccumulateInterest(suite.ctx, "xrp-a"))
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Hour * 24 * 365))
+	suite.Require().NoError(suite.keeper.AccumulateInterest(suite.ctx, "xrp-a"))
+
+	// fees that have not been synchronized to the cdps are included, and reduce the headroom
+	risk, err := suite.keeper.GetCollateralTypeRisk(suite.ctx, "xrp-a")
+	suite.Require().NoError(err)
+	suite.Equal(c("usdx", 20000000), risk.Principal)
+	suite.True(risk.AccumulatedFees.IsPositive())
+	totalPrincipal := suite.keeper.GetTotalPrincipal(suite.ctx, "xrp-a", "usdx")
+	suite.Equal(risk.Principal.Amount.Add(risk.AccumulatedFees.Amount), totalPrincipal)
+	suite.Equal(sdk.NewInt(500000000000).Sub(totalPrincipal), risk.DebtHeadroom.Amount)
+	suite.True(risk.CollateralizationRatio.LT(sdk.MustNewDecFromStr("7.5")))
+}
+
+func (suite *RiskTestSuite) TestGetAllCollateralTypeRisks() {
+	risks := suite.keeper.GetAllCollateralTypeRisks(suite.ctx)
+	suite.Require().Len(risks, len(suite.keeper.GetParams(suite.ctx).CollateralParams))
+	for _, risk := range risks {
+		if risk.CollateralType == "xrp-a" {
+			suite.Equal(uint64(2), risk.CdpCount)
+		} else {
+			suite.Equal(uint64(0), risk.CdpCount)
+		}
+	}
+}
+
+func (suite *RiskTestSuite) TestCdpTotals() {
+	suite.Require().NoError(suite.keeper.DepositCollateral(suite.ctx, suite.addrs[0], suite.addrs[0], c("xrp", 50000000), "xrp-a"))
+	suite.Require().NoError(suite.keeper.WithdrawCollateral(suite.ctx, suite.addrs[1], suite.addrs[1], c("xrp", 10000000), "xrp-a"))
+	suite.Equal(types.NewCdpTotals(sdk.NewInt(640000000), sdk.NewInt(20000000), 2), suite.keeper.GetCdpTotals(suite.ctx, "xrp-a"))
+
+	// repaying a cdp in full closes it and removes it from the totals
+	suite.Require().NoError(suite.keeper.RepayPrincipal(suite.ctx, suite.addrs[1], "xrp-a", c("usdx", 10000000)))
+	suite.Equal(types.NewCdpTotals(sdk.NewInt(450000000), sdk.NewInt(10000000), 1), suite.keeper.GetCdpTotals(suite.ctx, "xrp-a"))
+	suite.Equal(types.NewCdpTotals(sdk.ZeroInt(), sdk.ZeroInt(), 0), suite.keeper.GetCdpTotals(suite.ctx, "btc-a"))
+}
+
+func TestRiskTestSuite(t *testing.T) {
+	suite.Run(t, new(RiskTestSuite))
+}
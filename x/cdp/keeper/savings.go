@@ -6,13 +6,12 @@ import (
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
-	authexported "github.com/cosmos/cosmos-sdk/x/auth/exported"
-	supplyexported "github.com/cosmos/cosmos-sdk/x/supply/exported"
 
 	"github.com/kava-labs/kava/x/cdp/types"
 )
 
-// DistributeSavingsRate distributes surplus that has accumulated in the liquidator account to address holding stable coins according the the savings rate
+// DistributeSavingsRate distributes surplus that has accumulated in the savings rate module account to the savings vault.
+// Moving the surplus into the vault raises the value of every savings share, so holders are paid without iterating over them.
 func (k Keeper) DistributeSavingsRate(ctx sdk.Context, debtDenom string) error {
 	dp, found := k.GetDebtParam(ctx, debtDenom)
 	if !found {
@@ -25,55 +24,193 @@ func (k Keeper) DistributeSavingsRate(ctx sdk.Context, debtDenom string) error {
 		return nil
 	}
 
-	modAccountCoins := k.getModuleAccountCoins(ctx, dp.Denom)
-	totalSupplyLessModAccounts := k.supplyKeeper.GetSupply(ctx).GetTotal().Sub(modAccountCoins)
+	// keep the surplus in the savings rate account until there are shares to distribute it to
+	if !k.GetTotalSavingsShares(ctx, dp.Denom).IsPositive() {
+		return nil
+	}
 
-	// values to use in interest calculation
-	totalSurplus := sdk.NewDecFromInt(surplusToDistribute)
-	totalSupply := sdk.NewDecFromInt(totalSupplyLessModAccounts.AmountOf(debtDenom))
+	surplusCoins := sdk.NewCoins(sdk.NewCoin(dp.Denom, surplusToDistribute))
+	err := k.supplyKeeper.SendCoinsFromModuleToModule(ctx, types.SavingsRateMacc, types.SavingsVaultMacc, surplusCoins)
+	if err != nil {
+		return err
+	}
 
-	var iterationErr error
-	// TODO: avoid iterating over all the accounts by keeping the stored stable coin
-	// holders' addresses separately.
-	k.accountKeeper.IterateAccounts(ctx, func(acc authexported.Account) (stop bool) {
-		_, ok := acc.(supplyexported.ModuleAccountI)
-		if ok {
-			// don't distribute savings rate to module accounts
-			return false
-		}
+	previousSavingsDistributed := k.GetSavingsRateDistributed(ctx, dp.Denom)
+	k.SetSavingsRateDistributed(ctx, dp.Denom, previousSavingsDistributed.Add(surplusToDistribute))
+	return nil
+}
 
-		debtAmount := acc.GetCoins().AmountOf(debtDenom)
-		if !debtAmount.IsPositive() {
-			return false
-		}
+// DepositSavings sends coins from the depositor to the savings vault in exchange for shares of the vault
+func (k Keeper) DepositSavings(ctx sdk.Context, depositor sdk.AccAddress, amount sdk.Coin) error {
+	_, found := k.GetDebtParam(ctx, amount.Denom)
+	if !found {
+		return sdkerrors.Wrap(types.ErrDebtNotSupported, amount.Denom)
+	}
 
-		// (balance * rewardToDisribute) /  totalSupply
-		// interest is the ratable fraction of savings rate owed to that account, rounded using bankers rounding
-		interest := (sdk.NewDecFromInt(debtAmount).Mul(totalSurplus)).Quo(totalSupply).RoundInt()
-		// sanity check, if we are going to over-distribute due to rounding, distribute only the remaining savings rate that hasn't been distributed.
-		interest = sdk.MinInt(interest, surplusToDistribute)
+	// shares are priced before the deposit is added to the vault
+	shares := k.calculateSavingsShares(ctx, amount)
+	if !shares.IsPositive() {
+		return sdkerrors.Wrapf(types.ErrInvalidSavingsDeposit, "%s is worth less than one share", amount)
+	}
 
-		// sanity check - don't send saving rate if the rounded amount is zero
-		if !interest.IsPositive() {
-			return false
-		}
+	err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, depositor, types.SavingsVaultMacc, sdk.NewCoins(amount))
+	if err != nil {
+		return err
+	}
+
+	deposit, found := k.GetSavingsDeposit(ctx, amount.Denom, depositor)
+	if !found {
+		deposit = types.NewSavingsDeposit(depositor, amount.Denom, sdk.ZeroInt())
+	}
+	deposit.Shares = deposit.Shares.Add(shares)
+	k.SetSavingsDeposit(ctx, deposit)
+	k.SetTotalSavingsShares(ctx, amount.Denom, k.GetTotalSavingsShares(ctx, amount.Denom).Add(shares))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSavingsDeposit,
+			sdk.NewAttribute(types.AttributeKeyDepositor, depositor.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyShares, shares.String()),
+		),
+	)
+	return nil
+}
+
+// WithdrawSavings redeems the depositor's shares that are worth the input amount and sends the amount from the savings vault to the depositor
+func (k Keeper) WithdrawSavings(ctx sdk.Context, depositor sdk.AccAddress, amount sdk.Coin) error {
+	deposit, found := k.GetSavingsDeposit(ctx, amount.Denom, depositor)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrSavingsDepositNotFound, "depositor %s, denom %s", depositor, amount.Denom)
+	}
+
+	// round the shares up so that withdrawals never take more than their shares are worth
+	totalShares := k.GetTotalSavingsShares(ctx, amount.Denom)
+	vaultBalance := k.GetSavingsVaultBalance(ctx, amount.Denom)
+	if !vaultBalance.IsPositive() {
+		return sdkerrors.Wrapf(types.ErrInsufficientSavings, "%s > %s", amount, k.GetSavingsDepositValue(ctx, deposit))
+	}
+	shares := amount.Amount.Mul(totalShares).Add(vaultBalance).Sub(sdk.OneInt()).Quo(vaultBalance)
+	if shares.GT(deposit.Shares) {
+		return sdkerrors.Wrapf(types.ErrInsufficientSavings, "%s > %s", amount, k.GetSavingsDepositValue(ctx, deposit))
+	}
+
+	err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.SavingsVaultMacc, depositor, sdk.NewCoins(amount))
+	if err != nil {
+		return err
+	}
+
+	deposit.Shares = deposit.Shares.Sub(shares)
+	if deposit.Shares.IsZero() {
+		k.DeleteSavingsDeposit(ctx, amount.Denom, depositor)
+	} else {
+		k.SetSavingsDeposit(ctx, deposit)
+	}
+	k.SetTotalSavingsShares(ctx, amount.Denom, totalShares.Sub(shares))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSavingsWithdrawal,
+			sdk.NewAttribute(types.AttributeKeyDepositor, depositor.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyShares, shares.String()),
+		),
+	)
+	return nil
+}
+
+// calculateSavingsShares returns the number of shares a deposit of the input amount is worth, rounded down
+func (k Keeper) calculateSavingsShares(ctx sdk.Context, amount sdk.Coin) sdk.Int {
+	totalShares := k.GetTotalSavingsShares(ctx, amount.Denom)
+	vaultBalance := k.GetSavingsVaultBalance(ctx, amount.Denom)
+	if totalShares.IsZero() || vaultBalance.IsZero() {
+		return amount.Amount.Mul(types.InitialSavingsSharesPerCoin)
+	}
+	return amount.Amount.Mul(totalShares).Quo(vaultBalance)
+}
+
+// GetSavingsDepositValue returns the amount a savings deposit can currently be withdrawn for
+func (k Keeper) GetSavingsDepositValue(ctx sdk.Context, deposit types.SavingsDeposit) sdk.Coin {
+	totalShares := k.GetTotalSavingsShares(ctx, deposit.Denom)
+	if totalShares.IsZero() {
+		return sdk.NewCoin(deposit.Denom, sdk.ZeroInt())
+	}
+	vaultBalance := k.GetSavingsVaultBalance(ctx, deposit.Denom)
+	return sdk.NewCoin(deposit.Denom, deposit.Shares.Mul(vaultBalance).Quo(totalShares))
+}
+
+// GetSavingsVaultBalance returns the amount of the input denom held by the savings vault
+func (k Keeper) GetSavingsVaultBalance(ctx sdk.Context, denom string) sdk.Int {
+	return k.supplyKeeper.GetModuleAccount(ctx, types.SavingsVaultMacc).GetCoins().AmountOf(denom)
+}
+
+// GetSavingsDeposit returns the savings deposit of a depositor for the input denom
+func (k Keeper) GetSavingsDeposit(ctx sdk.Context, denom string, depositor sdk.AccAddress) (types.SavingsDeposit, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.SavingsDepositPrefix)
+	bz := store.Get(types.SavingsDepositKey(denom, depositor))
+	if bz == nil {
+		return types.SavingsDeposit{}, false
+	}
+	var deposit types.SavingsDeposit
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &deposit)
+	return deposit, true
+}
+
+// SetSavingsDeposit sets a savings deposit in the store
+func (k Keeper) SetSavingsDeposit(ctx sdk.Context, deposit types.SavingsDeposit) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.SavingsDepositPrefix)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(deposit)
+	store.Set(types.SavingsDepositKey(deposit.Denom, deposit.Depositor), bz)
+}
 
-		// update total savings rate distributed by surplus to distribute
-		previousSavingsDistributed := k.GetSavingsRateDistributed(ctx)
-		newTotalDistributed := previousSavingsDistributed.Add(interest)
-		k.SetSavingsRateDistributed(ctx, newTotalDistributed)
+// DeleteSavingsDeposit deletes a savings deposit from the store
+func (k Keeper) DeleteSavingsDeposit(ctx sdk.Context, denom string, depositor sdk.AccAddress) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.SavingsDepositPrefix)
+	store.Delete(types.SavingsDepositKey(denom, depositor))
+}
 
-		interestCoins := sdk.NewCoins(sdk.NewCoin(debtDenom, interest))
-		err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.SavingsRateMacc, acc.GetAddress(), interestCoins)
-		if err != nil {
-			iterationErr = err
-			return true
+// IterateSavingsDeposits iterates over the savings deposits of a denom and performs a callback function
+func (k Keeper) IterateSavingsDeposits(ctx sdk.Context, denom string, cb func(deposit types.SavingsDeposit) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.SavingsDepositPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, types.SavingsDepositIterKey(denom))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var deposit types.SavingsDeposit
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &deposit)
+		if cb(deposit) {
+			break
 		}
-		surplusToDistribute = surplusToDistribute.Sub(interest)
-		return false
-	})
+	}
+}
+
+// GetAllSavingsDeposits returns the savings deposits of every debt denom
+func (k Keeper) GetAllSavingsDeposits(ctx sdk.Context) types.SavingsDeposits {
+	var deposits types.SavingsDeposits
+	for _, dp := range k.GetParams(ctx).DebtParams {
+		k.IterateSavingsDeposits(ctx, dp.Denom, func(deposit types.SavingsDeposit) bool {
+			deposits = append(deposits, deposit)
+			return false
+		})
+	}
+	return deposits
+}
 
-	return iterationErr
+// GetTotalSavingsShares returns the total number of savings shares issued for a denom
+func (k Keeper) GetTotalSavingsShares(ctx sdk.Context, denom string) sdk.Int {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.SavingsSharesPrefix)
+	bz := store.Get([]byte(denom))
+	if bz == nil {
+		return sdk.ZeroInt()
+	}
+	var totalShares sdk.Int
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &totalShares)
+	return totalShares
+}
+
+// SetTotalSavingsShares sets the total number of savings shares issued for a denom
+func (k Keeper) SetTotalSavingsShares(ctx sdk.Context, denom string, totalShares sdk.Int) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.SavingsSharesPrefix)
+	store.Set([]byte(denom), k.cdc.MustMarshalBinaryLengthPrefixed(totalShares))
 }
 
 // GetPreviousSavingsDistribution get the time of the previous savings rate distribution
@@ -92,15 +229,3 @@ func (k Keeper) SetPreviousSavingsDistribution(ctx sdk.Context, distTime time.Ti
 	store := prefix.NewStore(ctx.KVStore(k.key), types.PreviousDistributionTimeKey)
 	store.Set([]byte{}, k.cdc.MustMarshalBinaryLengthPrefixed(distTime))
 }
-
-// getModuleAccountCoins gets the total coin balance of this coin currently held by module accounts
-func (k Keeper) getModuleAccountCoins(ctx sdk.Context, denom string) sdk.Coins {
-	totalModCoinBalance := sdk.NewCoins(sdk.NewCoin(denom, sdk.ZeroInt()))
-	for macc := range k.maccPerms {
-		modCoinBalance := k.supplyKeeper.GetModuleAccount(ctx, macc).GetCoins().AmountOf(denom)
-		if modCoinBalance.IsPositive() {
-			totalModCoinBalance = totalModCoinBalance.Add(sdk.NewCoin(denom, modCoinBalance))
-		}
-	}
-	return totalModCoinBalance
-}
@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"errors"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
@@ -58,28 +60,170 @@ func (suite *SavingsTestSuite) SetupTest() {
 }
 
 func (suite *SavingsTestSuite) TestApplySavingsRate() {
-	preSavingsRateDistAmount := suite.keeper.GetSavingsRateDistributed(suite.ctx)
+	preSavingsRateDistAmount := suite.keeper.GetSavingsRateDistributed(suite.ctx, "usdx")
+
+	// only holders who have deposited into the savings vault receive the savings rate
+	suite.Require().NoError(suite.keeper.DepositSavings(suite.ctx, suite.addrs[0], c("usdx", 100000)))
+	suite.Require().NoError(suite.keeper.DepositSavings(suite.ctx, suite.addrs[1], c("usdx", 50000)))
 
 	err := suite.keeper.DistributeSavingsRate(suite.ctx, "usdx")
 	suite.NoError(err)
 
 	ak := suite.app.GetAccountKeeper()
-	acc0 := ak.GetAccount(suite.ctx, suite.addrs[0])
-	suite.Equal(cs(c("usdx", 105000)), acc0.GetCoins())
-	acc1 := ak.GetAccount(suite.ctx, suite.addrs[1])
-	suite.Equal(cs(c("usdx", 52500)), acc1.GetCoins())
 	acc2 := ak.GetAccount(suite.ctx, suite.addrs[2])
-	suite.Equal(cs(c("usdx", 52500)), acc2.GetCoins())
+	suite.Equal(cs(c("usdx", 50000)), acc2.GetCoins())
+
+	deposit0, found := suite.keeper.GetSavingsDeposit(suite.ctx, "usdx", suite.addrs[0])
+	suite.Require().True(found)
+	suite.Equal(c("usdx", 106666), suite.keeper.GetSavingsDepositValue(suite.ctx, deposit0))
+	deposit1, found := suite.keeper.GetSavingsDeposit(suite.ctx, "usdx", suite.addrs[1])
+	suite.Require().True(found)
+	suite.Equal(c("usdx", 53333), suite.keeper.GetSavingsDepositValue(suite.ctx, deposit1))
 
 	sk := suite.app.GetSupplyKeeper()
 	macc := sk.GetModuleAccount(suite.ctx, types.SavingsRateMacc)
 	suite.True(macc.GetCoins().AmountOf("usdx").IsZero())
+	suite.Equal(sdk.NewInt(160000), suite.keeper.GetSavingsVaultBalance(suite.ctx, "usdx"))
 
 	expectedPostSavingsRateDistAmount := preSavingsRateDistAmount.Add(sdk.NewInt(suite.amountToDistribute))
-	postSavingsRateDistAmount := suite.keeper.GetSavingsRateDistributed(suite.ctx)
+	postSavingsRateDistAmount := suite.keeper.GetSavingsRateDistributed(suite.ctx, "usdx")
 	suite.True(expectedPostSavingsRateDistAmount.Equal(postSavingsRateDistAmount))
 }
 
+func (suite *SavingsTestSuite) TestApplySavingsRateNoDeposits() {
+	err := suite.keeper.DistributeSavingsRate(suite.ctx, "usdx")
+	suite.NoError(err)
+
+	// the surplus is kept until there are savings shares to distribute it to
+	sk := suite.app.GetSupplyKeeper()
+	macc := sk.GetModuleAccount(suite.ctx, types.SavingsRateMacc)
+	suite.Equal(sdk.NewInt(suite.amountToDistribute), macc.GetCoins().AmountOf("usdx"))
+	suite.True(suite.keeper.GetSavingsRateDistributed(suite.ctx, "usdx").IsZero())
+}
+
+func (suite *SavingsTestSuite) TestDepositSavings() {
+	type args struct {
+		depositor sdk.AccAddress
+		amount    sdk.Coin
+	}
+	type errArgs struct {
+		expectPass bool
+		contains   string
+	}
+	testCases := []struct {
+		name    string
+		args    args
+		errArgs errArgs
+	}{
+		{
+			name: "valid",
+			args: args{
+				depositor: suite.addrs[0],
+				amount:    c("usdx", 1000),
+			},
+			errArgs: errArgs{
+				expectPass: true,
+			},
+		},
+		{
+			name: "not a debt denom",
+			args: args{
+				depositor: suite.addrs[0],
+				amount:    c("xrp", 1000),
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "debt not supported",
+			},
+		},
+		{
+			name: "insufficient funds",
+			args: args{
+				depositor: suite.addrs[1],
+				amount:    c("usdx", 50001),
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "insufficient funds",
+			},
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			err := suite.keeper.DepositSavings(suite.ctx, tc.args.depositor, tc.args.amount)
+			if tc.errArgs.expectPass {
+				suite.Require().NoError(err)
+				deposit, found := suite.keeper.GetSavingsDeposit(suite.ctx, tc.args.amount.Denom, tc.args.depositor)
+				suite.Require().True(found)
+				suite.Equal(tc.args.amount.Amount.Mul(types.InitialSavingsSharesPerCoin), deposit.Shares)
+				suite.Equal(tc.args.amount.Amount.Mul(types.InitialSavingsSharesPerCoin), suite.keeper.GetTotalSavingsShares(suite.ctx, tc.args.amount.Denom))
+			} else {
+				suite.Require().Error(err)
+				suite.Require().True(strings.Contains(err.Error(), tc.errArgs.contains))
+			}
+		})
+	}
+}
+
+func (suite *SavingsTestSuite) TestDepositSavingsSharePrice() {
+	suite.Require().NoError(suite.keeper.DepositSavings(suite.ctx, suite.addrs[0], c("usdx", 10000)))
+	suite.Require().NoError(suite.keeper.DistributeSavingsRate(suite.ctx, "usdx"))
+
+	// each share is now worth two usdx, so later deposits receive fewer shares
+	suite.Require().NoError(suite.keeper.DepositSavings(suite.ctx, suite.addrs[1], c("usdx", 5000)))
+	deposit, found := suite.keeper.GetSavingsDeposit(suite.ctx, "usdx", suite.addrs[1])
+	suite.Require().True(found)
+	suite.Equal(sdk.NewInt(2500000000), deposit.Shares)
+	suite.Equal(sdk.NewInt(12500000000), suite.keeper.GetTotalSavingsShares(suite.ctx, "usdx"))
+
+	// deposits worth less than one share are rejected
+	err := suite.keeper.DepositSavings(suite.ctx, suite.addrs[2], c("usdx", 0))
+	suite.Require().True(errors.Is(err, types.ErrInvalidSavingsDeposit))
+}
+
+func (suite *SavingsTestSuite) TestDepositSavingsShareInflation() {
+	// an attacker makes the first deposit of a single coin, then the surplus is distributed to their share
+	suite.Require().NoError(suite.keeper.DepositSavings(suite.ctx, suite.addrs[0], c("usdx", 1)))
+	suite.Require().NoError(suite.keeper.DistributeSavingsRate(suite.ctx, "usdx"))
+
+	// a later deposit still receives shares, and loses at most one coin to rounding
+	suite.Require().NoError(suite.keeper.DepositSavings(suite.ctx, suite.addrs[1], c("usdx", 5000)))
+	deposit, found := suite.keeper.GetSavingsDeposit(suite.ctx, "usdx", suite.addrs[1])
+	suite.Require().True(found)
+	suite.Equal(sdk.NewInt(499950), deposit.Shares)
+	suite.Equal(c("usdx", 4999), suite.keeper.GetSavingsDepositValue(suite.ctx, deposit))
+}
+
+func (suite *SavingsTestSuite) TestWithdrawSavings() {
+	suite.Require().NoError(suite.keeper.DepositSavings(suite.ctx, suite.addrs[0], c("usdx", 10000)))
+	suite.Require().NoError(suite.keeper.DepositSavings(suite.ctx, suite.addrs[1], c("usdx", 10000)))
+	suite.Require().NoError(suite.keeper.DistributeSavingsRate(suite.ctx, "usdx"))
+
+	err := suite.keeper.WithdrawSavings(suite.ctx, suite.addrs[2], c("usdx", 1))
+	suite.Require().True(errors.Is(err, types.ErrSavingsDepositNotFound))
+
+	err = suite.keeper.WithdrawSavings(suite.ctx, suite.addrs[0], c("usdx", 15001))
+	suite.Require().True(errors.Is(err, types.ErrInsufficientSavings))
+
+	// a partial withdrawal redeems the shares it is worth, rounded up
+	suite.Require().NoError(suite.keeper.WithdrawSavings(suite.ctx, suite.addrs[0], c("usdx", 7501)))
+	deposit, found := suite.keeper.GetSavingsDeposit(suite.ctx, "usdx", suite.addrs[0])
+	suite.Require().True(found)
+	suite.Equal(sdk.NewInt(4999333333), deposit.Shares)
+	suite.Equal(sdk.NewInt(14999333333), suite.keeper.GetTotalSavingsShares(suite.ctx, "usdx"))
+
+	// withdrawing the full value of a deposit removes it
+	suite.Require().NoError(suite.keeper.WithdrawSavings(suite.ctx, suite.addrs[1], c("usdx", 15000)))
+	_, found = suite.keeper.GetSavingsDeposit(suite.ctx, "usdx", suite.addrs[1])
+	suite.False(found)
+
+	ak := suite.app.GetAccountKeeper()
+	suite.Equal(cs(c("usdx", 97501)), ak.GetAccount(suite.ctx, suite.addrs[0]).GetCoins())
+	suite.Equal(cs(c("usdx", 55000)), ak.GetAccount(suite.ctx, suite.addrs[1]).GetCoins())
+	suite.Equal(sdk.NewInt(7499), suite.keeper.GetSavingsVaultBalance(suite.ctx, "usdx"))
+}
+
 func (suite *SavingsTestSuite) TestGetSetPreviousDistributionTime() {
 	now := tmtime.Now()
 
@@ -95,16 +239,16 @@ func (suite *SavingsTestSuite) TestGetSetPreviousDistributionTime() {
 
 func (suite *SavingsTestSuite) TestGetSetSavingsRateDistributed() {
 	// Savings rate dist set to 0 when the default genesis is used
-	preSavingsRateDistAmount := suite.keeper.GetSavingsRateDistributed(suite.ctx)
-	suite.True(preSavingsRateDistAmount.Equal(types.DefaultSavingsRateDistributed))
+	preSavingsRateDistAmount := suite.keeper.GetSavingsRateDistributed(suite.ctx, "usdx")
+	suite.True(preSavingsRateDistAmount.Equal(types.DefaultSavingsRateDistributed.AmountOf("usdx")))
 
 	// Adding new dist amount to existing dist so default genesis value can be updated in the future
 	amountToDistribute := sdk.NewInt(9876543210)
 	newTotalDistributed := preSavingsRateDistAmount.Add(amountToDistribute)
 
-	suite.NotPanics(func() { suite.keeper.SetSavingsRateDistributed(suite.ctx, newTotalDistributed) })
+	suite.NotPanics(func() { suite.keeper.SetSavingsRateDistributed(suite.ctx, "usdx", newTotalDistributed) })
 
-	postSavingsRateDistAmount := suite.keeper.GetSavingsRateDistributed(suite.ctx)
+	postSavingsRateDistAmount := suite.keeper.GetSavingsRateDistributed(suite.ctx, "usdx")
 	suite.Equal(newTotalDistributed, postSavingsRateDistAmount)
 }
 
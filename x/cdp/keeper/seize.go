@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/kava-labs/kava/x/cdp/types"
 )
@@ -16,16 +17,26 @@ import (
 // 4. The total amount of principal outstanding for that collateral type is decremented
 // (this is the equivalent of saying that fees are no longer accumulated by a cdp once it gets liquidated)
 func (k Keeper) SeizeCollateral(ctx sdk.Context, cdp types.CDP) error {
+	return k.seizeCollateral(ctx, cdp, nil)
+}
+
+func (k Keeper) seizeCollateral(ctx sdk.Context, cdp types.CDP, keeper sdk.AccAddress) error {
+	// Add the fees accumulated since the cdp was last synchronized to the debt being seized
+	cdp, err := k.SynchronizeInterest(ctx, cdp)
+	if err != nil {
+		return err
+	}
+
 	// Calculate the previous collateral ratio
-	oldCollateralToDebtRatio := k.CalculateCollateralToDebtRatio(ctx, cdp.Collateral, cdp.Type, cdp.GetTotalPrincipal())
+	oldCollateralToDebtRatio := k.CalculateCollateralToDebtRatio(ctx, cdp.Collateral, cdp.Type, cdp.GetNormalizedPrincipal())
 
 	// Move debt coins from cdp to liquidator account
 	deposits := k.GetDeposits(ctx, cdp.ID)
 	debt := cdp.GetTotalPrincipal().Amount
-	modAccountDebt := k.getModAccountDebt(ctx, types.ModuleName)
+	modAccountDebt := k.getModAccountDebt(ctx, types.ModuleName, cdp.Principal.Denom)
 	debt = sdk.MinInt(debt, modAccountDebt)
-	debtCoin := sdk.NewCoin(k.GetDebtDenom(ctx), debt)
-	err := k.supplyKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, types.LiquidatorMacc, sdk.NewCoins(debtCoin))
+	debtCoin := sdk.NewCoin(k.GetDebtCoinDenom(ctx, cdp.Principal.Denom), debt)
+	err = k.supplyKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, types.LiquidatorMacc, sdk.NewCoins(debtCoin))
 	if err != nil {
 		return err
 	}
@@ -48,6 +59,11 @@ func (k Keeper) SeizeCollateral(ctx sdk.Context, cdp types.CDP) error {
 		)
 	}
 
+	deposits, err = k.payKeeperReward(ctx, cdp, deposits, keeper)
+	if err != nil {
+		return err
+	}
+
 	err = k.AuctionCollateral(ctx, deposits, cdp.Type, debt, cdp.Principal.Denom)
 	if err != nil {
 		return err
@@ -65,11 +81,15 @@ func (k Keeper) SeizeCollateral(ctx sdk.Context, cdp types.CDP) error {
 
 // LiquidateCdps seizes collateral from all CDPs below the input liquidation ratio
 func (k Keeper) LiquidateCdps(ctx sdk.Context, marketID string, collateralType string, liquidationRatio sdk.Dec) error {
-	price, err := k.pricefeedKeeper.GetCurrentPrice(ctx, marketID)
+	cp, found := k.GetCollateral(ctx, collateralType)
+	if !found {
+		return sdkerrors.Wrap(types.ErrCollateralNotSupported, collateralType)
+	}
+	price, err := k.getLiquidationPrice(ctx, marketID, cp.LiquidationTWAPWindow)
 	if err != nil {
 		return err
 	}
-	priceDivLiqRatio := price.Price.Quo(liquidationRatio)
+	priceDivLiqRatio := price.Quo(liquidationRatio)
 	if priceDivLiqRatio.IsZero() {
 		priceDivLiqRatio = sdk.SmallestDec()
 	}
@@ -79,7 +99,7 @@ func (k Keeper) LiquidateCdps(ctx sdk.Context, marketID string, collateralType s
 	normalizedRatio := sdk.OneDec().Quo(priceDivLiqRatio)
 	cdpsToLiquidate := k.GetAllCdpsByCollateralTypeAndRatio(ctx, collateralType, normalizedRatio)
 	for _, c := range cdpsToLiquidate {
-		err := k.SeizeCollateral(ctx, c)
+		err := k.liquidateCdp(ctx, c, cp, nil)
 		if err != nil {
 			return err
 		}
@@ -87,13 +107,230 @@ func (k Keeper) LiquidateCdps(ctx sdk.Context, marketID string, collateralType s
 	return nil
 }
 
+// AttemptKeeperLiquidation liquidates the cdp of the input borrower if it is below the liquidation ratio of its
+// collateral type at the current liquidation price. The keeper is paid a reward out of the seized collateral.
+func (k Keeper) AttemptKeeperLiquidation(ctx sdk.Context, keeper, borrower sdk.AccAddress, collateralType string) error {
+	cp, found := k.GetCollateral(ctx, collateralType)
+	if !found {
+		return sdkerrors.Wrap(types.ErrCollateralNotSupported, collateralType)
+	}
+	if !k.GetMarketStatus(ctx, cp.LiquidationMarketID) {
+		return sdkerrors.Wrap(types.ErrPricefeedDown, cp.LiquidationMarketID)
+	}
+	cdp, found := k.GetCdpByOwnerAndCollateralType(ctx, borrower, collateralType)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrCdpNotFound, "%s %s", borrower, collateralType)
+	}
+	cdp, err := k.SynchronizeInterest(ctx, cdp)
+	if err != nil {
+		return err
+	}
+	collateralizationRatio, err := k.CalculateCollateralizationRatio(ctx, cdp.Collateral, cdp.Type, cdp.Principal, cdp.AccumulatedFees, liquidation)
+	if err != nil {
+		return err
+	}
+	if collateralizationRatio.GTE(cp.LiquidationRatio) {
+		return sdkerrors.Wrapf(types.ErrNotLiquidatable, "collateral ratio %s ≥ liquidation ratio %s", collateralizationRatio, cp.LiquidationRatio)
+	}
+	return k.liquidateCdp(ctx, cdp, cp, keeper)
+}
+
+// liquidateCdp fully or partially seizes the input cdp depending on the params of its collateral type,
+// paying the keeper reward when a keeper is given
+func (k Keeper) liquidateCdp(ctx sdk.Context, cdp types.CDP, cp types.CollateralParam, keeper sdk.AccAddress) error {
+	if cp.PartialLiquidationEnabled() {
+		return k.partiallySeizeCollateral(ctx, cdp, keeper)
+	}
+	return k.seizeCollateral(ctx, cdp, keeper)
+}
+
+// PartiallySeizeCollateral liquidates only as much of the input cdp as is needed to bring its collateralization
+// ratio back up to the target ratio of its collateral type, limited to the close factor of its debt.
+// The seized collateral is taken from each deposit in proportion to its size and auctioned off, while the
+// remainder of the cdp and its deposits stay open. The whole cdp is seized if the remaining debt would be
+// below the debt floor or the cdp cannot be restored by selling part of its collateral.
+func (k Keeper) PartiallySeizeCollateral(ctx sdk.Context, cdp types.CDP) error {
+	return k.partiallySeizeCollateral(ctx, cdp, nil)
+}
+
+func (k Keeper) partiallySeizeCollateral(ctx sdk.Context, cdp types.CDP, keeper sdk.AccAddress) error {
+	// Add the fees accumulated since the cdp was last synchronized to the debt being seized
+	cdp, err := k.SynchronizeInterest(ctx, cdp)
+	if err != nil {
+		return err
+	}
+
+	cp, found := k.GetCollateral(ctx, cdp.Type)
+	if !found {
+		return sdkerrors.Wrap(types.ErrCollateralNotSupported, cdp.Type)
+	}
+	dp, found := k.GetDebtParam(ctx, cdp.Principal.Denom)
+	if !found {
+		return sdkerrors.Wrap(types.ErrDebtNotSupported, cdp.Principal.Denom)
+	}
+
+	collateralizationRatio, err := k.CalculateCollateralizationRatio(ctx, cdp.Collateral, cdp.Type, cdp.Principal, cdp.AccumulatedFees, liquidation)
+	if err != nil {
+		return err
+	}
+	debtFraction, collateralFraction := calculatePartialLiquidation(collateralizationRatio, cp.TargetRatio, cp.LiquidationPenalty, cp.CloseFactor)
+	if !debtFraction.IsPositive() {
+		return nil
+	}
+	if debtFraction.GTE(sdk.OneDec()) || collateralFraction.GTE(sdk.OneDec()) {
+		return k.seizeCollateral(ctx, cdp, keeper)
+	}
+
+	// Debt is repaid from the accumulated fees first, then from the principal
+	totalDebt := cdp.GetTotalPrincipal().Amount
+	debt := sdk.NewDecFromInt(totalDebt).Mul(debtFraction).Ceil().TruncateInt()
+	feesRepaid := sdk.MinInt(debt, cdp.AccumulatedFees.Amount)
+	principalRepaid := debt.Sub(feesRepaid)
+	if cdp.Principal.Amount.Sub(principalRepaid).LT(dp.DebtFloor) {
+		return k.seizeCollateral(ctx, cdp, keeper)
+	}
+
+	// Split the collateral being seized across deposits, rounding in favour of the protocol
+	deposits := k.GetDeposits(ctx, cdp.ID)
+	totalCollateral := deposits.SumCollateral()
+	var seizedDeposits types.Deposits
+	seizedCollateral := sdk.ZeroInt()
+	for _, dep := range deposits {
+		seized := sdk.NewDecFromInt(dep.Amount.Amount).Mul(collateralFraction).Ceil().TruncateInt()
+		seized = sdk.MinInt(seized, dep.Amount.Amount)
+		if !seized.IsPositive() {
+			continue
+		}
+		seizedDeposits = append(seizedDeposits, types.NewDeposit(dep.CdpID, dep.Depositor, sdk.NewCoin(dep.Amount.Denom, seized)))
+		seizedCollateral = seizedCollateral.Add(seized)
+	}
+	if seizedCollateral.GTE(totalCollateral) {
+		return k.seizeCollateral(ctx, cdp, keeper)
+	}
+
+	// Calculate the previous collateral ratio
+	oldCollateralToDebtRatio := k.CalculateCollateralToDebtRatio(ctx, cdp.Collateral, cdp.Type, cdp.GetNormalizedPrincipal())
+
+	// Move the liquidated portion of the debt coins from cdp to liquidator account
+	modAccountDebt := k.getModAccountDebt(ctx, types.ModuleName, cdp.Principal.Denom)
+	debtCoin := sdk.NewCoin(k.GetDebtCoinDenom(ctx, cdp.Principal.Denom), sdk.MinInt(debt, modAccountDebt))
+	err = k.supplyKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, types.LiquidatorMacc, sdk.NewCoins(debtCoin))
+	if err != nil {
+		return err
+	}
+
+	// liquidate the seized portion of each deposit and send it from cdp to liquidator
+	for _, seizedDep := range seizedDeposits {
+		err := k.supplyKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, types.LiquidatorMacc, sdk.NewCoins(seizedDep.Amount))
+		if err != nil {
+			return err
+		}
+		dep, _ := k.GetDeposit(ctx, seizedDep.CdpID, seizedDep.Depositor)
+		dep.Amount = dep.Amount.Sub(seizedDep.Amount)
+		if dep.Amount.IsZero() {
+			k.DeleteDeposit(ctx, dep.CdpID, dep.Depositor)
+		} else {
+			k.SetDeposit(ctx, dep)
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeCdpLiquidation,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+				sdk.NewAttribute(types.AttributeKeyCdpID, fmt.Sprintf("%d", cdp.ID)),
+				sdk.NewAttribute(types.AttributeKeyDeposit, seizedDep.String()),
+			),
+		)
+	}
+
+	seizedDeposits, err = k.payKeeperReward(ctx, cdp, seizedDeposits, keeper)
+	if err != nil {
+		return err
+	}
+
+	err = k.AuctionCollateral(ctx, seizedDeposits, cdp.Type, debtCoin.Amount, cdp.Principal.Denom)
+	if err != nil {
+		return err
+	}
+
+	// Decrement total principal for this collateral type
+	k.DecrementTotalPrincipal(ctx, cdp.Type, sdk.NewCoin(cdp.Principal.Denom, debt))
+
+	// Update the remaining cdp and re-index it at its new collateral ratio
+	cdp.Collateral = cdp.Collateral.Sub(sdk.NewCoin(cdp.Collateral.Denom, seizedCollateral))
+	cdp.AccumulatedFees = cdp.AccumulatedFees.Sub(sdk.NewCoin(cdp.AccumulatedFees.Denom, feesRepaid))
+	cdp.Principal = cdp.Principal.Sub(sdk.NewCoin(cdp.Principal.Denom, principalRepaid))
+	k.RemoveCdpCollateralRatioIndex(ctx, cdp.Type, cdp.ID, oldCollateralToDebtRatio)
+	collateralToDebtRatio := k.CalculateCollateralToDebtRatio(ctx, cdp.Collateral, cdp.Type, cdp.GetNormalizedPrincipal())
+	return k.SetCdpAndCollateralRatioIndex(ctx, cdp, collateralToDebtRatio)
+}
+
+// calculatePartialLiquidation returns the fractions of a cdp's debt and collateral that must be liquidated to bring its
+// collateralization ratio up to the target ratio, where each unit of debt liquidated is covered by (1 + penalty) worth of collateral.
+// Solving (ratio - f*(1 + penalty)) / (1 - f) = target for f gives f = (target - ratio) / (target - 1 - penalty),
+// which is then limited to the close factor.
+func calculatePartialLiquidation(collateralizationRatio, targetRatio, penalty, closeFactor sdk.Dec) (debtFraction, collateralFraction sdk.Dec) {
+	if !collateralizationRatio.IsPositive() {
+		return sdk.OneDec(), sdk.OneDec()
+	}
+	debtFraction = targetRatio.Sub(collateralizationRatio).Quo(targetRatio.Sub(sdk.OneDec()).Sub(penalty))
+	debtFraction = sdk.MinDec(debtFraction, closeFactor)
+	collateralFraction = debtFraction.Mul(sdk.OneDec().Add(penalty)).Quo(collateralizationRatio)
+	return debtFraction, collateralFraction
+}
+
+// payKeeperReward sends the keeper reward percentage of each seized deposit from the liquidator module account to
+// the keeper and returns the deposits that remain to be auctioned. Deposits are returned unchanged if there is no keeper.
+func (k Keeper) payKeeperReward(ctx sdk.Context, cdp types.CDP, deposits types.Deposits, keeper sdk.AccAddress) (types.Deposits, error) {
+	if keeper.Empty() {
+		return deposits, nil
+	}
+	cp, found := k.GetCollateral(ctx, cdp.Type)
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrCollateralNotSupported, cdp.Type)
+	}
+	if cp.KeeperRewardPercentage.IsNil() || !cp.KeeperRewardPercentage.IsPositive() {
+		return deposits, nil
+	}
+
+	var remaining types.Deposits
+	reward := sdk.NewCoin(cdp.Collateral.Denom, sdk.ZeroInt())
+	for _, dep := range deposits {
+		depositReward := sdk.NewDecFromInt(dep.Amount.Amount).Mul(cp.KeeperRewardPercentage).TruncateInt()
+		reward = reward.Add(sdk.NewCoin(dep.Amount.Denom, depositReward))
+		dep.Amount = dep.Amount.Sub(sdk.NewCoin(dep.Amount.Denom, depositReward))
+		if dep.Amount.IsPositive() {
+			remaining = append(remaining, dep)
+		}
+	}
+	if !reward.IsPositive() {
+		return deposits, nil
+	}
+
+	err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.LiquidatorMacc, keeper, sdk.NewCoins(reward))
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCdpKeeperReward,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyCdpID, fmt.Sprintf("%d", cdp.ID)),
+			sdk.NewAttribute(types.AttributeKeyKeeper, keeper.String()),
+			sdk.NewAttribute(types.AttributeKeyReward, reward.String()),
+		),
+	)
+	return remaining, nil
+}
+
 // ApplyLiquidationPenalty multiplies the input debt amount by the liquidation penalty
 func (k Keeper) ApplyLiquidationPenalty(ctx sdk.Context, collateralType string, debt sdk.Int) sdk.Int {
 	penalty := k.getLiquidationPenalty(ctx, collateralType)
 	return sdk.NewDecFromInt(debt).Mul(penalty).RoundInt()
 }
 
-func (k Keeper) getModAccountDebt(ctx sdk.Context, accountName string) sdk.Int {
+func (k Keeper) getModAccountDebt(ctx sdk.Context, accountName, principalDenom string) sdk.Int {
 	macc := k.supplyKeeper.GetModuleAccount(ctx, accountName)
-	return macc.GetCoins().AmountOf(k.GetDebtDenom(ctx))
+	return macc.GetCoins().AmountOf(k.GetDebtCoinDenom(ctx, principalDenom))
 }
@@ -12,6 +12,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/simulation"
 
 	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/kv"
 	tmtime "github.com/tendermint/tendermint/types/time"
 
 	"github.com/kava-labs/kava/app"
@@ -144,7 +145,7 @@ func (suite *SeizeTestSuite) TestSeizeCollateral() {
 	_, found = auctionKeeper.GetAuction(suite.ctx, auction.DefaultNextAuctionID)
 	suite.True(found)
 	auctionMacc := sk.GetModuleAccount(suite.ctx, auction.ModuleName)
-	suite.Equal(cs(c("debt", p.Int64()), c("xrp", cl.Int64())), auctionMacc.GetCoins())
+	suite.Equal(cs(c("debtusdx", p.Int64()), c("xrp", cl.Int64())), auctionMacc.GetCoins())
 	ak := suite.app.GetAccountKeeper()
 	acc := ak.GetAccount(suite.ctx, suite.addrs[1])
 	suite.Equal(p.Int64(), acc.GetCoins().AmountOf("usdx").Int64())
@@ -171,7 +172,7 @@ func (suite *SeizeTestSuite) TestSeizeCollateralMultiDeposit() {
 	tpa := suite.keeper.GetTotalPrincipal(suite.ctx, "xrp-a", "usdx")
 	suite.Equal(tpb.Sub(tpa), p)
 	auctionMacc := sk.GetModuleAccount(suite.ctx, auction.ModuleName)
-	suite.Equal(cs(c("debt", p.Int64()), c("xrp", cl.Int64())), auctionMacc.GetCoins())
+	suite.Equal(cs(c("debtusdx", p.Int64()), c("xrp", cl.Int64())), auctionMacc.GetCoins())
 	ak := suite.app.GetAccountKeeper()
 	acc := ak.GetAccount(suite.ctx, suite.addrs[1])
 	suite.Equal(p.Int64(), acc.GetCoins().AmountOf("usdx").Int64())
@@ -195,6 +196,274 @@ func (suite *SeizeTestSuite) TestLiquidateCdps() {
 	suite.Equal(len(suite.liquidations.xrp), xrpLiquidations)
 }
 
+func (suite *SeizeTestSuite) TestLiquidateCdpsTWAP() {
+	suite.createCdps()
+	sk := suite.app.GetSupplyKeeper()
+	pfKeeper := suite.app.GetPriceFeedKeeper()
+	pfParams := pfKeeper.GetParams(suite.ctx)
+	pfParams.PriceHistoryLength = 10
+	pfKeeper.SetParams(suite.ctx, pfParams)
+	params := suite.keeper.GetParams(suite.ctx)
+	for i, cp := range params.CollateralParams {
+		if cp.Type == "xrp-a" {
+			params.CollateralParams[i].LiquidationTWAPWindow = time.Hour
+		}
+	}
+	suite.keeper.SetParams(suite.ctx, params)
+	p, found := suite.keeper.GetCollateral(suite.ctx, "xrp-a")
+	suite.Require().True(found)
+	acc := sk.GetModuleAccount(suite.ctx, types.ModuleName)
+	originalXrpCollateral := acc.GetCoins().AmountOf("xrp")
+
+	startTime := suite.ctx.BlockTime()
+	suite.setPrice(d("0.25"), "xrp:usd")
+
+	// a sudden price drop does not move the time weighted average price
+	suite.ctx = suite.ctx.WithBlockTime(startTime.Add(time.Hour))
+	suite.setPrice(d("0.2"), "xrp:usd")
+	err := suite.keeper.LiquidateCdps(suite.ctx, "xrp:usd", "xrp-a", p.LiquidationRatio)
+	suite.Require().NoError(err)
+	acc = sk.GetModuleAccount(suite.ctx, types.ModuleName)
+	suite.Equal(originalXrpCollateral, acc.GetCoins().AmountOf("xrp"))
+
+	// once the price has held for the whole window cdps are liquidated
+	suite.ctx = suite.ctx.WithBlockTime(startTime.Add(2 * time.Hour))
+	suite.setPrice(d("0.2"), "xrp:usd")
+	err = suite.keeper.LiquidateCdps(suite.ctx, "xrp:usd", "xrp-a", p.LiquidationRatio)
+	suite.Require().NoError(err)
+	acc = sk.GetModuleAccount(suite.ctx, types.ModuleName)
+	seizedXrpCollateral := originalXrpCollateral.Sub(acc.GetCoins().AmountOf("xrp"))
+	xrpLiquidations := int(seizedXrpCollateral.Quo(i(10000000000)).Int64())
+	suite.Equal(len(suite.liquidations.xrp), xrpLiquidations)
+}
+
+func (suite *SeizeTestSuite) TestLiquidateCdpsTWAPInsufficientHistory() {
+	suite.createCdps()
+	sk := suite.app.GetSupplyKeeper()
+	pfKeeper := suite.app.GetPriceFeedKeeper()
+	pfParams := pfKeeper.GetParams(suite.ctx)
+	pfParams.PriceHistoryLength = 1
+	pfKeeper.SetParams(suite.ctx, pfParams)
+	params := suite.keeper.GetParams(suite.ctx)
+	for i, cp := range params.CollateralParams {
+		if cp.Type == "xrp-a" {
+			params.CollateralParams[i].LiquidationTWAPWindow = time.Hour
+		}
+	}
+	suite.keeper.SetParams(suite.ctx, params)
+	p, found := suite.keeper.GetCollateral(suite.ctx, "xrp-a")
+	suite.Require().True(found)
+	acc := sk.GetModuleAccount(suite.ctx, types.ModuleName)
+	originalXrpCollateral := acc.GetCoins().AmountOf("xrp")
+
+	// the price history only covers the latest sample, so the current price is used and the fallback is signalled by an event
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Minute))
+	suite.setPrice(d("0.2"), "xrp:usd")
+	suite.ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+	err := suite.keeper.LiquidateCdps(suite.ctx, "xrp:usd", "xrp-a", p.LiquidationRatio)
+	suite.Require().NoError(err)
+	var fallbackEvents sdk.Events
+	for _, event := range suite.ctx.EventManager().Events() {
+		if event.Type == types.EventTypeLiquidationPriceFallback {
+			fallbackEvents = append(fallbackEvents, event)
+		}
+	}
+	suite.Require().Len(fallbackEvents, 1)
+	suite.Contains(fallbackEvents[0].Attributes, kv.Pair{Key: []byte(types.AttributeKeyMarketID), Value: []byte("xrp:usd")})
+	acc = sk.GetModuleAccount(suite.ctx, types.ModuleName)
+	seizedXrpCollateral := originalXrpCollateral.Sub(acc.GetCoins().AmountOf("xrp"))
+	xrpLiquidations := int(seizedXrpCollateral.Quo(i(10000000000)).Int64())
+	suite.Equal(len(suite.liquidations.xrp), xrpLiquidations)
+}
+
+func (suite *SeizeTestSuite) setPartialLiquidation(collateralType string, closeFactor, targetRatio sdk.Dec) {
+	params := suite.keeper.GetParams(suite.ctx)
+	for i, cp := range params.CollateralParams {
+		if cp.Type == collateralType {
+			params.CollateralParams[i].CloseFactor = closeFactor
+			params.CollateralParams[i].TargetRatio = targetRatio
+		}
+	}
+	suite.keeper.SetParams(suite.ctx, params)
+}
+
+func (suite *SeizeTestSuite) TestPartiallySeizeCollateral() {
+	sk := suite.app.GetSupplyKeeper()
+	suite.setPartialLiquidation("xrp-a", d("1.0"), d("2.5"))
+	err := suite.keeper.AddCdp(suite.ctx, suite.addrs[0], c("xrp", 10000000000), c("usdx", 1000000000), "xrp-a")
+	suite.Require().NoError(err)
+	err = suite.keeper.DepositCollateral(suite.ctx, suite.addrs[0], suite.addrs[1], c("xrp", 5000000000), "xrp-a")
+	suite.Require().NoError(err)
+	suite.setPrice(d("0.12"), "xrp:usd")
+	cdp, found := suite.keeper.GetCDP(suite.ctx, "xrp-a", uint64(1))
+	suite.Require().True(found)
+	tpb := suite.keeper.GetTotalPrincipal(suite.ctx, "xrp-a", "usdx")
+
+	err = suite.keeper.PartiallySeizeCollateral(suite.ctx, cdp)
+	suite.Require().NoError(err)
+
+	// ratio 1.8, so (2.5 - 1.8) / (2.5 - 1.05) of the debt is liquidated
+	updated, found := suite.keeper.GetCDP(suite.ctx, "xrp-a", uint64(1))
+	suite.Require().True(found)
+	_, found = suite.keeper.GetCdpByOwnerAndCollateralType(suite.ctx, suite.addrs[0], "xrp-a")
+	suite.True(found)
+	liquidatedDebt := cdp.Principal.Amount.Sub(updated.Principal.Amount)
+	suite.Equal(i(482758621), liquidatedDebt)
+	suite.Equal(tpb.Sub(liquidatedDebt), suite.keeper.GetTotalPrincipal(suite.ctx, "xrp-a", "usdx"))
+	seizedCollateral := cdp.Collateral.Amount.Sub(updated.Collateral.Amount)
+	suite.Equal(i(4224137933), seizedCollateral)
+
+	// deposits are reduced in proportion to their size
+	deposits := suite.keeper.GetDeposits(suite.ctx, updated.ID)
+	suite.Require().Equal(2, len(deposits))
+	suite.Equal(updated.Collateral.Amount, deposits.SumCollateral())
+	owner, _ := suite.keeper.GetDeposit(suite.ctx, updated.ID, suite.addrs[0])
+	suite.Equal(i(7183908045), owner.Amount.Amount)
+
+	ratio, err := suite.keeper.CalculateCollateralizationRatio(suite.ctx, updated.Collateral, updated.Type, updated.Principal, updated.AccumulatedFees, "liquidation")
+	suite.NoError(err)
+	suite.True(ratio.GTE(d("2.499999")))
+	cdps := suite.keeper.GetAllCdpsByCollateralTypeAndRatio(suite.ctx, "xrp-a", d("100.0"))
+	suite.Equal(1, len(cdps))
+
+	auctionMacc := sk.GetModuleAccount(suite.ctx, auction.ModuleName)
+	suite.Equal(cs(c("debtusdx", liquidatedDebt.Int64()), c("xrp", seizedCollateral.Int64())), auctionMacc.GetCoins())
+}
+
+func (suite *SeizeTestSuite) TestPartiallySeizeCollateralCloseFactor() {
+	suite.setPartialLiquidation("xrp-a", d("0.25"), d("2.5"))
+	err := suite.keeper.AddCdp(suite.ctx, suite.addrs[0], c("xrp", 10000000000), c("usdx", 1000000000), "xrp-a")
+	suite.Require().NoError(err)
+	suite.setPrice(d("0.12"), "xrp:usd")
+	cdp, found := suite.keeper.GetCDP(suite.ctx, "xrp-a", uint64(1))
+	suite.Require().True(found)
+
+	err = suite.keeper.PartiallySeizeCollateral(suite.ctx, cdp)
+	suite.Require().NoError(err)
+
+	updated, found := suite.keeper.GetCDP(suite.ctx, "xrp-a", uint64(1))
+	suite.Require().True(found)
+	suite.Equal(c("usdx", 750000000), updated.Principal)
+	// 0.25 * 1.05 / 1.2 of the collateral is seized
+	suite.Equal(c("xrp", 7812500000), updated.Collateral)
+}
+
+func (suite *SeizeTestSuite) TestPartiallySeizeCollateralBelowDebtFloor() {
+	suite.setPartialLiquidation("xrp-a", d("1.0"), d("2.5"))
+	err := suite.keeper.AddCdp(suite.ctx, suite.addrs[0], c("xrp", 10000000000), c("usdx", 15000000), "xrp-a")
+	suite.Require().NoError(err)
+	suite.setPrice(d("0.0027"), "xrp:usd")
+	cdp, found := suite.keeper.GetCDP(suite.ctx, "xrp-a", uint64(1))
+	suite.Require().True(found)
+
+	err = suite.keeper.PartiallySeizeCollateral(suite.ctx, cdp)
+	suite.Require().NoError(err)
+
+	_, found = suite.keeper.GetCDP(suite.ctx, "xrp-a", uint64(1))
+	suite.False(found)
+	suite.Equal(0, len(suite.keeper.GetDeposits(suite.ctx, cdp.ID)))
+}
+
+func (suite *SeizeTestSuite) TestLiquidateCdpsPartial() {
+	suite.createCdps()
+	suite.setPartialLiquidation("xrp-a", d("1.0"), d("2.5"))
+	suite.setPrice(d("0.2"), "xrp:usd")
+	p, found := suite.keeper.GetCollateral(suite.ctx, "xrp-a")
+	suite.True(found)
+	err := suite.keeper.LiquidateCdps(suite.ctx, "xrp:usd", "xrp-a", p.LiquidationRatio)
+	suite.NoError(err)
+	for _, id := range suite.liquidations.xrp {
+		cdp, found := suite.keeper.GetCDP(suite.ctx, "xrp-a", id)
+		suite.Require().True(found)
+		suite.True(cdp.Collateral.Amount.LT(i(10000000000)))
+	}
+	cdps := suite.keeper.GetAllCdpsByCollateralTypeAndRatio(suite.ctx, "xrp-a", d("10.0"))
+	suite.Equal(0, len(cdps))
+}
+
+func (suite *SeizeTestSuite) setKeeperReward(collateralType string, reward sdk.Dec) {
+	params := suite.keeper.GetParams(suite.ctx)
+	for i, cp := range params.CollateralParams {
+		if cp.Type == collateralType {
+			params.CollateralParams[i].KeeperRewardPercentage = reward
+		}
+	}
+	suite.keeper.SetParams(suite.ctx, params)
+}
+
+func (suite *SeizeTestSuite) TestKeeperLiquidation() {
+	sk := suite.app.GetSupplyKeeper()
+	ak := suite.app.GetAccountKeeper()
+	suite.setKeeperReward("xrp-a", d("0.01"))
+	err := suite.keeper.AddCdp(suite.ctx, suite.addrs[0], c("xrp", 10000000000), c("usdx", 1000000000), "xrp-a")
+	suite.Require().NoError(err)
+
+	err = suite.keeper.AttemptKeeperLiquidation(suite.ctx, suite.addrs[1], suite.addrs[0], "xrp-a")
+	suite.Require().True(errors.Is(err, types.ErrNotLiquidatable))
+	err = suite.keeper.AttemptKeeperLiquidation(suite.ctx, suite.addrs[1], suite.addrs[2], "xrp-a")
+	suite.Require().True(errors.Is(err, types.ErrCdpNotFound))
+	err = suite.keeper.AttemptKeeperLiquidation(suite.ctx, suite.addrs[1], suite.addrs[0], "lol-a")
+	suite.Require().True(errors.Is(err, types.ErrCollateralNotSupported))
+
+	suite.setPrice(d("0.19"), "xrp:usd")
+	err = suite.keeper.AttemptKeeperLiquidation(suite.ctx, suite.addrs[1], suite.addrs[0], "xrp-a")
+	suite.Require().NoError(err)
+
+	_, found := suite.keeper.GetCDP(suite.ctx, "xrp-a", uint64(1))
+	suite.False(found)
+	acc := ak.GetAccount(suite.ctx, suite.addrs[1])
+	suite.Equal(i(10100000000), acc.GetCoins().AmountOf("xrp"))
+	auctionMacc := sk.GetModuleAccount(suite.ctx, auction.ModuleName)
+	suite.Equal(cs(c("debtusdx", 1000000000), c("xrp", 9900000000)), auctionMacc.GetCoins())
+}
+
+func (suite *SeizeTestSuite) TestKeeperLiquidationPartial() {
+	ak := suite.app.GetAccountKeeper()
+	suite.setKeeperReward("xrp-a", d("0.01"))
+	suite.setPartialLiquidation("xrp-a", d("1.0"), d("2.5"))
+	err := suite.keeper.AddCdp(suite.ctx, suite.addrs[0], c("xrp", 10000000000), c("usdx", 1000000000), "xrp-a")
+	suite.Require().NoError(err)
+	suite.setPrice(d("0.18"), "xrp:usd")
+
+	err = suite.keeper.AttemptKeeperLiquidation(suite.ctx, suite.addrs[1], suite.addrs[0], "xrp-a")
+	suite.Require().NoError(err)
+
+	cdp, found := suite.keeper.GetCDP(suite.ctx, "xrp-a", uint64(1))
+	suite.Require().True(found)
+	seized := i(10000000000).Sub(cdp.Collateral.Amount)
+	acc := ak.GetAccount(suite.ctx, suite.addrs[1])
+	suite.Equal(i(10000000000).Add(seized.QuoRaw(100)), acc.GetCoins().AmountOf("xrp"))
+}
+
+func (suite *SeizeTestSuite) TestSeizeCollateralDutchAuction() {
+	params := suite.keeper.GetParams(suite.ctx)
+	for i, cp := range params.CollateralParams {
+		if cp.Type == "btc-a" {
+			params.CollateralParams[i].DutchAuction = true
+		}
+	}
+	suite.keeper.SetParams(suite.ctx, params)
+
+	err := suite.keeper.AddCdp(suite.ctx, suite.addrs[0], c("btc", 100000000), c("usdx", 4000000000), "btc-a")
+	suite.Require().NoError(err)
+	suite.setPrice(d("5000.00"), "btc:usd")
+	cdp, found := suite.keeper.GetCDP(suite.ctx, "btc-a", uint64(1))
+	suite.Require().True(found)
+
+	err = suite.keeper.SeizeCollateral(suite.ctx, cdp)
+	suite.Require().NoError(err)
+
+	auctions := suite.app.GetAuctionKeeper().GetAllAuctions(suite.ctx)
+	suite.Require().Len(auctions, 10)
+	for _, a := range auctions {
+		dutchAuction, ok := a.(auction.DutchCollateralAuction)
+		suite.Require().True(ok)
+		suite.Equal(c("btc", 10000000), dutchAuction.Lot)
+		// 5000 usd per btc is 50 usdx per satoshi, with the default 1.2 start price buffer
+		suite.Equal(d("60.0"), dutchAuction.StartPrice)
+	}
+}
+
 func (suite *SeizeTestSuite) TestApplyLiquidationPenalty() {
 	penalty := suite.keeper.ApplyLiquidationPenalty(suite.ctx, "xrp-a", i(1000))
 	suite.Equal(i(50), penalty)
@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/kava-labs/kava/x/cdp/types"
+)
+
+// TransferCdp moves the cdp of an owner and collateral type to a new owner, along with the owner's deposit
+func (k Keeper) TransferCdp(ctx sdk.Context, owner, recipient sdk.AccAddress, collateralType string) error {
+	cdp, found := k.GetCdpByOwnerAndCollateralType(ctx, owner, collateralType)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrCdpNotFound, "owner %s, collateral %s", owner, collateralType)
+	}
+	_, found = k.GetCdpByOwnerAndCollateralType(ctx, recipient, collateralType)
+	if found {
+		return sdkerrors.Wrapf(types.ErrCdpAlreadyExists, "owner %s, collateral %s", recipient, collateralType)
+	}
+
+	// move the cdp to the recipient's owner index
+	k.RemoveCdpOwnerIndex(ctx, cdp)
+	cdp.Owner = recipient
+	err := k.SetCDP(ctx, cdp)
+	if err != nil {
+		return err
+	}
+	k.IndexCdpByOwner(ctx, cdp)
+
+	// move the owner's deposit to the recipient, merging it with any deposit the recipient has already made
+	deposit, found := k.GetDeposit(ctx, cdp.ID, owner)
+	if found {
+		k.DeleteDeposit(ctx, cdp.ID, owner)
+		recipientDeposit, found := k.GetDeposit(ctx, cdp.ID, recipient)
+		if found {
+			recipientDeposit.Amount = recipientDeposit.Amount.Add(deposit.Amount)
+		} else {
+			recipientDeposit = types.NewDeposit(cdp.ID, recipient, deposit.Amount)
+		}
+		k.SetDeposit(ctx, recipientDeposit)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCdpTransfer,
+			sdk.NewAttribute(types.AttributeKeyCdpID, fmt.Sprintf("%d", cdp.ID)),
+			sdk.NewAttribute(types.AttributeKeyOwner, owner.String()),
+			sdk.NewAttribute(types.AttributeKeyRecipient, recipient.String()),
+		),
+	)
+	return nil
+}
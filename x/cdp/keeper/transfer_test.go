@@ -0,0 +1,107 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmtime "github.com/tendermint/tendermint/types/time"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/cdp/keeper"
+	"github.com/kava-labs/kava/x/cdp/types"
+)
+
+type TransferTestSuite struct {
+	suite.Suite
+
+	keeper keeper.Keeper
+	app    app.TestApp
+	ctx    sdk.Context
+	addrs  []sdk.AccAddress
+}
+
+func (suite *TransferTestSuite) SetupTest() {
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, abci.Header{Height: 1, Time: tmtime.Now()})
+	_, addrs := app.GeneratePrivKeyAddressPairs(3)
+	authGS := app.NewAuthGenState(
+		addrs,
+		[]sdk.Coins{
+			cs(c("xrp", 500000000), c("btc", 500000000)),
+			cs(c("xrp", 500000000)),
+			cs(c("xrp", 500000000)),
+		})
+	tApp.InitializeFromGenesisStates(
+		authGS,
+		NewPricefeedGenStateMulti(),
+		NewCDPGenStateMulti(),
+	)
+	suite.app = tApp
+	suite.keeper = tApp.GetCDPKeeper()
+	suite.ctx = ctx
+	suite.addrs = addrs
+	err := suite.keeper.AddCdp(suite.ctx, addrs[0], c("xrp", 400000000), c("usdx", 10000000), "xrp-a")
+	suite.NoError(err)
+}
+
+func (suite *TransferTestSuite) TestTransferCdp() {
+	// a third party deposit is left in place
+	err := suite.keeper.DepositCollateral(suite.ctx, suite.addrs[0], suite.addrs[2], c("xrp", 10000000), "xrp-a")
+	suite.NoError(err)
+
+	err = suite.keeper.TransferCdp(suite.ctx, suite.addrs[0], suite.addrs[1], "xrp-a")
+	suite.NoError(err)
+
+	_, found := suite.keeper.GetCdpByOwnerAndCollateralType(suite.ctx, suite.addrs[0], "xrp-a")
+	suite.False(found)
+	_, found = suite.keeper.GetCdpIdsByOwner(suite.ctx, suite.addrs[0])
+	suite.False(found)
+	cdp, found := suite.keeper.GetCdpByOwnerAndCollateralType(suite.ctx, suite.addrs[1], "xrp-a")
+	suite.True(found)
+	suite.Equal(uint64(1), cdp.ID)
+	suite.Equal(suite.addrs[1], cdp.Owner)
+
+	_, found = suite.keeper.GetDeposit(suite.ctx, cdp.ID, suite.addrs[0])
+	suite.False(found)
+	deposit, found := suite.keeper.GetDeposit(suite.ctx, cdp.ID, suite.addrs[1])
+	suite.True(found)
+	suite.True(deposit.Equals(types.NewDeposit(cdp.ID, suite.addrs[1], c("xrp", 400000000))))
+	deposit, found = suite.keeper.GetDeposit(suite.ctx, cdp.ID, suite.addrs[2])
+	suite.True(found)
+	suite.True(deposit.Equals(types.NewDeposit(cdp.ID, suite.addrs[2], c("xrp", 10000000))))
+
+	// the new owner can manage the cdp
+	err = suite.keeper.DepositCollateral(suite.ctx, suite.addrs[1], suite.addrs[1], c("xrp", 10000000), "xrp-a")
+	suite.NoError(err)
+}
+
+func (suite *TransferTestSuite) TestTransferCdpMergesRecipientDeposit() {
+	err := suite.keeper.DepositCollateral(suite.ctx, suite.addrs[0], suite.addrs[1], c("xrp", 10000000), "xrp-a")
+	suite.NoError(err)
+
+	err = suite.keeper.TransferCdp(suite.ctx, suite.addrs[0], suite.addrs[1], "xrp-a")
+	suite.NoError(err)
+
+	deposits := suite.keeper.GetDeposits(suite.ctx, uint64(1))
+	suite.Equal(1, len(deposits))
+	suite.True(deposits[0].Equals(types.NewDeposit(uint64(1), suite.addrs[1], c("xrp", 410000000))))
+}
+
+func (suite *TransferTestSuite) TestTransferCdpInvalid() {
+	err := suite.keeper.TransferCdp(suite.ctx, suite.addrs[1], suite.addrs[2], "xrp-a")
+	suite.True(errors.Is(err, types.ErrCdpNotFound))
+
+	err = suite.keeper.AddCdp(suite.ctx, suite.addrs[1], c("xrp", 400000000), c("usdx", 10000000), "xrp-a")
+	suite.NoError(err)
+	err = suite.keeper.TransferCdp(suite.ctx, suite.addrs[0], suite.addrs[1], "xrp-a")
+	suite.True(errors.Is(err, types.ErrCdpAlreadyExists))
+}
+
+func TestTransferTestSuite(t *testing.T) {
+	suite.Run(t, new(TransferTestSuite))
+}
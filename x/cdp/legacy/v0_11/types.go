@@ -11,6 +11,7 @@ import (
 )
 
 var (
+	KeyDebtParam        = []byte("DebtParam")
 	stabilityFeeMax     = sdk.MustNewDecFromStr("1.000000051034942716") // 500% APR
 	minCollateralPrefix = 0
 	maxCollateralPrefix = 255
@@ -48,13 +48,33 @@ func DecodeStore(cdc *codec.Codec, kvA, kvB kv.Pair) string {
 		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &depositB)
 		return fmt.Sprintf("%s\n%s", depositA, depositB)
 
-	case bytes.Equal(kvA.Key[:1], types.PrincipalKeyPrefix):
+	case bytes.Equal(kvA.Key[:1], types.SavingsDepositPrefix):
+		var depositA, depositB types.SavingsDeposit
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &depositA)
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &depositB)
+		return fmt.Sprintf("%s\n%s", depositA, depositB)
+
+	case bytes.Equal(kvA.Key[:1], types.PrincipalKeyPrefix),
+		bytes.Equal(kvA.Key[:1], types.SavingsSharesPrefix):
 		var totalA, totalB sdk.Int
 		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &totalA)
 		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &totalB)
 		return fmt.Sprintf("%s\n%s", totalA, totalB)
 
-	case bytes.Equal(kvA.Key[:1], types.PreviousDistributionTimeKey):
+	case bytes.Equal(kvA.Key[:1], types.CdpTotalsPrefix):
+		var totalsA, totalsB types.CdpTotals
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &totalsA)
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &totalsB)
+		return fmt.Sprintf("%+v\n%+v", totalsA, totalsB)
+
+	case bytes.Equal(kvA.Key[:1], types.InterestFactorPrefix):
+		var factorA, factorB sdk.Dec
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &factorA)
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &factorB)
+		return fmt.Sprintf("%s\n%s", factorA, factorB)
+
+	case bytes.Equal(kvA.Key[:1], types.PreviousDistributionTimeKey),
+		bytes.Equal(kvA.Key[:1], types.PreviousAccrualTimePrefix):
 		var timeA, timeB time.Time
 		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &timeA)
 		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &timeB)
@@ -32,7 +32,9 @@ func TestDecodeDistributionStore(t *testing.T) {
 	deposit := types.Deposit{CdpID: 1, Amount: oneCoins}
 	principal := sdk.OneInt()
 	prevDistTime := time.Now().UTC()
-	cdp := types.CDP{ID: 1, FeesUpdated: prevDistTime, Collateral: oneCoins, Principal: oneCoins, AccumulatedFees: oneCoins}
+	cdp := types.CDP{ID: 1, FeesUpdated: prevDistTime, Collateral: oneCoins, Principal: oneCoins, AccumulatedFees: oneCoins, InterestFactor: sdk.OneDec()}
+	interestFactor := sdk.MustNewDecFromStr("1.000000001")
+	savingsDeposit := types.NewSavingsDeposit(sdk.AccAddress("test1"), denom, sdk.OneInt())
 
 	kvPairs := kv.Pairs{
 		kv.Pair{Key: types.CdpIDKeyPrefix, Value: cdc.MustMarshalBinaryLengthPrefixed(cdpIds)},
@@ -44,6 +46,10 @@ func TestDecodeDistributionStore(t *testing.T) {
 		kv.Pair{Key: []byte(types.DepositKeyPrefix), Value: cdc.MustMarshalBinaryLengthPrefixed(deposit)},
 		kv.Pair{Key: []byte(types.PrincipalKeyPrefix), Value: cdc.MustMarshalBinaryLengthPrefixed(principal)},
 		kv.Pair{Key: []byte(types.PreviousDistributionTimeKey), Value: cdc.MustMarshalBinaryLengthPrefixed(prevDistTime)},
+		kv.Pair{Key: []byte(types.InterestFactorPrefix), Value: cdc.MustMarshalBinaryLengthPrefixed(interestFactor)},
+		kv.Pair{Key: []byte(types.PreviousAccrualTimePrefix), Value: cdc.MustMarshalBinaryLengthPrefixed(prevDistTime)},
+		kv.Pair{Key: []byte(types.SavingsDepositPrefix), Value: cdc.MustMarshalBinaryLengthPrefixed(savingsDeposit)},
+		kv.Pair{Key: []byte(types.SavingsSharesPrefix), Value: cdc.MustMarshalBinaryLengthPrefixed(principal)},
 		kv.Pair{Key: []byte{0x99}, Value: []byte{0x99}},
 	}
 
@@ -60,6 +66,10 @@ func TestDecodeDistributionStore(t *testing.T) {
 		{"DepositKeyPrefix", fmt.Sprintf("%v\n%v", deposit, deposit)},
 		{"Principal", fmt.Sprintf("%v\n%v", principal, principal)},
 		{"PreviousDistributionTime", fmt.Sprintf("%s\n%s", prevDistTime, prevDistTime)},
+		{"InterestFactor", fmt.Sprintf("%s\n%s", interestFactor, interestFactor)},
+		{"PreviousAccrualTime", fmt.Sprintf("%s\n%s", prevDistTime, prevDistTime)},
+		{"SavingsDeposit", fmt.Sprintf("%s\n%s", savingsDeposit, savingsDeposit)},
+		{"SavingsShares", fmt.Sprintf("%v\n%v", principal, principal)},
 		{"other", ""},
 	}
 	for i, tt := range tests {
@@ -70,11 +70,7 @@ func randomCdpGenState(selection int) types.GenesisState {
 	case 0:
 		return types.GenesisState{
 			Params: types.Params{
-				GlobalDebtLimit:              sdk.NewInt64Coin("usdx", 100000000000000),
-				SurplusAuctionThreshold:      types.DefaultSurplusThreshold,
-				SurplusAuctionLot:            types.DefaultSurplusLot,
-				DebtAuctionLot:               types.DefaultDebtLot,
-				DebtAuctionThreshold:         types.DefaultDebtThreshold,
+				GlobalDebtLimit:              sdk.NewCoins(sdk.NewInt64Coin("usdx", 100000000000000)),
 				SavingsDistributionFrequency: types.DefaultSavingsDistributionFrequency,
 				CollateralParams: types.CollateralParams{
 					{
@@ -117,12 +113,18 @@ func randomCdpGenState(selection int) types.GenesisState {
 						ConversionFactor:    sdk.NewInt(8),
 					},
 				},
-				DebtParam: types.DebtParam{
-					Denom:            "usdx",
-					ReferenceAsset:   "usd",
-					ConversionFactor: sdk.NewInt(6),
-					DebtFloor:        sdk.NewInt(10000000),
-					SavingsRate:      sdk.MustNewDecFromStr("0.95"),
+				DebtParams: types.DebtParams{
+					{
+						Denom:                   "usdx",
+						ReferenceAsset:          "usd",
+						ConversionFactor:        sdk.NewInt(6),
+						DebtFloor:               sdk.NewInt(10000000),
+						SavingsRate:             sdk.MustNewDecFromStr("0.95"),
+						SurplusAuctionThreshold: types.DefaultSurplusThreshold,
+						SurplusAuctionLot:       types.DefaultSurplusLot,
+						DebtAuctionThreshold:    types.DefaultDebtThreshold,
+						DebtAuctionLot:          types.DefaultDebtLot,
+					},
 				},
 			},
 			StartingCdpID:            types.DefaultCdpStartingID,
@@ -134,11 +136,7 @@ func randomCdpGenState(selection int) types.GenesisState {
 	case 1:
 		return types.GenesisState{
 			Params: types.Params{
-				GlobalDebtLimit:              sdk.NewInt64Coin("usdx", 100000000000000),
-				SurplusAuctionThreshold:      types.DefaultSurplusThreshold,
-				DebtAuctionThreshold:         types.DefaultDebtThreshold,
-				SurplusAuctionLot:            types.DefaultSurplusLot,
-				DebtAuctionLot:               types.DefaultDebtLot,
+				GlobalDebtLimit:              sdk.NewCoins(sdk.NewInt64Coin("usdx", 100000000000000)),
 				SavingsDistributionFrequency: types.DefaultSavingsDistributionFrequency,
 				CollateralParams: types.CollateralParams{
 					{
@@ -155,12 +153,18 @@ func randomCdpGenState(selection int) types.GenesisState {
 						ConversionFactor:    sdk.NewInt(8),
 					},
 				},
-				DebtParam: types.DebtParam{
-					Denom:            "usdx",
-					ReferenceAsset:   "usd",
-					ConversionFactor: sdk.NewInt(6),
-					DebtFloor:        sdk.NewInt(10000000),
-					SavingsRate:      sdk.MustNewDecFromStr("0.95"),
+				DebtParams: types.DebtParams{
+					{
+						Denom:                   "usdx",
+						ReferenceAsset:          "usd",
+						ConversionFactor:        sdk.NewInt(6),
+						DebtFloor:               sdk.NewInt(10000000),
+						SavingsRate:             sdk.MustNewDecFromStr("0.95"),
+						SurplusAuctionThreshold: types.DefaultSurplusThreshold,
+						SurplusAuctionLot:       types.DefaultSurplusLot,
+						DebtAuctionThreshold:    types.DefaultDebtThreshold,
+						DebtAuctionLot:          types.DefaultDebtLot,
+					},
 				},
 			},
 			StartingCdpID:            types.DefaultCdpStartingID,
@@ -17,7 +17,8 @@ import (
 
 // Simulation operation weights constants
 const (
-	OpWeightMsgCdp = "op_weight_msg_cdp"
+	OpWeightMsgCdp         = "op_weight_msg_cdp"
+	OpWeightMsgTransferCdp = "op_weight_msg_transfer_cdp"
 )
 
 // WeightedOperations returns all the operations from the module with their respective weights
@@ -26,6 +27,7 @@ func WeightedOperations(
 	k keeper.Keeper, pfk types.PricefeedKeeper,
 ) simulation.WeightedOperations {
 	var weightMsgCdp int
+	var weightMsgTransferCdp int
 
 	appParams.GetOrGenerate(cdc, OpWeightMsgCdp, &weightMsgCdp, nil,
 		func(_ *rand.Rand) {
@@ -33,11 +35,21 @@ func WeightedOperations(
 		},
 	)
 
+	appParams.GetOrGenerate(cdc, OpWeightMsgTransferCdp, &weightMsgTransferCdp, nil,
+		func(_ *rand.Rand) {
+			weightMsgTransferCdp = appparams.DefaultWeightMsgTransferCdp
+		},
+	)
+
 	return simulation.WeightedOperations{
 		simulation.NewWeightedOperation(
 			weightMsgCdp,
 			SimulateMsgCdp(ak, k, pfk),
 		),
+		simulation.NewWeightedOperation(
+			weightMsgTransferCdp,
+			SimulateMsgTransferCDP(ak, k),
+		),
 	}
 }
 
@@ -184,7 +196,7 @@ func SimulateMsgCdp(ak types.AccountKeeper, k keeper.Keeper, pfk types.Pricefeed
 		if shouldDraw(r) {
 			collateralShifted := ShiftDec(sdk.NewDecFromInt(existingCDP.Collateral.Amount), randCollateralParam.ConversionFactor.Neg())
 			collateralValue := collateralShifted.Mul(priceShifted)
-			newFeesAccumulated := k.CalculateFees(ctx, existingCDP.Principal, sdk.NewInt(ctx.BlockTime().Unix()-existingCDP.FeesUpdated.Unix()), randCollateralParam.Type).Amount
+			newFeesAccumulated := k.CalculateNewInterest(ctx, existingCDP).Amount
 			totalFees := existingCDP.AccumulatedFees.Amount.Add(newFeesAccumulated)
 			// given the current collateral value, calculate how much debt we could add while maintaining a valid liquidation ratio
 			debt := existingCDP.Principal.Amount.Add(totalFees)
@@ -265,6 +277,61 @@ func SimulateMsgCdp(ak types.AccountKeeper, k keeper.Keeper, pfk types.Pricefeed
 	}
 }
 
+// SimulateMsgTransferCDP generates a MsgTransferCDP moving a random cdp to an account without a cdp of the same collateral type.
+func SimulateMsgTransferCDP(ak types.AccountKeeper, k keeper.Keeper) simulation.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account, chainID string,
+	) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+
+		collateralParams := k.GetParams(ctx).CollateralParams
+		if len(collateralParams) == 0 {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+		randCollateralParam := collateralParams[r.Intn(len(collateralParams))]
+
+		simAccount, _ := simulation.RandomAcc(r, accs)
+		acc := ak.GetAccount(ctx, simAccount.Address)
+		if acc == nil {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+		if _, found := k.GetCdpByOwnerAndCollateralType(ctx, acc.GetAddress(), randCollateralParam.Type); !found {
+			return simulation.NewOperationMsgBasic(types.ModuleName, "no-operation", "account has no cdp to transfer", false, nil), nil, nil
+		}
+
+		recipient, _ := simulation.RandomAcc(r, accs)
+		if recipient.Address.Equals(acc.GetAddress()) {
+			return simulation.NewOperationMsgBasic(types.ModuleName, "no-operation", "cannot transfer cdp to its owner", false, nil), nil, nil
+		}
+		if _, found := k.GetCdpByOwnerAndCollateralType(ctx, recipient.Address, randCollateralParam.Type); found {
+			return simulation.NewOperationMsgBasic(types.ModuleName, "no-operation", "recipient already has a cdp", false, nil), nil, nil
+		}
+
+		fees, err := simulation.RandomFees(r, ctx, acc.SpendableCoins(ctx.BlockTime()))
+		if err != nil {
+			return simulation.NoOpMsg(types.ModuleName), nil, err
+		}
+
+		msg := types.NewMsgTransferCDP(acc.GetAddress(), recipient.Address, randCollateralParam.Type)
+
+		tx := helpers.GenTx(
+			[]sdk.Msg{msg},
+			fees,
+			helpers.DefaultGenTxGas,
+			chainID,
+			[]uint64{acc.GetAccountNumber()},
+			[]uint64{acc.GetSequence()},
+			simAccount.PrivKey,
+		)
+
+		_, _, err = app.Deliver(tx)
+		if err != nil {
+			return simulation.NewOperationMsg(msg, false, fmt.Sprintf("%+v", err)), nil, err
+		}
+
+		return simulation.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
 func shouldDraw(r *rand.Rand) bool {
 	threshold := 50
 	value := simulation.RandIntBetween(r, 1, 100)
@@ -18,11 +18,12 @@ type CDP struct {
 	Collateral      sdk.Coin       `json:"collateral" yaml:"collateral"`             // Amount of collateral stored in this CDP
 	Principal       sdk.Coin       `json:"principal" yaml:"principal"`               // Amount of debt drawn using the CDP
 	AccumulatedFees sdk.Coin       `json:"accumulated_fees" yaml:"accumulated_fees"` // Fees accumulated since the CDP was opened or debt was last repayed
-	FeesUpdated     time.Time      `json:"fees_updated" yaml:"fees_updated"`         // Amount of stable coin drawn from this CDP
+	FeesUpdated     time.Time      `json:"fees_updated" yaml:"fees_updated"`         // The time fees were last synchronized with the global interest factor
+	InterestFactor  sdk.Dec        `json:"interest_factor" yaml:"interest_factor"`   // Snapshot of the collateral type's interest factor when fees were last synchronized
 }
 
 // NewCDP creates a new CDP object
-func NewCDP(id uint64, owner sdk.AccAddress, collateral sdk.Coin, collateralType string, principal sdk.Coin, time time.Time) CDP {
+func NewCDP(id uint64, owner sdk.AccAddress, collateral sdk.Coin, collateralType string, principal sdk.Coin, time time.Time, interestFactor sdk.Dec) CDP {
 	fees := sdk.NewCoin(principal.Denom, sdk.ZeroInt())
 	return CDP{
 		ID:              id,
@@ -32,11 +33,12 @@ func NewCDP(id uint64, owner sdk.AccAddress, collateral sdk.Coin, collateralType
 		Principal:       principal,
 		AccumulatedFees: fees,
 		FeesUpdated:     time,
+		InterestFactor:  interestFactor,
 	}
 }
 
 // NewCDPWithFees creates a new CDP object, for use during migration
-func NewCDPWithFees(id uint64, owner sdk.AccAddress, collateral sdk.Coin, collateralType string, principal, fees sdk.Coin, time time.Time) CDP {
+func NewCDPWithFees(id uint64, owner sdk.AccAddress, collateral sdk.Coin, collateralType string, principal, fees sdk.Coin, time time.Time, interestFactor sdk.Dec) CDP {
 	return CDP{
 		ID:              id,
 		Owner:           owner,
@@ -45,6 +47,7 @@ func NewCDPWithFees(id uint64, owner sdk.AccAddress, collateral sdk.Coin, collat
 		Principal:       principal,
 		AccumulatedFees: fees,
 		FeesUpdated:     time,
+		InterestFactor:  interestFactor,
 	}
 }
 
@@ -57,7 +60,8 @@ func (cdp CDP) String() string {
 	Collateral: %s
 	Principal: %s
 	AccumulatedFees: %s
-	Fees Last Updated: %s`,
+	Fees Last Updated: %s
+	Interest Factor: %s`,
 		cdp.Owner,
 		cdp.ID,
 		cdp.Type,
@@ -65,6 +69,7 @@ func (cdp CDP) String() string {
 		cdp.Principal,
 		cdp.AccumulatedFees,
 		cdp.FeesUpdated,
+		cdp.InterestFactor,
 	))
 }
 
@@ -88,6 +93,9 @@ func (cdp CDP) Validate() error {
 	if cdp.FeesUpdated.IsZero() {
 		return errors.New("cdp updated fee time cannot be zero")
 	}
+	if cdp.InterestFactor.IsNil() || cdp.InterestFactor.LT(sdk.OneDec()) {
+		return fmt.Errorf("cdp interest factor must be ≥ 1, is %s", cdp.InterestFactor)
+	}
 	if strings.TrimSpace(cdp.Type) == "" {
 		return fmt.Errorf("cdp type cannot be empty")
 	}
@@ -99,6 +107,18 @@ func (cdp CDP) GetTotalPrincipal() sdk.Coin {
 	return cdp.Principal.Add(cdp.AccumulatedFees)
 }
 
+// GetNormalizedPrincipal returns the total principal of the cdp divided by the interest factor it was last synchronized at.
+// Multiplying the normalized principal by the current interest factor of the collateral type yields the up-to-date debt,
+// which is why it is used to key the collateral ratio index.
+func (cdp CDP) GetNormalizedPrincipal() sdk.Coin {
+	totalPrincipal := cdp.GetTotalPrincipal()
+	if cdp.InterestFactor.IsNil() || !cdp.InterestFactor.IsPositive() {
+		return totalPrincipal
+	}
+	normalized := sdk.NewDecFromInt(totalPrincipal.Amount).Quo(cdp.InterestFactor).RoundInt()
+	return sdk.NewCoin(totalPrincipal.Denom, normalized)
+}
+
 // CDPs a collection of CDP objects
 type CDPs []CDP
 
@@ -139,6 +159,7 @@ func NewAugmentedCDP(cdp CDP, collateralValue sdk.Coin, collateralizationRatio s
 			Principal:       cdp.Principal,
 			AccumulatedFees: cdp.AccumulatedFees,
 			FeesUpdated:     cdp.FeesUpdated,
+			InterestFactor:  cdp.InterestFactor,
 		},
 		CollateralValue:        collateralValue,
 		CollateralizationRatio: collateralizationRatio,
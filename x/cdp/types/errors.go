@@ -45,4 +45,12 @@ var (
 	ErrPricefeedDown = sdkerrors.Register(ModuleName, 19, "no price found for collateral")
 	// ErrInvalidCollateral error for when the input collateral denom does not match the expected collateral denom
 	ErrInvalidCollateral = sdkerrors.Register(ModuleName, 20, "invalid collateral for input collateral type")
+	// ErrNotLiquidatable error for when a cdp is not below its liquidation ratio
+	ErrNotLiquidatable = sdkerrors.Register(ModuleName, 21, "cdp is not below liquidation ratio")
+	// ErrSavingsDepositNotFound error for a savings deposit that does not exist
+	ErrSavingsDepositNotFound = sdkerrors.Register(ModuleName, 22, "savings deposit not found")
+	// ErrInvalidSavingsDeposit error for a savings deposit that is too small or not a debt denom
+	ErrInvalidSavingsDeposit = sdkerrors.Register(ModuleName, 23, "invalid savings deposit")
+	// ErrInsufficientSavings error for withdrawing more than a savings deposit is worth
+	ErrInsufficientSavings = sdkerrors.Register(ModuleName, 24, "withdrawal amount exceeds savings deposit")
 )
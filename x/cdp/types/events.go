@@ -2,17 +2,30 @@ package types
 
 // Event types for cdp module
 const (
-	EventTypeCreateCdp         = "create_cdp"
-	EventTypeCdpDeposit        = "cdp_deposit"
-	EventTypeCdpDraw           = "cdp_draw"
-	EventTypeCdpRepay          = "cdp_repayment"
-	EventTypeCdpClose          = "cdp_close"
-	EventTypeCdpWithdrawal     = "cdp_withdrawal"
-	EventTypeCdpLiquidation    = "cdp_liquidation"
-	EventTypeBeginBlockerFatal = "cdp_begin_block_error"
+	EventTypeCreateCdp                = "create_cdp"
+	EventTypeCdpDeposit               = "cdp_deposit"
+	EventTypeCdpDraw                  = "cdp_draw"
+	EventTypeCdpRepay                 = "cdp_repayment"
+	EventTypeCdpClose                 = "cdp_close"
+	EventTypeCdpWithdrawal            = "cdp_withdrawal"
+	EventTypeCdpLiquidation           = "cdp_liquidation"
+	EventTypeCdpKeeperReward          = "cdp_keeper_reward"
+	EventTypeCdpTransfer              = "cdp_transfer"
+	EventTypeSavingsDeposit           = "savings_deposit"
+	EventTypeSavingsWithdrawal        = "savings_withdrawal"
+	EventTypeBeginBlockerFatal        = "cdp_begin_block_error"
+	EventTypeLiquidationPriceFallback = "cdp_liquidation_price_fallback"
 
 	AttributeKeyCdpID      = "cdp_id"
 	AttributeKeyDeposit    = "deposit"
 	AttributeValueCategory = "cdp"
 	AttributeKeyError      = "error_message"
+	AttributeKeyKeeper     = "keeper"
+	AttributeKeyReward     = "reward"
+	AttributeKeyOwner      = "owner"
+	AttributeKeyRecipient  = "recipient"
+	AttributeKeyDepositor  = "depositor"
+	AttributeKeyAmount     = "amount"
+	AttributeKeyShares     = "shares"
+	AttributeKeyMarketID   = "market_id"
 )
@@ -3,6 +3,7 @@ package types
 import (
 	"bytes"
 	"fmt"
+	"strings"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -17,21 +18,27 @@ type GenesisState struct {
 	DebtDenom                string    `json:"debt_denom" yaml:"debt_denom"`
 	GovDenom                 string    `json:"gov_denom" yaml:"gov_denom"`
 	PreviousDistributionTime time.Time `json:"previous_distribution_time" yaml:"previous_distribution_time"`
-	SavingsRateDistributed   sdk.Int   `json:"savings_rate_distributed" yaml:"savings_rate_distributed"`
+	SavingsRateDistributed   sdk.Coins `json:"savings_rate_distributed" yaml:"savings_rate_distributed"`
+
+	PreviousAccumulationTimes GenesisAccumulationTimes `json:"previous_accumulation_times" yaml:"previous_accumulation_times"`
+	SavingsDeposits           SavingsDeposits          `json:"savings_deposits" yaml:"savings_deposits"`
 }
 
 // NewGenesisState returns a new genesis state
 func NewGenesisState(params Params, cdps CDPs, deposits Deposits, startingCdpID uint64,
-	debtDenom, govDenom string, previousDistTime time.Time, savingsRateDist sdk.Int) GenesisState {
+	debtDenom, govDenom string, previousDistTime time.Time, savingsRateDist sdk.Coins,
+	prevAccumTimes GenesisAccumulationTimes, savingsDeposits SavingsDeposits) GenesisState {
 	return GenesisState{
-		Params:                   params,
-		CDPs:                     cdps,
-		Deposits:                 deposits,
-		StartingCdpID:            startingCdpID,
-		DebtDenom:                debtDenom,
-		GovDenom:                 govDenom,
-		PreviousDistributionTime: previousDistTime,
-		SavingsRateDistributed:   savingsRateDist,
+		Params:                    params,
+		CDPs:                      cdps,
+		Deposits:                  deposits,
+		StartingCdpID:             startingCdpID,
+		DebtDenom:                 debtDenom,
+		GovDenom:                  govDenom,
+		PreviousDistributionTime:  previousDistTime,
+		SavingsRateDistributed:    savingsRateDist,
+		PreviousAccumulationTimes: prevAccumTimes,
+		SavingsDeposits:           savingsDeposits,
 	}
 }
 
@@ -46,6 +53,8 @@ func DefaultGenesisState() GenesisState {
 		DefaultGovDenom,
 		DefaultPreviousDistributionTime,
 		DefaultSavingsRateDistributed,
+		GenesisAccumulationTimes{},
+		SavingsDeposits{},
 	)
 }
 
@@ -73,6 +82,14 @@ func (gs GenesisState) Validate() error {
 		return err
 	}
 
+	if err := gs.PreviousAccumulationTimes.Validate(); err != nil {
+		return err
+	}
+
+	if err := gs.SavingsDeposits.Validate(); err != nil {
+		return err
+	}
+
 	if err := sdk.ValidateDenom(gs.DebtDenom); err != nil {
 		return fmt.Errorf(fmt.Sprintf("debt denom invalid: %v", err))
 	}
@@ -81,19 +98,73 @@ func (gs GenesisState) Validate() error {
 		return fmt.Errorf(fmt.Sprintf("gov denom invalid: %v", err))
 	}
 
+	for _, dp := range gs.Params.DebtParams {
+		if err := sdk.ValidateDenom(DebtCoinDenom(gs.DebtDenom, dp.Denom)); err != nil {
+			return fmt.Errorf(fmt.Sprintf("debt coin denom for %s invalid: %v", dp.Denom, err))
+		}
+	}
+
 	return nil
 }
 
 func validateSavingsRateDistributed(i interface{}) error {
-	savingsRateDist, ok := i.(sdk.Int)
+	savingsRateDist, ok := i.(sdk.Coins)
 	if !ok {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
 
-	if savingsRateDist.IsNegative() {
-		return fmt.Errorf("savings rate distributed should not be negative: %s", savingsRateDist)
+	if !savingsRateDist.IsValid() {
+		return fmt.Errorf("invalid savings rate distributed: %s", savingsRateDist)
+	}
+
+	return nil
+}
+
+// GenesisAccumulationTime stores the interest accumulation state of a collateral type
+type GenesisAccumulationTime struct {
+	CollateralType           string    `json:"collateral_type" yaml:"collateral_type"`
+	PreviousAccumulationTime time.Time `json:"previous_accumulation_time" yaml:"previous_accumulation_time"`
+	InterestFactor           sdk.Dec   `json:"interest_factor" yaml:"interest_factor"`
+}
+
+// NewGenesisAccumulationTime returns a new GenesisAccumulationTime
+func NewGenesisAccumulationTime(ctype string, prevTime time.Time, factor sdk.Dec) GenesisAccumulationTime {
+	return GenesisAccumulationTime{
+		CollateralType:           ctype,
+		PreviousAccumulationTime: prevTime,
+		InterestFactor:           factor,
+	}
+}
+
+// Validate performs a basic check of a GenesisAccumulationTime fields.
+func (gat GenesisAccumulationTime) Validate() error {
+	if strings.TrimSpace(gat.CollateralType) == "" {
+		return fmt.Errorf("accumulation time collateral type cannot be empty")
+	}
+	if gat.PreviousAccumulationTime.IsZero() {
+		return fmt.Errorf("previous accumulation time not set for %s", gat.CollateralType)
+	}
+	if gat.InterestFactor.IsNil() || gat.InterestFactor.LT(sdk.OneDec()) {
+		return fmt.Errorf("interest factor for %s should be ≥ 1.0, is %s", gat.CollateralType, gat.InterestFactor)
 	}
+	return nil
+}
 
+// GenesisAccumulationTimes slice of GenesisAccumulationTime
+type GenesisAccumulationTimes []GenesisAccumulationTime
+
+// Validate performs basic validation of genesis accumulation times
+func (gats GenesisAccumulationTimes) Validate() error {
+	seenTypes := make(map[string]bool)
+	for _, gat := range gats {
+		if err := gat.Validate(); err != nil {
+			return err
+		}
+		if seenTypes[gat.CollateralType] {
+			return fmt.Errorf("duplicate accumulation time for collateral type %s", gat.CollateralType)
+		}
+		seenTypes[gat.CollateralType] = true
+	}
 	return nil
 }
 
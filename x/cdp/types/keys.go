@@ -28,6 +28,9 @@ const (
 
 	// SavingsRateMacc module account for savings rate
 	SavingsRateMacc = "savings"
+
+	// SavingsVaultMacc module account for the savings vault
+	SavingsVaultMacc = "savings_vault"
 )
 
 var sep = []byte(":")
@@ -47,21 +50,31 @@ var sep = []byte(":")
 // - 0x07<denom>:feeRate
 // - 0x08:previousDistributionTime
 // - 0x09<marketID>:downTime
-// - 0x10:totalDistributed
+// - 0x10<denom>:totalDistributed
+// - 0x11<collateralType>:interestFactor
+// - 0x12<collateralType>:previousAccrualTime
+// - 0x13<denom>:<depositorAddr_bytes>: SavingsDeposit
+// - 0x14<denom>:totalSavingsShares
+// - 0x15<collateralType>:CdpTotals
 
 // KVStore key prefixes
 var (
-	CdpIDKeyPrefix              = []byte{0x00}
-	CdpKeyPrefix                = []byte{0x01}
-	CollateralRatioIndexPrefix  = []byte{0x02}
-	CdpIDKey                    = []byte{0x03}
-	DebtDenomKey                = []byte{0x04}
-	GovDenomKey                 = []byte{0x05}
-	DepositKeyPrefix            = []byte{0x06}
-	PrincipalKeyPrefix          = []byte{0x07}
-	PreviousDistributionTimeKey = []byte{0x08}
-	PricefeedStatusKeyPrefix    = []byte{0x09}
-	SavingsRateDistributedKey   = []byte{0x10}
+	CdpIDKeyPrefix               = []byte{0x00}
+	CdpKeyPrefix                 = []byte{0x01}
+	CollateralRatioIndexPrefix   = []byte{0x02}
+	CdpIDKey                     = []byte{0x03}
+	DebtDenomKey                 = []byte{0x04}
+	GovDenomKey                  = []byte{0x05}
+	DepositKeyPrefix             = []byte{0x06}
+	PrincipalKeyPrefix           = []byte{0x07}
+	PreviousDistributionTimeKey  = []byte{0x08}
+	PricefeedStatusKeyPrefix     = []byte{0x09}
+	SavingsRateDistributedPrefix = []byte{0x10}
+	InterestFactorPrefix         = []byte{0x11}
+	PreviousAccrualTimePrefix    = []byte{0x12}
+	SavingsDepositPrefix         = []byte{0x13}
+	SavingsSharesPrefix          = []byte{0x14}
+	CdpTotalsPrefix              = []byte{0x15}
 )
 
 // GetCdpIDBytes returns the byte representation of the cdpID
@@ -120,6 +133,16 @@ func SplitDepositIterKey(key []byte) (cdpID uint64) {
 	return GetCdpIDFromBytes(key)
 }
 
+// SavingsDepositKey key of a savings deposit in the store
+func SavingsDepositKey(denom string, depositor sdk.AccAddress) []byte {
+	return createKey([]byte(denom), sep, depositor)
+}
+
+// SavingsDepositIterKey returns the prefix key for iterating over the savings deposits of a denom
+func SavingsDepositIterKey(denom string) []byte {
+	return createKey([]byte(denom), sep)
+}
+
 // CollateralRatioBytes returns the liquidation ratio as sortable bytes
 func CollateralRatioBytes(ratio sdk.Dec) []byte {
 	ok := ValidSortableDec(ratio)
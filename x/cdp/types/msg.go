@@ -16,6 +16,9 @@ var (
 	_ sdk.Msg = &MsgWithdraw{}
 	_ sdk.Msg = &MsgDrawDebt{}
 	_ sdk.Msg = &MsgRepayDebt{}
+	_ sdk.Msg = &MsgLiquidate{}
+	_ sdk.Msg = &MsgDepositSavings{}
+	_ sdk.Msg = &MsgWithdrawSavings{}
 )
 
 // MsgCreateCDP creates a cdp
@@ -314,3 +317,218 @@ func (msg MsgRepayDebt) String() string {
 	Payment: %s
 `, msg.Sender, msg.CollateralType, msg.Payment)
 }
+
+// MsgLiquidate attempts to liquidate a borrower's cdp
+type MsgLiquidate struct {
+	Keeper         sdk.AccAddress `json:"keeper" yaml:"keeper"`
+	Borrower       sdk.AccAddress `json:"borrower" yaml:"borrower"`
+	CollateralType string         `json:"collateral_type" yaml:"collateral_type"`
+}
+
+// NewMsgLiquidate returns a new MsgLiquidate
+func NewMsgLiquidate(keeper, borrower sdk.AccAddress, ctype string) MsgLiquidate {
+	return MsgLiquidate{
+		Keeper:         keeper,
+		Borrower:       borrower,
+		CollateralType: ctype,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgLiquidate) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgLiquidate) Type() string { return "liquidate" }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgLiquidate) ValidateBasic() error {
+	if msg.Keeper.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "keeper address cannot be empty")
+	}
+	if msg.Borrower.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "borrower address cannot be empty")
+	}
+	if strings.TrimSpace(msg.CollateralType) == "" {
+		return errors.New("cdp collateral type cannot be blank")
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgLiquidate) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgLiquidate) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Keeper}
+}
+
+// String implements the Stringer interface
+func (msg MsgLiquidate) String() string {
+	return fmt.Sprintf(`Liquidate CDP Message:
+	Keeper:         %s
+	Borrower: %s
+	Collateral Type: %s
+`, msg.Keeper, msg.Borrower, msg.CollateralType)
+}
+
+// MsgTransferCDP moves a cdp to a new owner
+type MsgTransferCDP struct {
+	Owner          sdk.AccAddress `json:"owner" yaml:"owner"`
+	Recipient      sdk.AccAddress `json:"recipient" yaml:"recipient"`
+	CollateralType string         `json:"collateral_type" yaml:"collateral_type"`
+}
+
+// NewMsgTransferCDP returns a new MsgTransferCDP
+func NewMsgTransferCDP(owner, recipient sdk.AccAddress, ctype string) MsgTransferCDP {
+	return MsgTransferCDP{
+		Owner:          owner,
+		Recipient:      recipient,
+		CollateralType: ctype,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgTransferCDP) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgTransferCDP) Type() string { return "transfer_cdp" }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgTransferCDP) ValidateBasic() error {
+	if msg.Owner.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "owner address cannot be empty")
+	}
+	if msg.Recipient.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "recipient address cannot be empty")
+	}
+	if msg.Owner.Equals(msg.Recipient) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "recipient cannot be the cdp owner")
+	}
+	if strings.TrimSpace(msg.CollateralType) == "" {
+		return errors.New("cdp collateral type cannot be blank")
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgTransferCDP) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgTransferCDP) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// String implements the Stringer interface
+func (msg MsgTransferCDP) String() string {
+	return fmt.Sprintf(`Transfer CDP Message:
+	Owner:           %s
+	Recipient:       %s
+	Collateral Type: %s
+`, msg.Owner, msg.Recipient, msg.CollateralType)
+}
+
+// MsgDepositSavings deposits debt coins into the savings vault in exchange for shares
+type MsgDepositSavings struct {
+	Depositor sdk.AccAddress `json:"depositor" yaml:"depositor"`
+	Amount    sdk.Coin       `json:"amount" yaml:"amount"`
+}
+
+// NewMsgDepositSavings returns a new MsgDepositSavings
+func NewMsgDepositSavings(depositor sdk.AccAddress, amount sdk.Coin) MsgDepositSavings {
+	return MsgDepositSavings{
+		Depositor: depositor,
+		Amount:    amount,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgDepositSavings) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgDepositSavings) Type() string { return "deposit_savings" }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgDepositSavings) ValidateBasic() error {
+	if msg.Depositor.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "depositor address cannot be empty")
+	}
+	if msg.Amount.IsZero() || !msg.Amount.IsValid() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "savings deposit amount %s", msg.Amount)
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgDepositSavings) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgDepositSavings) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Depositor}
+}
+
+// String implements the Stringer interface
+func (msg MsgDepositSavings) String() string {
+	return fmt.Sprintf(`Deposit Savings Message:
+	Depositor: %s
+	Amount:    %s
+`, msg.Depositor, msg.Amount)
+}
+
+// MsgWithdrawSavings withdraws debt coins from the savings vault, redeeming the shares they are worth
+type MsgWithdrawSavings struct {
+	Depositor sdk.AccAddress `json:"depositor" yaml:"depositor"`
+	Amount    sdk.Coin       `json:"amount" yaml:"amount"`
+}
+
+// NewMsgWithdrawSavings returns a new MsgWithdrawSavings
+func NewMsgWithdrawSavings(depositor sdk.AccAddress, amount sdk.Coin) MsgWithdrawSavings {
+	return MsgWithdrawSavings{
+		Depositor: depositor,
+		Amount:    amount,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgWithdrawSavings) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgWithdrawSavings) Type() string { return "withdraw_savings" }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgWithdrawSavings) ValidateBasic() error {
+	if msg.Depositor.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "depositor address cannot be empty")
+	}
+	if msg.Amount.IsZero() || !msg.Amount.IsValid() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "savings withdrawal amount %s", msg.Amount)
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgWithdrawSavings) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgWithdrawSavings) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Depositor}
+}
+
+// String implements the Stringer interface
+func (msg MsgWithdrawSavings) String() string {
+	return fmt.Sprintf(`Withdraw Savings Message:
+	Depositor: %s
+	Amount:    %s
+`, msg.Depositor, msg.Amount)
+}
@@ -167,3 +167,107 @@ func TestMsgRepayDebt(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgLiquidate(t *testing.T) {
+	tests := []struct {
+		description    string
+		keeper         sdk.AccAddress
+		borrower       sdk.AccAddress
+		collateralType string
+		expectPass     bool
+	}{
+		{"liquidate", addrs[0], addrs[1], "xrp-a", true},
+		{"liquidate own cdp", addrs[0], addrs[0], "xrp-a", true},
+		{"liquidate empty keeper", sdk.AccAddress{}, addrs[1], "xrp-a", false},
+		{"liquidate empty borrower", addrs[0], sdk.AccAddress{}, "xrp-a", false},
+		{"liquidate empty collateral type", addrs[0], addrs[1], "", false},
+	}
+
+	for _, tc := range tests {
+		msg := NewMsgLiquidate(
+			tc.keeper,
+			tc.borrower,
+			tc.collateralType,
+		)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", tc.description)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", tc.description)
+		}
+	}
+}
+
+func TestMsgTransferCDP(t *testing.T) {
+	tests := []struct {
+		description    string
+		owner          sdk.AccAddress
+		recipient      sdk.AccAddress
+		collateralType string
+		expectPass     bool
+	}{
+		{"transfer", addrs[0], addrs[1], "xrp-a", true},
+		{"transfer to owner", addrs[0], addrs[0], "xrp-a", false},
+		{"transfer empty owner", sdk.AccAddress{}, addrs[1], "xrp-a", false},
+		{"transfer empty recipient", addrs[0], sdk.AccAddress{}, "xrp-a", false},
+		{"transfer empty collateral type", addrs[0], addrs[1], "", false},
+	}
+
+	for _, tc := range tests {
+		msg := NewMsgTransferCDP(
+			tc.owner,
+			tc.recipient,
+			tc.collateralType,
+		)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", tc.description)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", tc.description)
+		}
+	}
+}
+
+func TestMsgDepositSavings(t *testing.T) {
+	tests := []struct {
+		description string
+		depositor   sdk.AccAddress
+		amount      sdk.Coin
+		expectPass  bool
+	}{
+		{"deposit", addrs[0], sdk.NewInt64Coin("usdx", 1000), true},
+		{"deposit empty depositor", sdk.AccAddress{}, sdk.NewInt64Coin("usdx", 1000), false},
+		{"deposit zero amount", addrs[0], sdk.NewInt64Coin("usdx", 0), false},
+		{"deposit invalid denom", addrs[0], sdk.Coin{Denom: "", Amount: sdk.NewInt(1000)}, false},
+	}
+
+	for _, tc := range tests {
+		msg := NewMsgDepositSavings(tc.depositor, tc.amount)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", tc.description)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", tc.description)
+		}
+	}
+}
+
+func TestMsgWithdrawSavings(t *testing.T) {
+	tests := []struct {
+		description string
+		depositor   sdk.AccAddress
+		amount      sdk.Coin
+		expectPass  bool
+	}{
+		{"withdraw", addrs[0], sdk.NewInt64Coin("usdx", 1000), true},
+		{"withdraw empty depositor", sdk.AccAddress{}, sdk.NewInt64Coin("usdx", 1000), false},
+		{"withdraw zero amount", addrs[0], sdk.NewInt64Coin("usdx", 0), false},
+		{"withdraw negative amount", addrs[0], sdk.Coin{Denom: "usdx", Amount: sdk.NewInt(-1)}, false},
+	}
+
+	for _, tc := range tests {
+		msg := NewMsgWithdrawSavings(tc.depositor, tc.amount)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", tc.description)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", tc.description)
+		}
+	}
+}
@@ -16,24 +16,26 @@ import (
 var (
 	KeyGlobalDebtLimit        = []byte("GlobalDebtLimit")
 	KeyCollateralParams       = []byte("CollateralParams")
-	KeyDebtParam              = []byte("DebtParam")
+	KeyDebtParams             = []byte("DebtParams")
+	KeyDebtParam              = []byte("DebtParam") // single debt param key used before v0.12, read by the legacy committee permissions
 	KeyDistributionFrequency  = []byte("DistributionFrequency")
 	KeyCircuitBreaker         = []byte("CircuitBreaker")
-	KeyDebtThreshold          = []byte("DebtThreshold")
-	KeyDebtLot                = []byte("DebtLot")
-	KeySurplusThreshold       = []byte("SurplusThreshold")
-	KeySurplusLot             = []byte("SurplusLot")
 	KeySavingsRateDistributed = []byte("SavingsRateDistributed")
-	DefaultGlobalDebt         = sdk.NewCoin(DefaultStableDenom, sdk.ZeroInt())
+	DefaultGlobalDebt         = sdk.NewCoins()
 	DefaultCircuitBreaker     = false
 	DefaultCollateralParams   = CollateralParams{}
 	DefaultDebtParam          = DebtParam{
-		Denom:            "usdx",
-		ReferenceAsset:   "usd",
-		ConversionFactor: sdk.NewInt(6),
-		DebtFloor:        sdk.NewInt(10000000),
-		SavingsRate:      sdk.MustNewDecFromStr("0.95"),
-	}
+		Denom:                   "usdx",
+		ReferenceAsset:          "usd",
+		ConversionFactor:        sdk.NewInt(6),
+		DebtFloor:               sdk.NewInt(10000000),
+		SavingsRate:             sdk.MustNewDecFromStr("0.95"),
+		SurplusAuctionThreshold: DefaultSurplusThreshold,
+		SurplusAuctionLot:       DefaultSurplusLot,
+		DebtAuctionThreshold:    DefaultDebtThreshold,
+		DebtAuctionLot:          DefaultDebtLot,
+	}
+	DefaultDebtParams                   = DebtParams{DefaultDebtParam}
 	DefaultCdpStartingID                = uint64(1)
 	DefaultDebtDenom                    = "debt"
 	DefaultGovDenom                     = "ukava"
@@ -44,7 +46,7 @@ var (
 	DefaultDebtLot                      = sdk.NewInt(10000000000)
 	DefaultPreviousDistributionTime     = tmtime.Canonical(time.Unix(0, 0))
 	DefaultSavingsDistributionFrequency = time.Hour * 12
-	DefaultSavingsRateDistributed       = sdk.NewInt(0)
+	DefaultSavingsRateDistributed       = sdk.NewCoins()
 	minCollateralPrefix                 = 0
 	maxCollateralPrefix                 = 255
 	stabilityFeeMax                     = sdk.MustNewDecFromStr("1.000000051034942716") // 500% APR
@@ -53,12 +55,8 @@ var (
 // Params governance parameters for cdp module
 type Params struct {
 	CollateralParams             CollateralParams `json:"collateral_params" yaml:"collateral_params"`
-	DebtParam                    DebtParam        `json:"debt_param" yaml:"debt_param"`
-	GlobalDebtLimit              sdk.Coin         `json:"global_debt_limit" yaml:"global_debt_limit"`
-	SurplusAuctionThreshold      sdk.Int          `json:"surplus_auction_threshold" yaml:"surplus_auction_threshold"`
-	SurplusAuctionLot            sdk.Int          `json:"surplus_auction_lot" yaml:"surplus_auction_lot"`
-	DebtAuctionThreshold         sdk.Int          `json:"debt_auction_threshold" yaml:"debt_auction_threshold"`
-	DebtAuctionLot               sdk.Int          `json:"debt_auction_lot" yaml:"debt_auction_lot"`
+	DebtParams                   DebtParams       `json:"debt_params" yaml:"debt_params"`
+	GlobalDebtLimit              sdk.Coins        `json:"global_debt_limit" yaml:"global_debt_limit"` // Maximum amount of each debt asset that can be drawn across all collateral types
 	SavingsDistributionFrequency time.Duration    `json:"savings_distribution_frequency" yaml:"savings_distribution_frequency"`
 	CircuitBreaker               bool             `json:"circuit_breaker" yaml:"circuit_breaker"`
 }
@@ -69,30 +67,21 @@ func (p Params) String() string {
 	Global Debt Limit: %s
 	Collateral Params: %s
 	Debt Params: %s
-	Surplus Auction Threshold: %s
-	Surplus Auction Lot: %s
-	Debt Auction Threshold: %s
-	Debt Auction Lot: %s
 	Savings Distribution Frequency: %s
 	Circuit Breaker: %t`,
-		p.GlobalDebtLimit, p.CollateralParams, p.DebtParam, p.SurplusAuctionThreshold, p.SurplusAuctionLot,
-		p.DebtAuctionThreshold, p.DebtAuctionLot, p.SavingsDistributionFrequency, p.CircuitBreaker,
+		p.GlobalDebtLimit, p.CollateralParams, p.DebtParams, p.SavingsDistributionFrequency, p.CircuitBreaker,
 	)
 }
 
 // NewParams returns a new params object
 func NewParams(
-	debtLimit sdk.Coin, collateralParams CollateralParams, debtParam DebtParam, surplusThreshold,
-	surplusLot, debtThreshold, debtLot sdk.Int, distributionFreq time.Duration, breaker bool,
+	debtLimit sdk.Coins, collateralParams CollateralParams, debtParams DebtParams,
+	distributionFreq time.Duration, breaker bool,
 ) Params {
 	return Params{
 		GlobalDebtLimit:              debtLimit,
 		CollateralParams:             collateralParams,
-		DebtParam:                    debtParam,
-		SurplusAuctionThreshold:      surplusThreshold,
-		SurplusAuctionLot:            surplusLot,
-		DebtAuctionThreshold:         debtThreshold,
-		DebtAuctionLot:               debtLot,
+		DebtParams:                   debtParams,
 		SavingsDistributionFrequency: distributionFreq,
 		CircuitBreaker:               breaker,
 	}
@@ -101,41 +90,50 @@ func NewParams(
 // DefaultParams returns default params for cdp module
 func DefaultParams() Params {
 	return NewParams(
-		DefaultGlobalDebt, DefaultCollateralParams, DefaultDebtParam, DefaultSurplusThreshold,
-		DefaultSurplusLot, DefaultDebtThreshold, DefaultDebtLot, DefaultSavingsDistributionFrequency,
-		DefaultCircuitBreaker,
+		DefaultGlobalDebt, DefaultCollateralParams, DefaultDebtParams,
+		DefaultSavingsDistributionFrequency, DefaultCircuitBreaker,
 	)
 }
 
 // CollateralParam governance parameters for each collateral type within the cdp module
 type CollateralParam struct {
-	Denom               string   `json:"denom" yaml:"denom"` // Coin name of collateral type
-	Type                string   `json:"type" yaml:"type"`
-	LiquidationRatio    sdk.Dec  `json:"liquidation_ratio" yaml:"liquidation_ratio"`     // The ratio (Collateral (priced in stable coin) / Debt) under which a CDP will be liquidated
-	DebtLimit           sdk.Coin `json:"debt_limit" yaml:"debt_limit"`                   // Maximum amount of debt allowed to be drawn from this collateral type
-	StabilityFee        sdk.Dec  `json:"stability_fee" yaml:"stability_fee"`             // per second stability fee for loans opened using this collateral
-	AuctionSize         sdk.Int  `json:"auction_size" yaml:"auction_size"`               // Max amount of collateral to sell off in any one auction.
-	LiquidationPenalty  sdk.Dec  `json:"liquidation_penalty" yaml:"liquidation_penalty"` // percentage penalty (between [0, 1]) applied to a cdp if it is liquidated
-	Prefix              byte     `json:"prefix" yaml:"prefix"`
-	SpotMarketID        string   `json:"spot_market_id" yaml:"spot_market_id"`               // marketID of the spot price of the asset from the pricefeed - used for opening CDPs, depositing, withdrawing
-	LiquidationMarketID string   `json:"liquidation_market_id" yaml:"liquidation_market_id"` // marketID of the pricefeed used for liquidation
-	ConversionFactor    sdk.Int  `json:"conversion_factor" yaml:"conversion_factor"`         // factor for converting internal units to one base unit of collateral
+	Denom                  string        `json:"denom" yaml:"denom"` // Coin name of collateral type
+	Type                   string        `json:"type" yaml:"type"`
+	LiquidationRatio       sdk.Dec       `json:"liquidation_ratio" yaml:"liquidation_ratio"`     // The ratio (Collateral (priced in stable coin) / Debt) under which a CDP will be liquidated
+	DebtLimit              sdk.Coin      `json:"debt_limit" yaml:"debt_limit"`                   // Maximum amount of debt allowed to be drawn from this collateral type, the denom is the debt asset drawn against it
+	StabilityFee           sdk.Dec       `json:"stability_fee" yaml:"stability_fee"`             // per second stability fee for loans opened using this collateral
+	AuctionSize            sdk.Int       `json:"auction_size" yaml:"auction_size"`               // Max amount of collateral to sell off in any one auction.
+	LiquidationPenalty     sdk.Dec       `json:"liquidation_penalty" yaml:"liquidation_penalty"` // percentage penalty (between [0, 1]) applied to a cdp if it is liquidated
+	Prefix                 byte          `json:"prefix" yaml:"prefix"`
+	SpotMarketID           string        `json:"spot_market_id" yaml:"spot_market_id"`                     // marketID of the spot price of the asset from the pricefeed - used for opening CDPs, depositing, withdrawing
+	LiquidationMarketID    string        `json:"liquidation_market_id" yaml:"liquidation_market_id"`       // marketID of the pricefeed used for liquidation
+	ConversionFactor       sdk.Int       `json:"conversion_factor" yaml:"conversion_factor"`               // factor for converting internal units to one base unit of collateral
+	CloseFactor            sdk.Dec       `json:"close_factor" yaml:"close_factor"`                         // maximum fraction (between (0, 1]) of a cdp's debt that is liquidated at once, zero disables partial liquidation
+	TargetRatio            sdk.Dec       `json:"target_ratio" yaml:"target_ratio"`                         // the ratio a partially liquidated cdp is brought back up to
+	KeeperRewardPercentage sdk.Dec       `json:"keeper_reward_percentage" yaml:"keeper_reward_percentage"` // percentage (between [0, 1]) of the seized collateral paid to the keeper that submitted the liquidation
+	DutchAuction           bool          `json:"dutch_auction" yaml:"dutch_auction"`                       // sell seized collateral in dutch (descending price) auctions rather than forward/reverse auctions
+	LiquidationTWAPWindow  time.Duration `json:"liquidation_twap_window" yaml:"liquidation_twap_window"`   // window of the time weighted average liquidation market price used for liquidation, zero uses the current liquidation market price
 }
 
 // NewCollateralParam returns a new CollateralParam
-func NewCollateralParam(denom, ctype string, liqRatio sdk.Dec, debtLimit sdk.Coin, stabilityFee sdk.Dec, auctionSize sdk.Int, liqPenalty sdk.Dec, prefix byte, spotMarketID, liquidationMarketID string, conversionFactor sdk.Int) CollateralParam {
+func NewCollateralParam(denom, ctype string, liqRatio sdk.Dec, debtLimit sdk.Coin, stabilityFee sdk.Dec, auctionSize sdk.Int, liqPenalty sdk.Dec, prefix byte, spotMarketID, liquidationMarketID string, conversionFactor sdk.Int, closeFactor, targetRatio, keeperReward sdk.Dec, dutchAuction bool, liquidationTWAPWindow time.Duration) CollateralParam {
 	return CollateralParam{
-		Denom:               denom,
-		Type:                ctype,
-		LiquidationRatio:    liqRatio,
-		DebtLimit:           debtLimit,
-		StabilityFee:        stabilityFee,
-		AuctionSize:         auctionSize,
-		LiquidationPenalty:  liqPenalty,
-		Prefix:              prefix,
-		SpotMarketID:        spotMarketID,
-		LiquidationMarketID: liquidationMarketID,
-		ConversionFactor:    conversionFactor,
+		Denom:                  denom,
+		Type:                   ctype,
+		LiquidationRatio:       liqRatio,
+		DebtLimit:              debtLimit,
+		StabilityFee:           stabilityFee,
+		AuctionSize:            auctionSize,
+		LiquidationPenalty:     liqPenalty,
+		Prefix:                 prefix,
+		SpotMarketID:           spotMarketID,
+		LiquidationMarketID:    liquidationMarketID,
+		ConversionFactor:       conversionFactor,
+		CloseFactor:            closeFactor,
+		TargetRatio:            targetRatio,
+		KeeperRewardPercentage: keeperReward,
+		DutchAuction:           dutchAuction,
+		LiquidationTWAPWindow:  liquidationTWAPWindow,
 	}
 }
 
@@ -152,8 +150,18 @@ func (cp CollateralParam) String() string {
 	Prefix: %b
 	Spot Market ID: %s
 	Liquidation Market ID: %s
-	Conversion Factor: %s`,
-		cp.Denom, cp.Type, cp.LiquidationRatio, cp.StabilityFee, cp.LiquidationPenalty, cp.DebtLimit, cp.AuctionSize, cp.Prefix, cp.SpotMarketID, cp.LiquidationMarketID, cp.ConversionFactor)
+	Conversion Factor: %s
+	Close Factor: %s
+	Target Ratio: %s
+	Keeper Reward Percentage: %s
+	Dutch Auction: %t
+	Liquidation TWAP Window: %s`,
+		cp.Denom, cp.Type, cp.LiquidationRatio, cp.StabilityFee, cp.LiquidationPenalty, cp.DebtLimit, cp.AuctionSize, cp.Prefix, cp.SpotMarketID, cp.LiquidationMarketID, cp.ConversionFactor, cp.CloseFactor, cp.TargetRatio, cp.KeeperRewardPercentage, cp.DutchAuction, cp.LiquidationTWAPWindow)
+}
+
+// PartialLiquidationEnabled returns true if cdps of this collateral type are partially liquidated
+func (cp CollateralParam) PartialLiquidationEnabled() bool {
+	return !cp.CloseFactor.IsNil() && cp.CloseFactor.IsPositive()
 }
 
 // CollateralParams array of CollateralParam
@@ -170,21 +178,30 @@ func (cps CollateralParams) String() string {
 
 // DebtParam governance params for debt assets
 type DebtParam struct {
-	Denom            string  `json:"denom" yaml:"denom"`
-	ReferenceAsset   string  `json:"reference_asset" yaml:"reference_asset"`
-	ConversionFactor sdk.Int `json:"conversion_factor" yaml:"conversion_factor"`
-	DebtFloor        sdk.Int `json:"debt_floor" yaml:"debt_floor"`     // minimum active loan size, used to prevent dust
-	SavingsRate      sdk.Dec `json:"savings_rate" yaml:"savings_rate"` // the percentage of stability fees that are redirected to savings rate
+	Denom                   string  `json:"denom" yaml:"denom"`
+	ReferenceAsset          string  `json:"reference_asset" yaml:"reference_asset"`
+	ConversionFactor        sdk.Int `json:"conversion_factor" yaml:"conversion_factor"`
+	DebtFloor               sdk.Int `json:"debt_floor" yaml:"debt_floor"`                               // minimum active loan size, used to prevent dust
+	SavingsRate             sdk.Dec `json:"savings_rate" yaml:"savings_rate"`                           // the percentage of stability fees that are redirected to savings rate
+	SurplusAuctionThreshold sdk.Int `json:"surplus_auction_threshold" yaml:"surplus_auction_threshold"` // surplus of this asset above which a surplus auction is started
+	SurplusAuctionLot       sdk.Int `json:"surplus_auction_lot" yaml:"surplus_auction_lot"`             // amount of this asset sold in each surplus auction
+	DebtAuctionThreshold    sdk.Int `json:"debt_auction_threshold" yaml:"debt_auction_threshold"`       // bad debt in this asset above which a debt auction is started
+	DebtAuctionLot          sdk.Int `json:"debt_auction_lot" yaml:"debt_auction_lot"`                   // amount of bad debt in this asset covered by each debt auction
 }
 
 // NewDebtParam returns a new DebtParam
-func NewDebtParam(denom, refAsset string, conversionFactor, debtFloor sdk.Int, savingsRate sdk.Dec) DebtParam {
+func NewDebtParam(denom, refAsset string, conversionFactor, debtFloor sdk.Int, savingsRate sdk.Dec,
+	surplusThreshold, surplusLot, debtThreshold, debtLot sdk.Int) DebtParam {
 	return DebtParam{
-		Denom:            denom,
-		ReferenceAsset:   refAsset,
-		ConversionFactor: conversionFactor,
-		DebtFloor:        debtFloor,
-		SavingsRate:      savingsRate,
+		Denom:                   denom,
+		ReferenceAsset:          refAsset,
+		ConversionFactor:        conversionFactor,
+		DebtFloor:               debtFloor,
+		SavingsRate:             savingsRate,
+		SurplusAuctionThreshold: surplusThreshold,
+		SurplusAuctionLot:       surplusLot,
+		DebtAuctionThreshold:    debtThreshold,
+		DebtAuctionLot:          debtLot,
 	}
 }
 
@@ -195,7 +212,12 @@ func (dp DebtParam) String() string {
 	Conversion Factor: %s
 	Debt Floor %s
 	Savings  Rate %s
-	`, dp.Denom, dp.ReferenceAsset, dp.ConversionFactor, dp.DebtFloor, dp.SavingsRate)
+	Surplus Auction Threshold: %s
+	Surplus Auction Lot: %s
+	Debt Auction Threshold: %s
+	Debt Auction Lot: %s
+	`, dp.Denom, dp.ReferenceAsset, dp.ConversionFactor, dp.DebtFloor, dp.SavingsRate,
+		dp.SurplusAuctionThreshold, dp.SurplusAuctionLot, dp.DebtAuctionThreshold, dp.DebtAuctionLot)
 }
 
 // DebtParams array of DebtParam
@@ -210,6 +232,11 @@ func (dps DebtParams) String() string {
 	return out
 }
 
+// DebtCoinDenom returns the denom of the internal debt coins that track the debt drawn in the input debt asset
+func DebtCoinDenom(debtDenom, principalDenom string) string {
+	return debtDenom + principalDenom
+}
+
 // ParamKeyTable Key declaration for parameters
 func ParamKeyTable() params.KeyTable {
 	return params.NewKeyTable().RegisterParamSet(&Params{})
@@ -222,12 +249,8 @@ func (p *Params) ParamSetPairs() params.ParamSetPairs {
 	return params.ParamSetPairs{
 		params.NewParamSetPair(KeyGlobalDebtLimit, &p.GlobalDebtLimit, validateGlobalDebtLimitParam),
 		params.NewParamSetPair(KeyCollateralParams, &p.CollateralParams, validateCollateralParams),
-		params.NewParamSetPair(KeyDebtParam, &p.DebtParam, validateDebtParam),
+		params.NewParamSetPair(KeyDebtParams, &p.DebtParams, validateDebtParams),
 		params.NewParamSetPair(KeyCircuitBreaker, &p.CircuitBreaker, validateCircuitBreakerParam),
-		params.NewParamSetPair(KeySurplusThreshold, &p.SurplusAuctionThreshold, validateSurplusAuctionThresholdParam),
-		params.NewParamSetPair(KeySurplusLot, &p.SurplusAuctionLot, validateSurplusAuctionLotParam),
-		params.NewParamSetPair(KeyDebtThreshold, &p.DebtAuctionThreshold, validateDebtAuctionThresholdParam),
-		params.NewParamSetPair(KeyDebtLot, &p.DebtAuctionLot, validateDebtAuctionLotParam),
 		params.NewParamSetPair(KeyDistributionFrequency, &p.SavingsDistributionFrequency, validateSavingsDistributionFrequencyParam),
 	}
 }
@@ -242,7 +265,7 @@ func (p Params) Validate() error {
 		return err
 	}
 
-	if err := validateDebtParam(p.DebtParam); err != nil {
+	if err := validateDebtParams(p.DebtParams); err != nil {
 		return err
 	}
 
@@ -250,22 +273,6 @@ func (p Params) Validate() error {
 		return err
 	}
 
-	if err := validateSurplusAuctionThresholdParam(p.SurplusAuctionThreshold); err != nil {
-		return err
-	}
-
-	if err := validateSurplusAuctionLotParam(p.SurplusAuctionLot); err != nil {
-		return err
-	}
-
-	if err := validateDebtAuctionThresholdParam(p.DebtAuctionThreshold); err != nil {
-		return err
-	}
-
-	if err := validateDebtAuctionLotParam(p.DebtAuctionLot); err != nil {
-		return err
-	}
-
 	if err := validateSavingsDistributionFrequencyParam(p.SavingsDistributionFrequency); err != nil {
 		return err
 	}
@@ -274,46 +281,45 @@ func (p Params) Validate() error {
 		return nil
 	}
 
-	if (DebtParam{}) != p.DebtParam {
-		if p.DebtParam.Denom != p.GlobalDebtLimit.Denom {
-			return fmt.Errorf("debt denom %s does not match global debt denom %s",
-				p.DebtParam.Denom, p.GlobalDebtLimit.Denom)
+	debtDenoms := make(map[string]bool)
+	for _, dp := range p.DebtParams {
+		debtDenoms[dp.Denom] = true
+	}
+
+	for _, gdl := range p.GlobalDebtLimit {
+		if !debtDenoms[gdl.Denom] {
+			return fmt.Errorf("global debt limit denom %s does not match any debt param denom", gdl.Denom)
 		}
 	}
 
 	// validate collateral params
-	collateralDupMap := make(map[string]int)
-	prefixDupMap := make(map[int]int)
-	collateralParamsDebtLimit := sdk.ZeroInt()
+	collateralParamsDebtLimit := sdk.NewCoins()
 
 	for _, cp := range p.CollateralParams {
 
-		prefix := int(cp.Prefix)
-		prefixDupMap[prefix] = 1
-		collateralDupMap[cp.Denom] = 1
-
-		if cp.DebtLimit.Denom != p.GlobalDebtLimit.Denom {
-			return fmt.Errorf("collateral debt limit denom %s does not match global debt limit denom %s",
-				cp.DebtLimit.Denom, p.GlobalDebtLimit.Denom)
+		if !debtDenoms[cp.DebtLimit.Denom] {
+			return fmt.Errorf("collateral debt limit denom %s does not match any debt param denom", cp.DebtLimit.Denom)
 		}
 
-		collateralParamsDebtLimit = collateralParamsDebtLimit.Add(cp.DebtLimit.Amount)
+		collateralParamsDebtLimit = collateralParamsDebtLimit.Add(cp.DebtLimit)
 
-		if cp.DebtLimit.Amount.GT(p.GlobalDebtLimit.Amount) {
+		if cp.DebtLimit.Amount.GT(p.GlobalDebtLimit.AmountOf(cp.DebtLimit.Denom)) {
 			return fmt.Errorf("collateral debt limit %s exceeds global debt limit: %s", cp.DebtLimit, p.GlobalDebtLimit)
 		}
 	}
 
-	if collateralParamsDebtLimit.GT(p.GlobalDebtLimit.Amount) {
-		return fmt.Errorf("sum of collateral debt limits %s exceeds global debt limit %s",
-			collateralParamsDebtLimit, p.GlobalDebtLimit)
+	for _, limit := range collateralParamsDebtLimit {
+		if limit.Amount.GT(p.GlobalDebtLimit.AmountOf(limit.Denom)) {
+			return fmt.Errorf("sum of collateral debt limits %s exceeds global debt limit %s",
+				limit, p.GlobalDebtLimit)
+		}
 	}
 
 	return nil
 }
 
 func validateGlobalDebtLimitParam(i interface{}) error {
-	globalDebtLimit, ok := i.(sdk.Coin)
+	globalDebtLimit, ok := i.(sdk.Coins)
 	if !ok {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
@@ -381,22 +387,66 @@ func validateCollateralParams(i interface{}) error {
 		if cp.StabilityFee.LT(sdk.OneDec()) || cp.StabilityFee.GT(stabilityFeeMax) {
 			return fmt.Errorf("stability fee must be ≥ 1.0, ≤ %s, is %s for %s", stabilityFeeMax, cp.StabilityFee, cp.Denom)
 		}
+		if !cp.CloseFactor.IsNil() && (cp.CloseFactor.IsNegative() || cp.CloseFactor.GT(sdk.OneDec())) {
+			return fmt.Errorf("close factor should be between 0 and 1, is %s for %s", cp.CloseFactor, cp.Denom)
+		}
+		if !cp.KeeperRewardPercentage.IsNil() && (cp.KeeperRewardPercentage.IsNegative() || cp.KeeperRewardPercentage.GTE(sdk.OneDec())) {
+			return fmt.Errorf("keeper reward percentage should be at least 0 and less than 1, is %s for %s", cp.KeeperRewardPercentage, cp.Denom)
+		}
+		if cp.LiquidationTWAPWindow < 0 {
+			return fmt.Errorf("liquidation twap window should not be negative, is %s for %s", cp.LiquidationTWAPWindow, cp.Denom)
+		}
+		if cp.PartialLiquidationEnabled() {
+			if cp.TargetRatio.IsNil() || cp.TargetRatio.LTE(cp.LiquidationRatio) {
+				return fmt.Errorf("target ratio must be greater than liquidation ratio %s, is %s for %s", cp.LiquidationRatio, cp.TargetRatio, cp.Denom)
+			}
+			// each unit of debt liquidated removes (1 + penalty) worth of collateral, so a target ratio at or below
+			// that can never be reached by selling collateral
+			if cp.TargetRatio.LTE(sdk.OneDec().Add(cp.LiquidationPenalty)) {
+				return fmt.Errorf("target ratio must be greater than 1 + liquidation penalty, is %s for %s", cp.TargetRatio, cp.Denom)
+			}
+		}
 	}
 
 	return nil
 }
 
-func validateDebtParam(i interface{}) error {
-	debtParam, ok := i.(DebtParam)
+func validateDebtParams(i interface{}) error {
+	debtParams, ok := i.(DebtParams)
 	if !ok {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
-	if err := sdk.ValidateDenom(debtParam.Denom); err != nil {
-		return fmt.Errorf("debt denom invalid %s", debtParam.Denom)
-	}
 
-	if debtParam.SavingsRate.LT(sdk.ZeroDec()) || debtParam.SavingsRate.GT(sdk.OneDec()) {
-		return fmt.Errorf("savings rate should be between 0 and 1, is %s for %s", debtParam.SavingsRate, debtParam.Denom)
+	denomDupMap := make(map[string]bool)
+	for _, dp := range debtParams {
+		if err := sdk.ValidateDenom(dp.Denom); err != nil {
+			return fmt.Errorf("debt denom invalid %s", dp.Denom)
+		}
+
+		if denomDupMap[dp.Denom] {
+			return fmt.Errorf("duplicate debt denom: %s", dp.Denom)
+		}
+		denomDupMap[dp.Denom] = true
+
+		if dp.SavingsRate.LT(sdk.ZeroDec()) || dp.SavingsRate.GT(sdk.OneDec()) {
+			return fmt.Errorf("savings rate should be between 0 and 1, is %s for %s", dp.SavingsRate, dp.Denom)
+		}
+
+		if err := validateSurplusAuctionThresholdParam(dp.SurplusAuctionThreshold); err != nil {
+			return fmt.Errorf("%s for %s", err, dp.Denom)
+		}
+
+		if err := validateSurplusAuctionLotParam(dp.SurplusAuctionLot); err != nil {
+			return fmt.Errorf("%s for %s", err, dp.Denom)
+		}
+
+		if err := validateDebtAuctionThresholdParam(dp.DebtAuctionThreshold); err != nil {
+			return fmt.Errorf("%s for %s", err, dp.Denom)
+		}
+
+		if err := validateDebtAuctionLotParam(dp.DebtAuctionLot); err != nil {
+			return fmt.Errorf("%s for %s", err, dp.Denom)
+		}
 	}
 	return nil
 }
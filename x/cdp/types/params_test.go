@@ -21,13 +21,9 @@ func (suite *ParamsTestSuite) SetupTest() {
 
 func (suite *ParamsTestSuite) TestParamValidation() {
 	type args struct {
-		globalDebtLimit  sdk.Coin
+		globalDebtLimit  sdk.Coins
 		collateralParams types.CollateralParams
-		debtParam        types.DebtParam
-		surplusThreshold sdk.Int
-		surplusLot       sdk.Int
-		debtThreshold    sdk.Int
-		debtLot          sdk.Int
+		debtParams       types.DebtParams
 		distributionFreq time.Duration
 		breaker          bool
 	}
@@ -46,11 +42,7 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 			args: args{
 				globalDebtLimit:  types.DefaultGlobalDebt,
 				collateralParams: types.DefaultCollateralParams,
-				debtParam:        types.DefaultDebtParam,
-				surplusThreshold: types.DefaultSurplusThreshold,
-				surplusLot:       types.DefaultSurplusLot,
-				debtThreshold:    types.DefaultDebtThreshold,
-				debtLot:          types.DefaultDebtLot,
+				debtParams:       types.DefaultDebtParams,
 				distributionFreq: types.DefaultSavingsDistributionFrequency,
 				breaker:          types.DefaultCircuitBreaker,
 			},
@@ -62,7 +54,7 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 		{
 			name: "valid single-collateral",
 			args: args{
-				globalDebtLimit: sdk.NewInt64Coin("usdx", 4000000000000),
+				globalDebtLimit: sdk.NewCoins(sdk.NewInt64Coin("usdx", 4000000000000)),
 				collateralParams: types.CollateralParams{
 					{
 						Denom:               "bnb",
@@ -78,17 +70,19 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 						ConversionFactor:    sdk.NewInt(8),
 					},
 				},
-				debtParam: types.DebtParam{
-					Denom:            "usdx",
-					ReferenceAsset:   "usd",
-					ConversionFactor: sdk.NewInt(6),
-					DebtFloor:        sdk.NewInt(10000000),
-					SavingsRate:      sdk.MustNewDecFromStr("0.95"),
+				debtParams: types.DebtParams{
+					{
+						Denom:                   "usdx",
+						ReferenceAsset:          "usd",
+						ConversionFactor:        sdk.NewInt(6),
+						DebtFloor:               sdk.NewInt(10000000),
+						SavingsRate:             sdk.MustNewDecFromStr("0.95"),
+						SurplusAuctionThreshold: types.DefaultSurplusThreshold,
+						SurplusAuctionLot:       types.DefaultSurplusLot,
+						DebtAuctionThreshold:    types.DefaultDebtThreshold,
+						DebtAuctionLot:          types.DefaultDebtLot,
+					},
 				},
-				surplusThreshold: types.DefaultSurplusThreshold,
-				surplusLot:       types.DefaultSurplusLot,
-				debtThreshold:    types.DefaultDebtThreshold,
-				debtLot:          types.DefaultDebtLot,
 				distributionFreq: types.DefaultSavingsDistributionFrequency,
 				breaker:          types.DefaultCircuitBreaker,
 			},
@@ -100,7 +94,7 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 		{
 			name: "invalid single-collateral mismatched debt denoms",
 			args: args{
-				globalDebtLimit: sdk.NewInt64Coin("usdx", 4000000000000),
+				globalDebtLimit: sdk.NewCoins(sdk.NewInt64Coin("usdx", 4000000000000)),
 				collateralParams: types.CollateralParams{
 					{
 						Denom:               "bnb",
@@ -116,29 +110,31 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 						ConversionFactor:    sdk.NewInt(8),
 					},
 				},
-				debtParam: types.DebtParam{
-					Denom:            "susd",
-					ReferenceAsset:   "usd",
-					ConversionFactor: sdk.NewInt(6),
-					DebtFloor:        sdk.NewInt(10000000),
-					SavingsRate:      sdk.MustNewDecFromStr("0.95"),
+				debtParams: types.DebtParams{
+					{
+						Denom:                   "susd",
+						ReferenceAsset:          "usd",
+						ConversionFactor:        sdk.NewInt(6),
+						DebtFloor:               sdk.NewInt(10000000),
+						SavingsRate:             sdk.MustNewDecFromStr("0.95"),
+						SurplusAuctionThreshold: types.DefaultSurplusThreshold,
+						SurplusAuctionLot:       types.DefaultSurplusLot,
+						DebtAuctionThreshold:    types.DefaultDebtThreshold,
+						DebtAuctionLot:          types.DefaultDebtLot,
+					},
 				},
-				surplusThreshold: types.DefaultSurplusThreshold,
-				surplusLot:       types.DefaultSurplusLot,
-				debtThreshold:    types.DefaultDebtThreshold,
-				debtLot:          types.DefaultDebtLot,
 				distributionFreq: types.DefaultSavingsDistributionFrequency,
 				breaker:          types.DefaultCircuitBreaker,
 			},
 			errArgs: errArgs{
 				expectPass: false,
-				contains:   "does not match global debt denom",
+				contains:   "does not match any debt param denom",
 			},
 		},
 		{
 			name: "invalid single-collateral over debt limit",
 			args: args{
-				globalDebtLimit: sdk.NewInt64Coin("usdx", 1000000000000),
+				globalDebtLimit: sdk.NewCoins(sdk.NewInt64Coin("usdx", 1000000000000)),
 				collateralParams: types.CollateralParams{
 					{
 						Denom:               "bnb",
@@ -154,17 +150,19 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 						ConversionFactor:    sdk.NewInt(8),
 					},
 				},
-				debtParam: types.DebtParam{
-					Denom:            "usdx",
-					ReferenceAsset:   "usd",
-					ConversionFactor: sdk.NewInt(6),
-					DebtFloor:        sdk.NewInt(10000000),
-					SavingsRate:      sdk.MustNewDecFromStr("0.95"),
+				debtParams: types.DebtParams{
+					{
+						Denom:                   "usdx",
+						ReferenceAsset:          "usd",
+						ConversionFactor:        sdk.NewInt(6),
+						DebtFloor:               sdk.NewInt(10000000),
+						SavingsRate:             sdk.MustNewDecFromStr("0.95"),
+						SurplusAuctionThreshold: types.DefaultSurplusThreshold,
+						SurplusAuctionLot:       types.DefaultSurplusLot,
+						DebtAuctionThreshold:    types.DefaultDebtThreshold,
+						DebtAuctionLot:          types.DefaultDebtLot,
+					},
 				},
-				surplusThreshold: types.DefaultSurplusThreshold,
-				surplusLot:       types.DefaultSurplusLot,
-				debtThreshold:    types.DefaultDebtThreshold,
-				debtLot:          types.DefaultDebtLot,
 				distributionFreq: types.DefaultSavingsDistributionFrequency,
 				breaker:          types.DefaultCircuitBreaker,
 			},
@@ -176,7 +174,7 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 		{
 			name: "valid multi-collateral",
 			args: args{
-				globalDebtLimit: sdk.NewInt64Coin("usdx", 4000000000000),
+				globalDebtLimit: sdk.NewCoins(sdk.NewInt64Coin("usdx", 4000000000000)),
 				collateralParams: types.CollateralParams{
 					{
 						Denom:               "bnb",
@@ -205,17 +203,19 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 						ConversionFactor:    sdk.NewInt(6),
 					},
 				},
-				debtParam: types.DebtParam{
-					Denom:            "usdx",
-					ReferenceAsset:   "usd",
-					ConversionFactor: sdk.NewInt(6),
-					DebtFloor:        sdk.NewInt(10000000),
-					SavingsRate:      sdk.MustNewDecFromStr("0.95"),
+				debtParams: types.DebtParams{
+					{
+						Denom:                   "usdx",
+						ReferenceAsset:          "usd",
+						ConversionFactor:        sdk.NewInt(6),
+						DebtFloor:               sdk.NewInt(10000000),
+						SavingsRate:             sdk.MustNewDecFromStr("0.95"),
+						SurplusAuctionThreshold: types.DefaultSurplusThreshold,
+						SurplusAuctionLot:       types.DefaultSurplusLot,
+						DebtAuctionThreshold:    types.DefaultDebtThreshold,
+						DebtAuctionLot:          types.DefaultDebtLot,
+					},
 				},
-				surplusThreshold: types.DefaultSurplusThreshold,
-				surplusLot:       types.DefaultSurplusLot,
-				debtThreshold:    types.DefaultDebtThreshold,
-				debtLot:          types.DefaultDebtLot,
 				distributionFreq: types.DefaultSavingsDistributionFrequency,
 				breaker:          types.DefaultCircuitBreaker,
 			},
@@ -227,7 +227,7 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 		{
 			name: "invalid multi-collateral over debt limit",
 			args: args{
-				globalDebtLimit: sdk.NewInt64Coin("usdx", 2000000000000),
+				globalDebtLimit: sdk.NewCoins(sdk.NewInt64Coin("usdx", 2000000000000)),
 				collateralParams: types.CollateralParams{
 					{
 						Denom:               "bnb",
@@ -256,17 +256,19 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 						ConversionFactor:    sdk.NewInt(6),
 					},
 				},
-				debtParam: types.DebtParam{
-					Denom:            "usdx",
-					ReferenceAsset:   "usd",
-					ConversionFactor: sdk.NewInt(6),
-					DebtFloor:        sdk.NewInt(10000000),
-					SavingsRate:      sdk.MustNewDecFromStr("0.95"),
+				debtParams: types.DebtParams{
+					{
+						Denom:                   "usdx",
+						ReferenceAsset:          "usd",
+						ConversionFactor:        sdk.NewInt(6),
+						DebtFloor:               sdk.NewInt(10000000),
+						SavingsRate:             sdk.MustNewDecFromStr("0.95"),
+						SurplusAuctionThreshold: types.DefaultSurplusThreshold,
+						SurplusAuctionLot:       types.DefaultSurplusLot,
+						DebtAuctionThreshold:    types.DefaultDebtThreshold,
+						DebtAuctionLot:          types.DefaultDebtLot,
+					},
 				},
-				surplusThreshold: types.DefaultSurplusThreshold,
-				surplusLot:       types.DefaultSurplusLot,
-				debtThreshold:    types.DefaultDebtThreshold,
-				debtLot:          types.DefaultDebtLot,
 				distributionFreq: types.DefaultSavingsDistributionFrequency,
 				breaker:          types.DefaultCircuitBreaker,
 			},
@@ -278,7 +280,7 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 		{
 			name: "invalid multi-collateral multiple debt denoms",
 			args: args{
-				globalDebtLimit: sdk.NewInt64Coin("usdx", 4000000000000),
+				globalDebtLimit: sdk.NewCoins(sdk.NewInt64Coin("usdx", 4000000000000)),
 				collateralParams: types.CollateralParams{
 					{
 						Denom:               "bnb",
@@ -307,29 +309,210 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 						ConversionFactor:    sdk.NewInt(6),
 					},
 				},
-				debtParam: types.DebtParam{
-					Denom:            "usdx",
-					ReferenceAsset:   "usd",
-					ConversionFactor: sdk.NewInt(6),
-					DebtFloor:        sdk.NewInt(10000000),
-					SavingsRate:      sdk.MustNewDecFromStr("0.95"),
+				debtParams: types.DebtParams{
+					{
+						Denom:                   "usdx",
+						ReferenceAsset:          "usd",
+						ConversionFactor:        sdk.NewInt(6),
+						DebtFloor:               sdk.NewInt(10000000),
+						SavingsRate:             sdk.MustNewDecFromStr("0.95"),
+						SurplusAuctionThreshold: types.DefaultSurplusThreshold,
+						SurplusAuctionLot:       types.DefaultSurplusLot,
+						DebtAuctionThreshold:    types.DefaultDebtThreshold,
+						DebtAuctionLot:          types.DefaultDebtLot,
+					},
+				},
+				distributionFreq: types.DefaultSavingsDistributionFrequency,
+				breaker:          types.DefaultCircuitBreaker,
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "does not match any debt param denom",
+			},
+		},
+		{
+			name: "valid multi-debt",
+			args: args{
+				globalDebtLimit: sdk.NewCoins(sdk.NewInt64Coin("usdx", 4000000000000), sdk.NewInt64Coin("eurx", 1000000000000)),
+				collateralParams: types.CollateralParams{
+					{
+						Denom:               "bnb",
+						Type:                "bnb-a",
+						LiquidationRatio:    sdk.MustNewDecFromStr("1.5"),
+						DebtLimit:           sdk.NewInt64Coin("usdx", 2000000000000),
+						StabilityFee:        sdk.MustNewDecFromStr("1.000000001547125958"),
+						LiquidationPenalty:  sdk.MustNewDecFromStr("0.05"),
+						AuctionSize:         sdk.NewInt(50000000000),
+						Prefix:              0x20,
+						SpotMarketID:        "bnb:usd",
+						LiquidationMarketID: "bnb:usd",
+						ConversionFactor:    sdk.NewInt(8),
+					},
+					{
+						Denom:               "bnb",
+						Type:                "bnb-b",
+						LiquidationRatio:    sdk.MustNewDecFromStr("1.5"),
+						DebtLimit:           sdk.NewInt64Coin("eurx", 1000000000000),
+						StabilityFee:        sdk.MustNewDecFromStr("1.000000001547125958"),
+						LiquidationPenalty:  sdk.MustNewDecFromStr("0.05"),
+						AuctionSize:         sdk.NewInt(50000000000),
+						Prefix:              0x21,
+						SpotMarketID:        "bnb:eur",
+						LiquidationMarketID: "bnb:eur",
+						ConversionFactor:    sdk.NewInt(8),
+					},
+				},
+				debtParams: types.DebtParams{
+					{
+						Denom:                   "usdx",
+						ReferenceAsset:          "usd",
+						ConversionFactor:        sdk.NewInt(6),
+						DebtFloor:               sdk.NewInt(10000000),
+						SavingsRate:             sdk.MustNewDecFromStr("0.95"),
+						SurplusAuctionThreshold: types.DefaultSurplusThreshold,
+						SurplusAuctionLot:       types.DefaultSurplusLot,
+						DebtAuctionThreshold:    types.DefaultDebtThreshold,
+						DebtAuctionLot:          types.DefaultDebtLot,
+					},
+					{
+						Denom:                   "eurx",
+						ReferenceAsset:          "eur",
+						ConversionFactor:        sdk.NewInt(6),
+						DebtFloor:               sdk.NewInt(10000000),
+						SavingsRate:             sdk.MustNewDecFromStr("0.95"),
+						SurplusAuctionThreshold: types.DefaultSurplusThreshold,
+						SurplusAuctionLot:       types.DefaultSurplusLot,
+						DebtAuctionThreshold:    types.DefaultDebtThreshold,
+						DebtAuctionLot:          types.DefaultDebtLot,
+					},
+				},
+				distributionFreq: types.DefaultSavingsDistributionFrequency,
+				breaker:          types.DefaultCircuitBreaker,
+			},
+			errArgs: errArgs{
+				expectPass: true,
+				contains:   "",
+			},
+		},
+		{
+			name: "invalid multi-debt over debt limit",
+			args: args{
+				globalDebtLimit: sdk.NewCoins(sdk.NewInt64Coin("usdx", 4000000000000), sdk.NewInt64Coin("eurx", 1000000000000)),
+				collateralParams: types.CollateralParams{
+					{
+						Denom:               "bnb",
+						Type:                "bnb-a",
+						LiquidationRatio:    sdk.MustNewDecFromStr("1.5"),
+						DebtLimit:           sdk.NewInt64Coin("usdx", 2000000000000),
+						StabilityFee:        sdk.MustNewDecFromStr("1.000000001547125958"),
+						LiquidationPenalty:  sdk.MustNewDecFromStr("0.05"),
+						AuctionSize:         sdk.NewInt(50000000000),
+						Prefix:              0x20,
+						SpotMarketID:        "bnb:usd",
+						LiquidationMarketID: "bnb:usd",
+						ConversionFactor:    sdk.NewInt(8),
+					},
+					{
+						Denom:               "bnb",
+						Type:                "bnb-b",
+						LiquidationRatio:    sdk.MustNewDecFromStr("1.5"),
+						DebtLimit:           sdk.NewInt64Coin("eurx", 2000000000000),
+						StabilityFee:        sdk.MustNewDecFromStr("1.000000001547125958"),
+						LiquidationPenalty:  sdk.MustNewDecFromStr("0.05"),
+						AuctionSize:         sdk.NewInt(50000000000),
+						Prefix:              0x21,
+						SpotMarketID:        "bnb:eur",
+						LiquidationMarketID: "bnb:eur",
+						ConversionFactor:    sdk.NewInt(8),
+					},
+				},
+				debtParams: types.DebtParams{
+					{
+						Denom:                   "usdx",
+						ReferenceAsset:          "usd",
+						ConversionFactor:        sdk.NewInt(6),
+						DebtFloor:               sdk.NewInt(10000000),
+						SavingsRate:             sdk.MustNewDecFromStr("0.95"),
+						SurplusAuctionThreshold: types.DefaultSurplusThreshold,
+						SurplusAuctionLot:       types.DefaultSurplusLot,
+						DebtAuctionThreshold:    types.DefaultDebtThreshold,
+						DebtAuctionLot:          types.DefaultDebtLot,
+					},
+					{
+						Denom:                   "eurx",
+						ReferenceAsset:          "eur",
+						ConversionFactor:        sdk.NewInt(6),
+						DebtFloor:               sdk.NewInt(10000000),
+						SavingsRate:             sdk.MustNewDecFromStr("0.95"),
+						SurplusAuctionThreshold: types.DefaultSurplusThreshold,
+						SurplusAuctionLot:       types.DefaultSurplusLot,
+						DebtAuctionThreshold:    types.DefaultDebtThreshold,
+						DebtAuctionLot:          types.DefaultDebtLot,
+					},
+				},
+				distributionFreq: types.DefaultSavingsDistributionFrequency,
+				breaker:          types.DefaultCircuitBreaker,
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "exceeds global debt limit",
+			},
+		},
+		{
+			name: "invalid debt params duplicate denom",
+			args: args{
+				globalDebtLimit: sdk.NewCoins(sdk.NewInt64Coin("usdx", 4000000000000)),
+				collateralParams: types.CollateralParams{
+					{
+						Denom:               "bnb",
+						Type:                "bnb-a",
+						LiquidationRatio:    sdk.MustNewDecFromStr("1.5"),
+						DebtLimit:           sdk.NewInt64Coin("usdx", 2000000000000),
+						StabilityFee:        sdk.MustNewDecFromStr("1.000000001547125958"),
+						LiquidationPenalty:  sdk.MustNewDecFromStr("0.05"),
+						AuctionSize:         sdk.NewInt(50000000000),
+						Prefix:              0x20,
+						SpotMarketID:        "bnb:usd",
+						LiquidationMarketID: "bnb:usd",
+						ConversionFactor:    sdk.NewInt(8),
+					},
+				},
+				debtParams: types.DebtParams{
+					{
+						Denom:                   "usdx",
+						ReferenceAsset:          "usd",
+						ConversionFactor:        sdk.NewInt(6),
+						DebtFloor:               sdk.NewInt(10000000),
+						SavingsRate:             sdk.MustNewDecFromStr("0.95"),
+						SurplusAuctionThreshold: types.DefaultSurplusThreshold,
+						SurplusAuctionLot:       types.DefaultSurplusLot,
+						DebtAuctionThreshold:    types.DefaultDebtThreshold,
+						DebtAuctionLot:          types.DefaultDebtLot,
+					},
+					{
+						Denom:                   "usdx",
+						ReferenceAsset:          "usd",
+						ConversionFactor:        sdk.NewInt(6),
+						DebtFloor:               sdk.NewInt(10000000),
+						SavingsRate:             sdk.MustNewDecFromStr("0.95"),
+						SurplusAuctionThreshold: types.DefaultSurplusThreshold,
+						SurplusAuctionLot:       types.DefaultSurplusLot,
+						DebtAuctionThreshold:    types.DefaultDebtThreshold,
+						DebtAuctionLot:          types.DefaultDebtLot,
+					},
 				},
-				surplusThreshold: types.DefaultSurplusThreshold,
-				surplusLot:       types.DefaultSurplusLot,
-				debtThreshold:    types.DefaultDebtThreshold,
-				debtLot:          types.DefaultDebtLot,
 				distributionFreq: types.DefaultSavingsDistributionFrequency,
 				breaker:          types.DefaultCircuitBreaker,
 			},
 			errArgs: errArgs{
 				expectPass: false,
-				contains:   "does not match global debt limit denom",
+				contains:   "duplicate debt denom",
 			},
 		},
 		{
 			name: "invalid collateral params empty denom",
 			args: args{
-				globalDebtLimit: sdk.NewInt64Coin("usdx", 2000000000000),
+				globalDebtLimit: sdk.NewCoins(sdk.NewInt64Coin("usdx", 2000000000000)),
 				collateralParams: types.CollateralParams{
 					{
 						Denom:               "",
@@ -344,17 +527,19 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 						ConversionFactor:    sdk.NewInt(8),
 					},
 				},
-				debtParam: types.DebtParam{
-					Denom:            "usdx",
-					ReferenceAsset:   "usd",
-					ConversionFactor: sdk.NewInt(6),
-					DebtFloor:        sdk.NewInt(10000000),
-					SavingsRate:      sdk.MustNewDecFromStr("0.95"),
+				debtParams: types.DebtParams{
+					{
+						Denom:                   "usdx",
+						ReferenceAsset:          "usd",
+						ConversionFactor:        sdk.NewInt(6),
+						DebtFloor:               sdk.NewInt(10000000),
+						SavingsRate:             sdk.MustNewDecFromStr("0.95"),
+						SurplusAuctionThreshold: types.DefaultSurplusThreshold,
+						SurplusAuctionLot:       types.DefaultSurplusLot,
+						DebtAuctionThreshold:    types.DefaultDebtThreshold,
+						DebtAuctionLot:          types.DefaultDebtLot,
+					},
 				},
-				surplusThreshold: types.DefaultSurplusThreshold,
-				surplusLot:       types.DefaultSurplusLot,
-				debtThreshold:    types.DefaultDebtThreshold,
-				debtLot:          types.DefaultDebtLot,
 				distributionFreq: types.DefaultSavingsDistributionFrequency,
 				breaker:          types.DefaultCircuitBreaker,
 			},
@@ -366,7 +551,7 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 		{
 			name: "invalid collateral params empty market id",
 			args: args{
-				globalDebtLimit: sdk.NewInt64Coin("usdx", 2000000000000),
+				globalDebtLimit: sdk.NewCoins(sdk.NewInt64Coin("usdx", 2000000000000)),
 				collateralParams: types.CollateralParams{
 					{
 						Denom:               "bnb",
@@ -382,17 +567,19 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 						ConversionFactor:    sdk.NewInt(8),
 					},
 				},
-				debtParam: types.DebtParam{
-					Denom:            "usdx",
-					ReferenceAsset:   "usd",
-					ConversionFactor: sdk.NewInt(6),
-					DebtFloor:        sdk.NewInt(10000000),
-					SavingsRate:      sdk.MustNewDecFromStr("0.95"),
+				debtParams: types.DebtParams{
+					{
+						Denom:                   "usdx",
+						ReferenceAsset:          "usd",
+						ConversionFactor:        sdk.NewInt(6),
+						DebtFloor:               sdk.NewInt(10000000),
+						SavingsRate:             sdk.MustNewDecFromStr("0.95"),
+						SurplusAuctionThreshold: types.DefaultSurplusThreshold,
+						SurplusAuctionLot:       types.DefaultSurplusLot,
+						DebtAuctionThreshold:    types.DefaultDebtThreshold,
+						DebtAuctionLot:          types.DefaultDebtLot,
+					},
 				},
-				surplusThreshold: types.DefaultSurplusThreshold,
-				surplusLot:       types.DefaultSurplusLot,
-				debtThreshold:    types.DefaultDebtThreshold,
-				debtLot:          types.DefaultDebtLot,
 				distributionFreq: types.DefaultSavingsDistributionFrequency,
 				breaker:          types.DefaultCircuitBreaker,
 			},
@@ -404,7 +591,7 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 		{
 			name: "invalid collateral params duplicate denom + type",
 			args: args{
-				globalDebtLimit: sdk.NewInt64Coin("usdx", 2000000000000),
+				globalDebtLimit: sdk.NewCoins(sdk.NewInt64Coin("usdx", 2000000000000)),
 				collateralParams: types.CollateralParams{
 					{
 						Denom:               "bnb",
@@ -433,17 +620,19 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 						ConversionFactor:    sdk.NewInt(8),
 					},
 				},
-				debtParam: types.DebtParam{
-					Denom:            "usdx",
-					ReferenceAsset:   "usd",
-					ConversionFactor: sdk.NewInt(6),
-					DebtFloor:        sdk.NewInt(10000000),
-					SavingsRate:      sdk.MustNewDecFromStr("0.95"),
+				debtParams: types.DebtParams{
+					{
+						Denom:                   "usdx",
+						ReferenceAsset:          "usd",
+						ConversionFactor:        sdk.NewInt(6),
+						DebtFloor:               sdk.NewInt(10000000),
+						SavingsRate:             sdk.MustNewDecFromStr("0.95"),
+						SurplusAuctionThreshold: types.DefaultSurplusThreshold,
+						SurplusAuctionLot:       types.DefaultSurplusLot,
+						DebtAuctionThreshold:    types.DefaultDebtThreshold,
+						DebtAuctionLot:          types.DefaultDebtLot,
+					},
 				},
-				surplusThreshold: types.DefaultSurplusThreshold,
-				surplusLot:       types.DefaultSurplusLot,
-				debtThreshold:    types.DefaultDebtThreshold,
-				debtLot:          types.DefaultDebtLot,
 				distributionFreq: types.DefaultSavingsDistributionFrequency,
 				breaker:          types.DefaultCircuitBreaker,
 			},
@@ -455,7 +644,7 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 		{
 			name: "valid collateral params duplicate denom + different type",
 			args: args{
-				globalDebtLimit: sdk.NewInt64Coin("usdx", 2000000000000),
+				globalDebtLimit: sdk.NewCoins(sdk.NewInt64Coin("usdx", 2000000000000)),
 				collateralParams: types.CollateralParams{
 					{
 						Denom:               "bnb",
@@ -484,17 +673,19 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 						ConversionFactor:    sdk.NewInt(8),
 					},
 				},
-				debtParam: types.DebtParam{
-					Denom:            "usdx",
-					ReferenceAsset:   "usd",
-					ConversionFactor: sdk.NewInt(6),
-					DebtFloor:        sdk.NewInt(10000000),
-					SavingsRate:      sdk.MustNewDecFromStr("0.95"),
+				debtParams: types.DebtParams{
+					{
+						Denom:                   "usdx",
+						ReferenceAsset:          "usd",
+						ConversionFactor:        sdk.NewInt(6),
+						DebtFloor:               sdk.NewInt(10000000),
+						SavingsRate:             sdk.MustNewDecFromStr("0.95"),
+						SurplusAuctionThreshold: types.DefaultSurplusThreshold,
+						SurplusAuctionLot:       types.DefaultSurplusLot,
+						DebtAuctionThreshold:    types.DefaultDebtThreshold,
+						DebtAuctionLot:          types.DefaultDebtLot,
+					},
 				},
-				surplusThreshold: types.DefaultSurplusThreshold,
-				surplusLot:       types.DefaultSurplusLot,
-				debtThreshold:    types.DefaultDebtThreshold,
-				debtLot:          types.DefaultDebtLot,
 				distributionFreq: types.DefaultSavingsDistributionFrequency,
 				breaker:          types.DefaultCircuitBreaker,
 			},
@@ -506,7 +697,7 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 		{
 			name: "invalid collateral params duplicate prefix",
 			args: args{
-				globalDebtLimit: sdk.NewInt64Coin("usdx", 2000000000000),
+				globalDebtLimit: sdk.NewCoins(sdk.NewInt64Coin("usdx", 2000000000000)),
 				collateralParams: types.CollateralParams{
 					{
 						Denom:               "bnb",
@@ -535,17 +726,19 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 						ConversionFactor:    sdk.NewInt(8),
 					},
 				},
-				debtParam: types.DebtParam{
-					Denom:            "usdx",
-					ReferenceAsset:   "usd",
-					ConversionFactor: sdk.NewInt(6),
-					DebtFloor:        sdk.NewInt(10000000),
-					SavingsRate:      sdk.MustNewDecFromStr("0.95"),
+				debtParams: types.DebtParams{
+					{
+						Denom:                   "usdx",
+						ReferenceAsset:          "usd",
+						ConversionFactor:        sdk.NewInt(6),
+						DebtFloor:               sdk.NewInt(10000000),
+						SavingsRate:             sdk.MustNewDecFromStr("0.95"),
+						SurplusAuctionThreshold: types.DefaultSurplusThreshold,
+						SurplusAuctionLot:       types.DefaultSurplusLot,
+						DebtAuctionThreshold:    types.DefaultDebtThreshold,
+						DebtAuctionLot:          types.DefaultDebtLot,
+					},
 				},
-				surplusThreshold: types.DefaultSurplusThreshold,
-				surplusLot:       types.DefaultSurplusLot,
-				debtThreshold:    types.DefaultDebtThreshold,
-				debtLot:          types.DefaultDebtLot,
 				distributionFreq: types.DefaultSavingsDistributionFrequency,
 				breaker:          types.DefaultCircuitBreaker,
 			},
@@ -557,7 +750,7 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 		{
 			name: "invalid collateral params nil debt limit",
 			args: args{
-				globalDebtLimit: sdk.NewInt64Coin("usdx", 2000000000000),
+				globalDebtLimit: sdk.NewCoins(sdk.NewInt64Coin("usdx", 2000000000000)),
 				collateralParams: types.CollateralParams{
 					{
 						Denom:               "bnb",
@@ -573,17 +766,19 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 						ConversionFactor:    sdk.NewInt(8),
 					},
 				},
-				debtParam: types.DebtParam{
-					Denom:            "usdx",
-					ReferenceAsset:   "usd",
-					ConversionFactor: sdk.NewInt(6),
-					DebtFloor:        sdk.NewInt(10000000),
-					SavingsRate:      sdk.MustNewDecFromStr("0.95"),
+				debtParams: types.DebtParams{
+					{
+						Denom:                   "usdx",
+						ReferenceAsset:          "usd",
+						ConversionFactor:        sdk.NewInt(6),
+						DebtFloor:               sdk.NewInt(10000000),
+						SavingsRate:             sdk.MustNewDecFromStr("0.95"),
+						SurplusAuctionThreshold: types.DefaultSurplusThreshold,
+						SurplusAuctionLot:       types.DefaultSurplusLot,
+						DebtAuctionThreshold:    types.DefaultDebtThreshold,
+						DebtAuctionLot:          types.DefaultDebtLot,
+					},
 				},
-				surplusThreshold: types.DefaultSurplusThreshold,
-				surplusLot:       types.DefaultSurplusLot,
-				debtThreshold:    types.DefaultDebtThreshold,
-				debtLot:          types.DefaultDebtLot,
 				distributionFreq: types.DefaultSavingsDistributionFrequency,
 				breaker:          types.DefaultCircuitBreaker,
 			},
@@ -595,7 +790,7 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 		{
 			name: "invalid collateral params liquidation ratio out of range",
 			args: args{
-				globalDebtLimit: sdk.NewInt64Coin("usdx", 2000000000000),
+				globalDebtLimit: sdk.NewCoins(sdk.NewInt64Coin("usdx", 2000000000000)),
 				collateralParams: types.CollateralParams{
 					{
 						Denom:               "bnb",
@@ -611,17 +806,19 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 						ConversionFactor:    sdk.NewInt(8),
 					},
 				},
-				debtParam: types.DebtParam{
-					Denom:            "usdx",
-					ReferenceAsset:   "usd",
-					ConversionFactor: sdk.NewInt(6),
-					DebtFloor:        sdk.NewInt(10000000),
-					SavingsRate:      sdk.MustNewDecFromStr("0.95"),
+				debtParams: types.DebtParams{
+					{
+						Denom:                   "usdx",
+						ReferenceAsset:          "usd",
+						ConversionFactor:        sdk.NewInt(6),
+						DebtFloor:               sdk.NewInt(10000000),
+						SavingsRate:             sdk.MustNewDecFromStr("0.95"),
+						SurplusAuctionThreshold: types.DefaultSurplusThreshold,
+						SurplusAuctionLot:       types.DefaultSurplusLot,
+						DebtAuctionThreshold:    types.DefaultDebtThreshold,
+						DebtAuctionLot:          types.DefaultDebtLot,
+					},
 				},
-				surplusThreshold: types.DefaultSurplusThreshold,
-				surplusLot:       types.DefaultSurplusLot,
-				debtThreshold:    types.DefaultDebtThreshold,
-				debtLot:          types.DefaultDebtLot,
 				distributionFreq: types.DefaultSavingsDistributionFrequency,
 				breaker:          types.DefaultCircuitBreaker,
 			},
@@ -633,7 +830,7 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 		{
 			name: "invalid collateral params auction size zero",
 			args: args{
-				globalDebtLimit: sdk.NewInt64Coin("usdx", 2000000000000),
+				globalDebtLimit: sdk.NewCoins(sdk.NewInt64Coin("usdx", 2000000000000)),
 				collateralParams: types.CollateralParams{
 					{
 						Denom:               "bnb",
@@ -649,17 +846,19 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 						ConversionFactor:    sdk.NewInt(8),
 					},
 				},
-				debtParam: types.DebtParam{
-					Denom:            "usdx",
-					ReferenceAsset:   "usd",
-					ConversionFactor: sdk.NewInt(6),
-					DebtFloor:        sdk.NewInt(10000000),
-					SavingsRate:      sdk.MustNewDecFromStr("0.95"),
+				debtParams: types.DebtParams{
+					{
+						Denom:                   "usdx",
+						ReferenceAsset:          "usd",
+						ConversionFactor:        sdk.NewInt(6),
+						DebtFloor:               sdk.NewInt(10000000),
+						SavingsRate:             sdk.MustNewDecFromStr("0.95"),
+						SurplusAuctionThreshold: types.DefaultSurplusThreshold,
+						SurplusAuctionLot:       types.DefaultSurplusLot,
+						DebtAuctionThreshold:    types.DefaultDebtThreshold,
+						DebtAuctionLot:          types.DefaultDebtLot,
+					},
 				},
-				surplusThreshold: types.DefaultSurplusThreshold,
-				surplusLot:       types.DefaultSurplusLot,
-				debtThreshold:    types.DefaultDebtThreshold,
-				debtLot:          types.DefaultDebtLot,
 				distributionFreq: types.DefaultSavingsDistributionFrequency,
 				breaker:          types.DefaultCircuitBreaker,
 			},
@@ -671,7 +870,7 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 		{
 			name: "invalid collateral params stability fee out of range",
 			args: args{
-				globalDebtLimit: sdk.NewInt64Coin("usdx", 2000000000000),
+				globalDebtLimit: sdk.NewCoins(sdk.NewInt64Coin("usdx", 2000000000000)),
 				collateralParams: types.CollateralParams{
 					{
 						Denom:               "bnb",
@@ -687,17 +886,19 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 						ConversionFactor:    sdk.NewInt(8),
 					},
 				},
-				debtParam: types.DebtParam{
-					Denom:            "usdx",
-					ReferenceAsset:   "usd",
-					ConversionFactor: sdk.NewInt(6),
-					DebtFloor:        sdk.NewInt(10000000),
-					SavingsRate:      sdk.MustNewDecFromStr("0.95"),
+				debtParams: types.DebtParams{
+					{
+						Denom:                   "usdx",
+						ReferenceAsset:          "usd",
+						ConversionFactor:        sdk.NewInt(6),
+						DebtFloor:               sdk.NewInt(10000000),
+						SavingsRate:             sdk.MustNewDecFromStr("0.95"),
+						SurplusAuctionThreshold: types.DefaultSurplusThreshold,
+						SurplusAuctionLot:       types.DefaultSurplusLot,
+						DebtAuctionThreshold:    types.DefaultDebtThreshold,
+						DebtAuctionLot:          types.DefaultDebtLot,
+					},
 				},
-				surplusThreshold: types.DefaultSurplusThreshold,
-				surplusLot:       types.DefaultSurplusLot,
-				debtThreshold:    types.DefaultDebtThreshold,
-				debtLot:          types.DefaultDebtLot,
 				distributionFreq: types.DefaultSavingsDistributionFrequency,
 				breaker:          types.DefaultCircuitBreaker,
 			},
@@ -706,10 +907,305 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 				contains:   "stability fee must be ≥ 1.0",
 			},
 		},
+		{
+			name: "valid collateral params partial liquidation",
+			args: args{
+				globalDebtLimit: sdk.NewCoins(sdk.NewInt64Coin("usdx", 2000000000000)),
+				collateralParams: types.CollateralParams{
+					{
+						Denom:               "bnb",
+						Type:                "bnb-a",
+						LiquidationRatio:    sdk.MustNewDecFromStr("1.5"),
+						DebtLimit:           sdk.NewInt64Coin("usdx", 1000000000000),
+						StabilityFee:        sdk.MustNewDecFromStr("1.000000001547125958"),
+						LiquidationPenalty:  sdk.MustNewDecFromStr("0.05"),
+						AuctionSize:         sdk.NewInt(50000000000),
+						Prefix:              0x20,
+						SpotMarketID:        "bnb:usd",
+						LiquidationMarketID: "bnb:usd",
+						ConversionFactor:    sdk.NewInt(8),
+						CloseFactor:         sdk.MustNewDecFromStr("0.5"),
+						TargetRatio:         sdk.MustNewDecFromStr("1.75"),
+					},
+				},
+				debtParams: types.DebtParams{
+					{
+						Denom:                   "usdx",
+						ReferenceAsset:          "usd",
+						ConversionFactor:        sdk.NewInt(6),
+						DebtFloor:               sdk.NewInt(10000000),
+						SavingsRate:             sdk.MustNewDecFromStr("0.95"),
+						SurplusAuctionThreshold: types.DefaultSurplusThreshold,
+						SurplusAuctionLot:       types.DefaultSurplusLot,
+						DebtAuctionThreshold:    types.DefaultDebtThreshold,
+						DebtAuctionLot:          types.DefaultDebtLot,
+					},
+				},
+				distributionFreq: types.DefaultSavingsDistributionFrequency,
+				breaker:          types.DefaultCircuitBreaker,
+			},
+			errArgs: errArgs{
+				expectPass: true,
+				contains:   "",
+			},
+		},
+		{
+			name: "invalid collateral params keeper reward out of range",
+			args: args{
+				globalDebtLimit: sdk.NewCoins(sdk.NewInt64Coin("usdx", 2000000000000)),
+				collateralParams: types.CollateralParams{
+					{
+						Denom:                  "bnb",
+						Type:                   "bnb-a",
+						LiquidationRatio:       sdk.MustNewDecFromStr("1.5"),
+						DebtLimit:              sdk.NewInt64Coin("usdx", 1000000000000),
+						StabilityFee:           sdk.MustNewDecFromStr("1.000000001547125958"),
+						LiquidationPenalty:     sdk.MustNewDecFromStr("0.05"),
+						AuctionSize:            sdk.NewInt(50000000000),
+						Prefix:                 0x20,
+						SpotMarketID:           "bnb:usd",
+						LiquidationMarketID:    "bnb:usd",
+						ConversionFactor:       sdk.NewInt(8),
+						CloseFactor:            sdk.MustNewDecFromStr("0.5"),
+						TargetRatio:            sdk.MustNewDecFromStr("1.75"),
+						KeeperRewardPercentage: sdk.MustNewDecFromStr("1.1"),
+					},
+				},
+				debtParams: types.DebtParams{
+					{
+						Denom:                   "usdx",
+						ReferenceAsset:          "usd",
+						ConversionFactor:        sdk.NewInt(6),
+						DebtFloor:               sdk.NewInt(10000000),
+						SavingsRate:             sdk.MustNewDecFromStr("0.95"),
+						SurplusAuctionThreshold: types.DefaultSurplusThreshold,
+						SurplusAuctionLot:       types.DefaultSurplusLot,
+						DebtAuctionThreshold:    types.DefaultDebtThreshold,
+						DebtAuctionLot:          types.DefaultDebtLot,
+					},
+				},
+				distributionFreq: types.DefaultSavingsDistributionFrequency,
+				breaker:          types.DefaultCircuitBreaker,
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "keeper reward percentage should be at least 0 and less than 1",
+			},
+		},
+		{
+			name: "invalid collateral params keeper reward of the whole collateral",
+			args: args{
+				globalDebtLimit: sdk.NewCoins(sdk.NewInt64Coin("usdx", 2000000000000)),
+				collateralParams: types.CollateralParams{
+					{
+						Denom:                  "bnb",
+						Type:                   "bnb-a",
+						LiquidationRatio:       sdk.MustNewDecFromStr("1.5"),
+						DebtLimit:              sdk.NewInt64Coin("usdx", 1000000000000),
+						StabilityFee:           sdk.MustNewDecFromStr("1.000000001547125958"),
+						LiquidationPenalty:     sdk.MustNewDecFromStr("0.05"),
+						AuctionSize:            sdk.NewInt(50000000000),
+						Prefix:                 0x20,
+						SpotMarketID:           "bnb:usd",
+						LiquidationMarketID:    "bnb:usd",
+						ConversionFactor:       sdk.NewInt(8),
+						CloseFactor:            sdk.MustNewDecFromStr("0.5"),
+						TargetRatio:            sdk.MustNewDecFromStr("1.75"),
+						KeeperRewardPercentage: sdk.OneDec(),
+					},
+				},
+				debtParams: types.DebtParams{
+					{
+						Denom:                   "usdx",
+						ReferenceAsset:          "usd",
+						ConversionFactor:        sdk.NewInt(6),
+						DebtFloor:               sdk.NewInt(10000000),
+						SavingsRate:             sdk.MustNewDecFromStr("0.95"),
+						SurplusAuctionThreshold: types.DefaultSurplusThreshold,
+						SurplusAuctionLot:       types.DefaultSurplusLot,
+						DebtAuctionThreshold:    types.DefaultDebtThreshold,
+						DebtAuctionLot:          types.DefaultDebtLot,
+					},
+				},
+				distributionFreq: types.DefaultSavingsDistributionFrequency,
+				breaker:          types.DefaultCircuitBreaker,
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "keeper reward percentage should be at least 0 and less than 1",
+			},
+		},
+		{
+			name: "invalid collateral params negative liquidation twap window",
+			args: args{
+				globalDebtLimit: sdk.NewCoins(sdk.NewInt64Coin("usdx", 2000000000000)),
+				collateralParams: types.CollateralParams{
+					{
+						Denom:                 "bnb",
+						Type:                  "bnb-a",
+						LiquidationRatio:      sdk.MustNewDecFromStr("1.5"),
+						DebtLimit:             sdk.NewInt64Coin("usdx", 1000000000000),
+						StabilityFee:          sdk.MustNewDecFromStr("1.000000001547125958"),
+						LiquidationPenalty:    sdk.MustNewDecFromStr("0.05"),
+						AuctionSize:           sdk.NewInt(50000000000),
+						Prefix:                0x20,
+						SpotMarketID:          "bnb:usd",
+						LiquidationMarketID:   "bnb:usd",
+						ConversionFactor:      sdk.NewInt(8),
+						LiquidationTWAPWindow: -time.Hour,
+					},
+				},
+				debtParams: types.DebtParams{
+					{
+						Denom:                   "usdx",
+						ReferenceAsset:          "usd",
+						ConversionFactor:        sdk.NewInt(6),
+						DebtFloor:               sdk.NewInt(10000000),
+						SavingsRate:             sdk.MustNewDecFromStr("0.95"),
+						SurplusAuctionThreshold: types.DefaultSurplusThreshold,
+						SurplusAuctionLot:       types.DefaultSurplusLot,
+						DebtAuctionThreshold:    types.DefaultDebtThreshold,
+						DebtAuctionLot:          types.DefaultDebtLot,
+					},
+				},
+				distributionFreq: types.DefaultSavingsDistributionFrequency,
+				breaker:          types.DefaultCircuitBreaker,
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "liquidation twap window should not be negative",
+			},
+		},
+		{
+			name: "invalid collateral params close factor out of range",
+			args: args{
+				globalDebtLimit: sdk.NewCoins(sdk.NewInt64Coin("usdx", 2000000000000)),
+				collateralParams: types.CollateralParams{
+					{
+						Denom:               "bnb",
+						Type:                "bnb-a",
+						LiquidationRatio:    sdk.MustNewDecFromStr("1.5"),
+						DebtLimit:           sdk.NewInt64Coin("usdx", 1000000000000),
+						StabilityFee:        sdk.MustNewDecFromStr("1.000000001547125958"),
+						LiquidationPenalty:  sdk.MustNewDecFromStr("0.05"),
+						AuctionSize:         sdk.NewInt(50000000000),
+						Prefix:              0x20,
+						SpotMarketID:        "bnb:usd",
+						LiquidationMarketID: "bnb:usd",
+						ConversionFactor:    sdk.NewInt(8),
+						CloseFactor:         sdk.MustNewDecFromStr("1.5"),
+						TargetRatio:         sdk.MustNewDecFromStr("1.75"),
+					},
+				},
+				debtParams: types.DebtParams{
+					{
+						Denom:                   "usdx",
+						ReferenceAsset:          "usd",
+						ConversionFactor:        sdk.NewInt(6),
+						DebtFloor:               sdk.NewInt(10000000),
+						SavingsRate:             sdk.MustNewDecFromStr("0.95"),
+						SurplusAuctionThreshold: types.DefaultSurplusThreshold,
+						SurplusAuctionLot:       types.DefaultSurplusLot,
+						DebtAuctionThreshold:    types.DefaultDebtThreshold,
+						DebtAuctionLot:          types.DefaultDebtLot,
+					},
+				},
+				distributionFreq: types.DefaultSavingsDistributionFrequency,
+				breaker:          types.DefaultCircuitBreaker,
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "close factor should be between 0 and 1",
+			},
+		},
+		{
+			name: "invalid collateral params target ratio below liquidation ratio",
+			args: args{
+				globalDebtLimit: sdk.NewCoins(sdk.NewInt64Coin("usdx", 2000000000000)),
+				collateralParams: types.CollateralParams{
+					{
+						Denom:               "bnb",
+						Type:                "bnb-a",
+						LiquidationRatio:    sdk.MustNewDecFromStr("1.5"),
+						DebtLimit:           sdk.NewInt64Coin("usdx", 1000000000000),
+						StabilityFee:        sdk.MustNewDecFromStr("1.000000001547125958"),
+						LiquidationPenalty:  sdk.MustNewDecFromStr("0.05"),
+						AuctionSize:         sdk.NewInt(50000000000),
+						Prefix:              0x20,
+						SpotMarketID:        "bnb:usd",
+						LiquidationMarketID: "bnb:usd",
+						ConversionFactor:    sdk.NewInt(8),
+						CloseFactor:         sdk.MustNewDecFromStr("0.5"),
+						TargetRatio:         sdk.MustNewDecFromStr("1.4"),
+					},
+				},
+				debtParams: types.DebtParams{
+					{
+						Denom:                   "usdx",
+						ReferenceAsset:          "usd",
+						ConversionFactor:        sdk.NewInt(6),
+						DebtFloor:               sdk.NewInt(10000000),
+						SavingsRate:             sdk.MustNewDecFromStr("0.95"),
+						SurplusAuctionThreshold: types.DefaultSurplusThreshold,
+						SurplusAuctionLot:       types.DefaultSurplusLot,
+						DebtAuctionThreshold:    types.DefaultDebtThreshold,
+						DebtAuctionLot:          types.DefaultDebtLot,
+					},
+				},
+				distributionFreq: types.DefaultSavingsDistributionFrequency,
+				breaker:          types.DefaultCircuitBreaker,
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "target ratio must be greater than liquidation ratio",
+			},
+		},
+		{
+			name: "invalid collateral params target ratio below penalty",
+			args: args{
+				globalDebtLimit: sdk.NewCoins(sdk.NewInt64Coin("usdx", 2000000000000)),
+				collateralParams: types.CollateralParams{
+					{
+						Denom:               "bnb",
+						Type:                "bnb-a",
+						LiquidationRatio:    sdk.MustNewDecFromStr("1.01"),
+						DebtLimit:           sdk.NewInt64Coin("usdx", 1000000000000),
+						StabilityFee:        sdk.MustNewDecFromStr("1.000000001547125958"),
+						LiquidationPenalty:  sdk.MustNewDecFromStr("0.05"),
+						AuctionSize:         sdk.NewInt(50000000000),
+						Prefix:              0x20,
+						SpotMarketID:        "bnb:usd",
+						LiquidationMarketID: "bnb:usd",
+						ConversionFactor:    sdk.NewInt(8),
+						CloseFactor:         sdk.MustNewDecFromStr("0.5"),
+						TargetRatio:         sdk.MustNewDecFromStr("1.04"),
+					},
+				},
+				debtParams: types.DebtParams{
+					{
+						Denom:                   "usdx",
+						ReferenceAsset:          "usd",
+						ConversionFactor:        sdk.NewInt(6),
+						DebtFloor:               sdk.NewInt(10000000),
+						SavingsRate:             sdk.MustNewDecFromStr("0.95"),
+						SurplusAuctionThreshold: types.DefaultSurplusThreshold,
+						SurplusAuctionLot:       types.DefaultSurplusLot,
+						DebtAuctionThreshold:    types.DefaultDebtThreshold,
+						DebtAuctionLot:          types.DefaultDebtLot,
+					},
+				},
+				distributionFreq: types.DefaultSavingsDistributionFrequency,
+				breaker:          types.DefaultCircuitBreaker,
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "target ratio must be greater than 1 + liquidation penalty",
+			},
+		},
 		{
 			name: "invalid debt param empty denom",
 			args: args{
-				globalDebtLimit: sdk.NewInt64Coin("usdx", 2000000000000),
+				globalDebtLimit: sdk.NewCoins(sdk.NewInt64Coin("usdx", 2000000000000)),
 				collateralParams: types.CollateralParams{
 					{
 						Denom:               "bnb",
@@ -725,17 +1221,19 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 						ConversionFactor:    sdk.NewInt(8),
 					},
 				},
-				debtParam: types.DebtParam{
-					Denom:            "",
-					ReferenceAsset:   "usd",
-					ConversionFactor: sdk.NewInt(6),
-					DebtFloor:        sdk.NewInt(10000000),
-					SavingsRate:      sdk.MustNewDecFromStr("0.95"),
+				debtParams: types.DebtParams{
+					{
+						Denom:                   "",
+						ReferenceAsset:          "usd",
+						ConversionFactor:        sdk.NewInt(6),
+						DebtFloor:               sdk.NewInt(10000000),
+						SavingsRate:             sdk.MustNewDecFromStr("0.95"),
+						SurplusAuctionThreshold: types.DefaultSurplusThreshold,
+						SurplusAuctionLot:       types.DefaultSurplusLot,
+						DebtAuctionThreshold:    types.DefaultDebtThreshold,
+						DebtAuctionLot:          types.DefaultDebtLot,
+					},
 				},
-				surplusThreshold: types.DefaultSurplusThreshold,
-				surplusLot:       types.DefaultSurplusLot,
-				debtThreshold:    types.DefaultDebtThreshold,
-				debtLot:          types.DefaultDebtLot,
 				distributionFreq: types.DefaultSavingsDistributionFrequency,
 				breaker:          types.DefaultCircuitBreaker,
 			},
@@ -747,7 +1245,7 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 		{
 			name: "invalid debt param savings rate out of range",
 			args: args{
-				globalDebtLimit: sdk.NewInt64Coin("usdx", 2000000000000),
+				globalDebtLimit: sdk.NewCoins(sdk.NewInt64Coin("usdx", 2000000000000)),
 				collateralParams: types.CollateralParams{
 					{
 						Denom:               "bnb",
@@ -763,17 +1261,19 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 						ConversionFactor:    sdk.NewInt(8),
 					},
 				},
-				debtParam: types.DebtParam{
-					Denom:            "usdx",
-					ReferenceAsset:   "usd",
-					ConversionFactor: sdk.NewInt(6),
-					DebtFloor:        sdk.NewInt(10000000),
-					SavingsRate:      sdk.MustNewDecFromStr("1.05"),
+				debtParams: types.DebtParams{
+					{
+						Denom:                   "usdx",
+						ReferenceAsset:          "usd",
+						ConversionFactor:        sdk.NewInt(6),
+						DebtFloor:               sdk.NewInt(10000000),
+						SavingsRate:             sdk.MustNewDecFromStr("1.05"),
+						SurplusAuctionThreshold: types.DefaultSurplusThreshold,
+						SurplusAuctionLot:       types.DefaultSurplusLot,
+						DebtAuctionThreshold:    types.DefaultDebtThreshold,
+						DebtAuctionLot:          types.DefaultDebtLot,
+					},
 				},
-				surplusThreshold: types.DefaultSurplusThreshold,
-				surplusLot:       types.DefaultSurplusLot,
-				debtThreshold:    types.DefaultDebtThreshold,
-				debtLot:          types.DefaultDebtLot,
 				distributionFreq: types.DefaultSavingsDistributionFrequency,
 				breaker:          types.DefaultCircuitBreaker,
 			},
@@ -785,13 +1285,9 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 		{
 			name: "nil debt limit",
 			args: args{
-				globalDebtLimit:  sdk.Coin{},
+				globalDebtLimit:  sdk.Coins{sdk.Coin{}},
 				collateralParams: types.DefaultCollateralParams,
-				debtParam:        types.DefaultDebtParam,
-				surplusThreshold: types.DefaultSurplusThreshold,
-				surplusLot:       types.DefaultSurplusLot,
-				debtThreshold:    types.DefaultDebtThreshold,
-				debtLot:          types.DefaultDebtLot,
+				debtParams:       types.DefaultDebtParams,
 				distributionFreq: types.DefaultSavingsDistributionFrequency,
 				breaker:          types.DefaultCircuitBreaker,
 			},
@@ -805,11 +1301,7 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 			args: args{
 				globalDebtLimit:  types.DefaultGlobalDebt,
 				collateralParams: types.DefaultCollateralParams,
-				debtParam:        types.DefaultDebtParam,
-				surplusThreshold: types.DefaultSurplusThreshold,
-				surplusLot:       types.DefaultSurplusLot,
-				debtThreshold:    types.DefaultDebtThreshold,
-				debtLot:          types.DefaultDebtLot,
+				debtParams:       types.DefaultDebtParams,
 				distributionFreq: time.Second * 0,
 				breaker:          types.DefaultCircuitBreaker,
 			},
@@ -823,11 +1315,19 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 			args: args{
 				globalDebtLimit:  types.DefaultGlobalDebt,
 				collateralParams: types.DefaultCollateralParams,
-				debtParam:        types.DefaultDebtParam,
-				surplusThreshold: sdk.ZeroInt(),
-				surplusLot:       types.DefaultSurplusLot,
-				debtThreshold:    types.DefaultDebtThreshold,
-				debtLot:          types.DefaultDebtLot,
+				debtParams: types.DebtParams{
+					{
+						Denom:                   types.DefaultDebtParam.Denom,
+						ReferenceAsset:          types.DefaultDebtParam.ReferenceAsset,
+						ConversionFactor:        types.DefaultDebtParam.ConversionFactor,
+						DebtFloor:               types.DefaultDebtParam.DebtFloor,
+						SavingsRate:             types.DefaultDebtParam.SavingsRate,
+						SurplusAuctionThreshold: sdk.ZeroInt(),
+						SurplusAuctionLot:       types.DefaultSurplusLot,
+						DebtAuctionThreshold:    types.DefaultDebtThreshold,
+						DebtAuctionLot:          types.DefaultDebtLot,
+					},
+				},
 				distributionFreq: types.DefaultSavingsDistributionFrequency,
 				breaker:          types.DefaultCircuitBreaker,
 			},
@@ -841,11 +1341,19 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 			args: args{
 				globalDebtLimit:  types.DefaultGlobalDebt,
 				collateralParams: types.DefaultCollateralParams,
-				debtParam:        types.DefaultDebtParam,
-				surplusThreshold: types.DefaultSurplusThreshold,
-				surplusLot:       types.DefaultSurplusLot,
-				debtThreshold:    sdk.ZeroInt(),
-				debtLot:          types.DefaultDebtLot,
+				debtParams: types.DebtParams{
+					{
+						Denom:                   types.DefaultDebtParam.Denom,
+						ReferenceAsset:          types.DefaultDebtParam.ReferenceAsset,
+						ConversionFactor:        types.DefaultDebtParam.ConversionFactor,
+						DebtFloor:               types.DefaultDebtParam.DebtFloor,
+						SavingsRate:             types.DefaultDebtParam.SavingsRate,
+						SurplusAuctionThreshold: types.DefaultSurplusThreshold,
+						SurplusAuctionLot:       types.DefaultSurplusLot,
+						DebtAuctionThreshold:    sdk.ZeroInt(),
+						DebtAuctionLot:          types.DefaultDebtLot,
+					},
+				},
 				distributionFreq: types.DefaultSavingsDistributionFrequency,
 				breaker:          types.DefaultCircuitBreaker,
 			},
@@ -859,11 +1367,19 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 			args: args{
 				globalDebtLimit:  types.DefaultGlobalDebt,
 				collateralParams: types.DefaultCollateralParams,
-				debtParam:        types.DefaultDebtParam,
-				surplusThreshold: types.DefaultSurplusThreshold,
-				surplusLot:       sdk.ZeroInt(),
-				debtThreshold:    types.DefaultDebtThreshold,
-				debtLot:          types.DefaultDebtLot,
+				debtParams: types.DebtParams{
+					{
+						Denom:                   types.DefaultDebtParam.Denom,
+						ReferenceAsset:          types.DefaultDebtParam.ReferenceAsset,
+						ConversionFactor:        types.DefaultDebtParam.ConversionFactor,
+						DebtFloor:               types.DefaultDebtParam.DebtFloor,
+						SavingsRate:             types.DefaultDebtParam.SavingsRate,
+						SurplusAuctionThreshold: types.DefaultSurplusThreshold,
+						SurplusAuctionLot:       sdk.ZeroInt(),
+						DebtAuctionThreshold:    types.DefaultDebtThreshold,
+						DebtAuctionLot:          types.DefaultDebtLot,
+					},
+				},
 				distributionFreq: types.DefaultSavingsDistributionFrequency,
 				breaker:          types.DefaultCircuitBreaker,
 			},
@@ -877,11 +1393,19 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 			args: args{
 				globalDebtLimit:  types.DefaultGlobalDebt,
 				collateralParams: types.DefaultCollateralParams,
-				debtParam:        types.DefaultDebtParam,
-				surplusThreshold: types.DefaultSurplusThreshold,
-				surplusLot:       types.DefaultSurplusLot,
-				debtThreshold:    types.DefaultDebtThreshold,
-				debtLot:          sdk.ZeroInt(),
+				debtParams: types.DebtParams{
+					{
+						Denom:                   types.DefaultDebtParam.Denom,
+						ReferenceAsset:          types.DefaultDebtParam.ReferenceAsset,
+						ConversionFactor:        types.DefaultDebtParam.ConversionFactor,
+						DebtFloor:               types.DefaultDebtParam.DebtFloor,
+						SavingsRate:             types.DefaultDebtParam.SavingsRate,
+						SurplusAuctionThreshold: types.DefaultSurplusThreshold,
+						SurplusAuctionLot:       types.DefaultSurplusLot,
+						DebtAuctionThreshold:    types.DefaultDebtThreshold,
+						DebtAuctionLot:          sdk.ZeroInt(),
+					},
+				},
 				distributionFreq: types.DefaultSavingsDistributionFrequency,
 				breaker:          types.DefaultCircuitBreaker,
 			},
@@ -893,7 +1417,7 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 	}
 	for _, tc := range testCases {
 		suite.Run(tc.name, func() {
-			params := types.NewParams(tc.args.globalDebtLimit, tc.args.collateralParams, tc.args.debtParam, tc.args.surplusThreshold, tc.args.surplusLot, tc.args.debtThreshold, tc.args.debtLot, tc.args.distributionFreq, tc.args.breaker)
+			params := types.NewParams(tc.args.globalDebtLimit, tc.args.collateralParams, tc.args.debtParams, tc.args.distributionFreq, tc.args.breaker)
 			err := params.Validate()
 			if tc.errArgs.expectPass {
 				suite.Require().NoError(err)
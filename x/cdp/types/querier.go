@@ -15,9 +15,13 @@ const (
 	QueryGetAccounts                        = "accounts"
 	QueryGetSavingsRateDistributed          = "savings-rate-dist"
 	QueryGetPreviousSavingsDistributionTime = "savings-rate-dist-time"
+	QueryGetSavingsPosition                 = "savings-position"
+	QueryGetCollateralTypeRisks             = "risk"
 	RestOwner                               = "owner"
 	RestCollateralType                      = "collateral-type"
 	RestRatio                               = "ratio"
+	RestDepositor                           = "depositor"
+	RestDenom                               = "denom"
 )
 
 // QueryCdpParams params for query /cdp/cdp
@@ -95,3 +99,29 @@ func NewQueryCdpsByRatioParams(collateralType string, ratio sdk.Dec) QueryCdpsBy
 		Ratio:          ratio,
 	}
 }
+
+// QuerySavingsPositionParams params for query /cdp/savings-position
+type QuerySavingsPositionParams struct {
+	Depositor sdk.AccAddress `json:"depositor" yaml:"depositor"`
+	Denom     string         `json:"denom" yaml:"denom"`
+}
+
+// NewQuerySavingsPositionParams returns QuerySavingsPositionParams
+func NewQuerySavingsPositionParams(depositor sdk.AccAddress, denom string) QuerySavingsPositionParams {
+	return QuerySavingsPositionParams{
+		Depositor: depositor,
+		Denom:     denom,
+	}
+}
+
+// QueryCollateralTypeRisksParams params for query /cdp/risk
+type QueryCollateralTypeRisksParams struct {
+	CollateralType string `json:"collateral_type" yaml:"collateral_type"` // get the risk of this collateral type, or of every collateral type if empty
+}
+
+// NewQueryCollateralTypeRisksParams returns QueryCollateralTypeRisksParams
+func NewQueryCollateralTypeRisksParams(collateralType string) QueryCollateralTypeRisksParams {
+	return QueryCollateralTypeRisksParams{
+		CollateralType: collateralType,
+	}
+}
@@ -0,0 +1,85 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// CdpTotals is the running sum of the collateral and principal of the cdps of a collateral type, and how many cdps there are
+type CdpTotals struct {
+	Collateral sdk.Int `json:"collateral" yaml:"collateral"`
+	Principal  sdk.Int `json:"principal" yaml:"principal"`
+	CdpCount   uint64  `json:"cdp_count" yaml:"cdp_count"`
+}
+
+// NewCdpTotals returns a new CdpTotals
+func NewCdpTotals(collateral, principal sdk.Int, cdpCount uint64) CdpTotals {
+	return CdpTotals{
+		Collateral: collateral,
+		Principal:  principal,
+		CdpCount:   cdpCount,
+	}
+}
+
+// CollateralTypeRisk is the debt limit utilisation and collateralization of all the cdps of a collateral type
+type CollateralTypeRisk struct {
+	CollateralType               string   `json:"collateral_type" yaml:"collateral_type"`
+	Principal                    sdk.Coin `json:"principal" yaml:"principal"`                                             // sum of the principal drawn by the cdps
+	AccumulatedFees              sdk.Coin `json:"accumulated_fees" yaml:"accumulated_fees"`                               // fees owed by the cdps, including fees that have not been synchronized
+	DebtLimit                    sdk.Coin `json:"debt_limit" yaml:"debt_limit"`                                           // debt limit of the collateral type
+	DebtHeadroom                 sdk.Coin `json:"debt_headroom" yaml:"debt_headroom"`                                     // amount that can still be drawn before the collateral or global debt limit is reached
+	Collateral                   sdk.Coin `json:"collateral" yaml:"collateral"`                                           // sum of the collateral of the cdps
+	CollateralValueAtSpot        sdk.Coin `json:"collateral_value_at_spot" yaml:"collateral_value_at_spot"`               // collateral's value in debt coin at the spot price
+	CollateralValueAtLiquidation sdk.Coin `json:"collateral_value_at_liquidation" yaml:"collateral_value_at_liquidation"` // collateral's value in debt coin at the liquidation price
+	CollateralizationRatio       sdk.Dec  `json:"collateralization_ratio" yaml:"collateralization_ratio"`                 // collateral value at the liquidation price divided by principal plus fees
+	CdpCount                     uint64   `json:"cdp_count" yaml:"cdp_count"`
+}
+
+// NewCollateralTypeRisk returns a new CollateralTypeRisk
+func NewCollateralTypeRisk(collateralType string, principal, fees, debtLimit, headroom, collateral, spotValue, liquidationValue sdk.Coin,
+	collateralizationRatio sdk.Dec, cdpCount uint64) CollateralTypeRisk {
+	return CollateralTypeRisk{
+		CollateralType:               collateralType,
+		Principal:                    principal,
+		AccumulatedFees:              fees,
+		DebtLimit:                    debtLimit,
+		DebtHeadroom:                 headroom,
+		Collateral:                   collateral,
+		CollateralValueAtSpot:        spotValue,
+		CollateralValueAtLiquidation: liquidationValue,
+		CollateralizationRatio:       collateralizationRatio,
+		CdpCount:                     cdpCount,
+	}
+}
+
+// String implements fmt.Stringer
+func (ctr CollateralTypeRisk) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`Collateral Type Risk:
+	Collateral Type: %s
+	Principal: %s
+	Accumulated Fees: %s
+	Debt Limit: %s
+	Debt Headroom: %s
+	Collateral: %s
+	Collateral Value At Spot Price: %s
+	Collateral Value At Liquidation Price: %s
+	Collateralization Ratio: %s
+	CDPs: %d`,
+		ctr.CollateralType, ctr.Principal, ctr.AccumulatedFees, ctr.DebtLimit, ctr.DebtHeadroom, ctr.Collateral,
+		ctr.CollateralValueAtSpot, ctr.CollateralValueAtLiquidation, ctr.CollateralizationRatio, ctr.CdpCount,
+	))
+}
+
+// CollateralTypeRisks a collection of CollateralTypeRisk objects
+type CollateralTypeRisks []CollateralTypeRisk
+
+// String implements fmt.Stringer
+func (ctrs CollateralTypeRisks) String() string {
+	out := ""
+	for _, ctr := range ctrs {
+		out += ctr.String() + "\n"
+	}
+	return strings.TrimSpace(out)
+}
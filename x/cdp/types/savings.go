@@ -0,0 +1,104 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitialSavingsSharesPerCoin is the number of shares minted per coin by the first deposit into an empty savings vault.
+// Starting shares at a large scale stops surplus, or coins sent directly to the vault, from raising a share's value enough
+// to round later deposits down to no shares.
+var InitialSavingsSharesPerCoin = sdk.NewInt(1000000)
+
+// SavingsDeposit is a depositor's shares of the savings vault of a debt denom
+type SavingsDeposit struct {
+	Depositor sdk.AccAddress `json:"depositor" yaml:"depositor"`
+	Denom     string         `json:"denom" yaml:"denom"`
+	Shares    sdk.Int        `json:"shares" yaml:"shares"`
+}
+
+// NewSavingsDeposit returns a new SavingsDeposit
+func NewSavingsDeposit(depositor sdk.AccAddress, denom string, shares sdk.Int) SavingsDeposit {
+	return SavingsDeposit{
+		Depositor: depositor,
+		Denom:     denom,
+		Shares:    shares,
+	}
+}
+
+// Validate performs a basic validation of the savings deposit
+func (sd SavingsDeposit) Validate() error {
+	if sd.Depositor.Empty() {
+		return errors.New("depositor cannot be empty")
+	}
+	if err := sdk.ValidateDenom(sd.Denom); err != nil {
+		return fmt.Errorf("invalid savings deposit denom: %w", err)
+	}
+	if sd.Shares.BigInt() == nil || !sd.Shares.IsPositive() {
+		return fmt.Errorf("savings deposit shares must be positive: %s", sd.Shares)
+	}
+	return nil
+}
+
+// String implements fmt.Stringer
+func (sd SavingsDeposit) String() string {
+	return fmt.Sprintf(`Savings Deposit:
+	Depositor: %s
+	Denom: %s
+	Shares: %s`, sd.Depositor, sd.Denom, sd.Shares)
+}
+
+// SavingsDeposits a collection of SavingsDeposit objects
+type SavingsDeposits []SavingsDeposit
+
+// Validate validates each savings deposit and checks there is at most one deposit per depositor and denom
+func (sds SavingsDeposits) Validate() error {
+	seenDeposits := make(map[string]bool)
+	for _, sd := range sds {
+		if err := sd.Validate(); err != nil {
+			return err
+		}
+		key := sd.Denom + ":" + sd.Depositor.String()
+		if seenDeposits[key] {
+			return fmt.Errorf("duplicate %s savings deposit for %s", sd.Denom, sd.Depositor)
+		}
+		seenDeposits[key] = true
+	}
+	return nil
+}
+
+// String implements fmt.Stringer
+func (sds SavingsDeposits) String() string {
+	out := ""
+	for _, sd := range sds {
+		out += sd.String() + "\n"
+	}
+	return strings.TrimSpace(out)
+}
+
+// SavingsPosition is a depositor's savings deposit and the amount it can currently be withdrawn for
+type SavingsPosition struct {
+	Depositor sdk.AccAddress `json:"depositor" yaml:"depositor"`
+	Shares    sdk.Int        `json:"shares" yaml:"shares"`
+	Value     sdk.Coin       `json:"value" yaml:"value"`
+}
+
+// NewSavingsPosition returns a new SavingsPosition
+func NewSavingsPosition(depositor sdk.AccAddress, shares sdk.Int, value sdk.Coin) SavingsPosition {
+	return SavingsPosition{
+		Depositor: depositor,
+		Shares:    shares,
+		Value:     value,
+	}
+}
+
+// String implements fmt.Stringer
+func (sp SavingsPosition) String() string {
+	return fmt.Sprintf(`Savings Position:
+	Depositor: %s
+	Shares: %s
+	Value: %s`, sp.Depositor, sp.Shares, sp.Value)
+}
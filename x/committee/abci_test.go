@@ -84,15 +84,19 @@ func (suite *ModuleTestSuite) TestBeginBlock_EnactsPassed() {
 	suite.keeper.SetCommittee(suite.ctx, normalCom)
 
 	// setup 2 proposals
-	previousCDPDebtThreshold := suite.app.GetCDPKeeper().GetParams(suite.ctx).DebtAuctionThreshold
-	newDebtThreshold := previousCDPDebtThreshold.Add(i(1000000))
-	evenNewerDebtThreshold := newDebtThreshold.Add(i(1000000))
+	previousCDPDebtParams := suite.app.GetCDPKeeper().GetParams(suite.ctx).DebtParams
+	newDebtParams := make(cdp.DebtParams, len(previousCDPDebtParams))
+	copy(newDebtParams, previousCDPDebtParams)
+	newDebtParams[0].DebtAuctionThreshold = previousCDPDebtParams[0].DebtAuctionThreshold.Add(i(1000000))
+	evenNewerDebtParams := make(cdp.DebtParams, len(newDebtParams))
+	copy(evenNewerDebtParams, newDebtParams)
+	evenNewerDebtParams[0].DebtAuctionThreshold = newDebtParams[0].DebtAuctionThreshold.Add(i(1000000))
 
 	pprop1 := params.NewParameterChangeProposal("Title 1", "A description of this proposal.",
 		[]params.ParamChange{{
 			Subspace: cdptypes.ModuleName,
-			Key:      string(cdp.KeyDebtThreshold),
-			Value:    string(cdp.ModuleCdc.MustMarshalJSON(newDebtThreshold)),
+			Key:      string(cdp.KeyDebtParams),
+			Value:    string(cdp.ModuleCdc.MustMarshalJSON(newDebtParams)),
 		}},
 	)
 	id1, err := suite.keeper.SubmitProposal(suite.ctx, normalCom.Members[0], normalCom.ID, pprop1)
@@ -101,8 +105,8 @@ func (suite *ModuleTestSuite) TestBeginBlock_EnactsPassed() {
 	pprop2 := params.NewParameterChangeProposal("Title 2", "A description of this proposal.",
 		[]params.ParamChange{{
 			Subspace: cdptypes.ModuleName,
-			Key:      string(cdp.KeyDebtThreshold),
-			Value:    string(cdp.ModuleCdc.MustMarshalJSON(evenNewerDebtThreshold)),
+			Key:      string(cdp.KeyDebtParams),
+			Value:    string(cdp.ModuleCdc.MustMarshalJSON(evenNewerDebtParams)),
 		}},
 	)
 	id2, err := suite.keeper.SubmitProposal(suite.ctx, normalCom.Members[0], normalCom.ID, pprop2)
@@ -119,7 +123,7 @@ func (suite *ModuleTestSuite) TestBeginBlock_EnactsPassed() {
 	})
 
 	// Check the param has been updated
-	suite.Equal(newDebtThreshold, suite.app.GetCDPKeeper().GetParams(suite.ctx).DebtAuctionThreshold)
+	suite.Equal(newDebtParams, suite.app.GetCDPKeeper().GetParams(suite.ctx).DebtParams)
 	// Check the passed proposal has gone
 	_, found := suite.keeper.GetProposal(suite.ctx, id1)
 	suite.False(found, "expected passed proposal to be enacted and closed")
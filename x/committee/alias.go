@@ -44,32 +44,33 @@ const (
 
 var (
 	// function aliases
-	NewKeeper                   = keeper.NewKeeper
-	NewQuerier                  = keeper.NewQuerier
-	RegisterInvariants          = keeper.RegisterInvariants
-	ValidCommitteesInvariant    = keeper.ValidCommitteesInvariant
-	ValidProposalsInvariant     = keeper.ValidProposalsInvariant
-	ValidVotesInvariant         = keeper.ValidVotesInvariant
-	DefaultGenesisState         = types.DefaultGenesisState
-	GetKeyFromID                = types.GetKeyFromID
-	GetVoteKey                  = types.GetVoteKey
-	NewAllowedCollateralParam   = types.NewAllowedCollateralParam
-	NewCommittee                = types.NewCommittee
-	NewCommitteeChangeProposal  = types.NewCommitteeChangeProposal
-	NewCommitteeDeleteProposal  = types.NewCommitteeDeleteProposal
-	NewGenesisState             = types.NewGenesisState
-	NewMsgSubmitProposal        = types.NewMsgSubmitProposal
-	NewMsgVote                  = types.NewMsgVote
-	NewProposal                 = types.NewProposal
-	NewQueryCommitteeParams     = types.NewQueryCommitteeParams
-	NewQueryProposalParams      = types.NewQueryProposalParams
-	NewQueryRawParamsParams     = types.NewQueryRawParamsParams
-	NewQueryVoteParams          = types.NewQueryVoteParams
-	NewVote                     = types.NewVote
-	RegisterCodec               = types.RegisterCodec
-	RegisterPermissionTypeCodec = types.RegisterPermissionTypeCodec
-	RegisterProposalTypeCodec   = types.RegisterProposalTypeCodec
-	Uint64FromBytes             = types.Uint64FromBytes
+	NewKeeper                        = keeper.NewKeeper
+	NewQuerier                       = keeper.NewQuerier
+	RegisterInvariants               = keeper.RegisterInvariants
+	ValidCommitteesInvariant         = keeper.ValidCommitteesInvariant
+	ValidProposalsInvariant          = keeper.ValidProposalsInvariant
+	ValidVotesInvariant              = keeper.ValidVotesInvariant
+	DefaultGenesisState              = types.DefaultGenesisState
+	GetKeyFromID                     = types.GetKeyFromID
+	GetVoteKey                       = types.GetVoteKey
+	NewAllowedCollateralAuctionParam = types.NewAllowedCollateralAuctionParam
+	NewAllowedCollateralParam        = types.NewAllowedCollateralParam
+	NewCommittee                     = types.NewCommittee
+	NewCommitteeChangeProposal       = types.NewCommitteeChangeProposal
+	NewCommitteeDeleteProposal       = types.NewCommitteeDeleteProposal
+	NewGenesisState                  = types.NewGenesisState
+	NewMsgSubmitProposal             = types.NewMsgSubmitProposal
+	NewMsgVote                       = types.NewMsgVote
+	NewProposal                      = types.NewProposal
+	NewQueryCommitteeParams          = types.NewQueryCommitteeParams
+	NewQueryProposalParams           = types.NewQueryProposalParams
+	NewQueryRawParamsParams          = types.NewQueryRawParamsParams
+	NewQueryVoteParams               = types.NewQueryVoteParams
+	NewVote                          = types.NewVote
+	RegisterCodec                    = types.RegisterCodec
+	RegisterPermissionTypeCodec      = types.RegisterPermissionTypeCodec
+	RegisterProposalTypeCodec        = types.RegisterProposalTypeCodec
+	Uint64FromBytes                  = types.Uint64FromBytes
 
 	// variable aliases
 	ProposalHandler            = client.ProposalHandler
@@ -90,34 +91,37 @@ var (
 )
 
 type (
-	Keeper                      = keeper.Keeper
-	AllowedAssetParam           = types.AllowedAssetParam
-	AllowedAssetParams          = types.AllowedAssetParams
-	AllowedCollateralParam      = types.AllowedCollateralParam
-	AllowedCollateralParams     = types.AllowedCollateralParams
-	AllowedDebtParam            = types.AllowedDebtParam
-	AllowedMarket               = types.AllowedMarket
-	AllowedMarkets              = types.AllowedMarkets
-	AllowedParam                = types.AllowedParam
-	AllowedParams               = types.AllowedParams
-	Committee                   = types.Committee
-	CommitteeChangeProposal     = types.CommitteeChangeProposal
-	CommitteeDeleteProposal     = types.CommitteeDeleteProposal
-	GenesisState                = types.GenesisState
-	GodPermission               = types.GodPermission
-	MsgSubmitProposal           = types.MsgSubmitProposal
-	MsgVote                     = types.MsgVote
-	ParamKeeper                 = types.ParamKeeper
-	Permission                  = types.Permission
-	Proposal                    = types.Proposal
-	PubProposal                 = types.PubProposal
-	QueryCommitteeParams        = types.QueryCommitteeParams
-	QueryProposalParams         = types.QueryProposalParams
-	QueryRawParamsParams        = types.QueryRawParamsParams
-	QueryVoteParams             = types.QueryVoteParams
-	SimpleParamChangePermission = types.SimpleParamChangePermission
-	SoftwareUpgradePermission   = types.SoftwareUpgradePermission
-	SubParamChangePermission    = types.SubParamChangePermission
-	TextPermission              = types.TextPermission
-	Vote                        = types.Vote
+	Keeper                         = keeper.Keeper
+	AllowedAssetParam              = types.AllowedAssetParam
+	AllowedAssetParams             = types.AllowedAssetParams
+	AllowedCollateralAuctionParam  = types.AllowedCollateralAuctionParam
+	AllowedCollateralAuctionParams = types.AllowedCollateralAuctionParams
+	AllowedCollateralParam         = types.AllowedCollateralParam
+	AllowedCollateralParams        = types.AllowedCollateralParams
+	AllowedDebtParam               = types.AllowedDebtParam
+	AllowedDebtParams              = types.AllowedDebtParams
+	AllowedMarket                  = types.AllowedMarket
+	AllowedMarkets                 = types.AllowedMarkets
+	AllowedParam                   = types.AllowedParam
+	AllowedParams                  = types.AllowedParams
+	Committee                      = types.Committee
+	CommitteeChangeProposal        = types.CommitteeChangeProposal
+	CommitteeDeleteProposal        = types.CommitteeDeleteProposal
+	GenesisState                   = types.GenesisState
+	GodPermission                  = types.GodPermission
+	MsgSubmitProposal              = types.MsgSubmitProposal
+	MsgVote                        = types.MsgVote
+	ParamKeeper                    = types.ParamKeeper
+	Permission                     = types.Permission
+	Proposal                       = types.Proposal
+	PubProposal                    = types.PubProposal
+	QueryCommitteeParams           = types.QueryCommitteeParams
+	QueryProposalParams            = types.QueryProposalParams
+	QueryRawParamsParams           = types.QueryRawParamsParams
+	QueryVoteParams                = types.QueryVoteParams
+	SimpleParamChangePermission    = types.SimpleParamChangePermission
+	SoftwareUpgradePermission      = types.SoftwareUpgradePermission
+	SubParamChangePermission       = types.SubParamChangePermission
+	TextPermission                 = types.TextPermission
+	Vote                           = types.Vote
 )
@@ -76,8 +76,8 @@ func (suite *HandlerTestSuite) TestSubmitProposalMsg_Valid() {
 			"A description of this proposal.",
 			[]params.ParamChange{{
 				Subspace: cdptypes.ModuleName,
-				Key:      string(cdptypes.KeyDebtThreshold),
-				Value:    string(types.ModuleCdc.MustMarshalJSON(i(1000000))),
+				Key:      string(cdptypes.KeyCircuitBreaker),
+				Value:    string(types.ModuleCdc.MustMarshalJSON(true)),
 			}},
 		),
 		suite.addresses[0],
@@ -12,6 +12,7 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	"github.com/kava-labs/kava/app"
+	auctiontypes "github.com/kava-labs/kava/x/auction/types"
 	bep3types "github.com/kava-labs/kava/x/bep3/types"
 	cdptypes "github.com/kava-labs/kava/x/cdp/types"
 	"github.com/kava-labs/kava/x/committee/types"
@@ -62,22 +63,29 @@ func (suite *PermissionTestSuite) TestSubParamChangePermission_Allows() {
 	copy(testCPUpdatedDebtLimit, testCPs)
 	testCPUpdatedDebtLimit[0].DebtLimit = c("usdx", 5000000)
 
-	// cdp DebtParam
-	testDP := cdptypes.DebtParam{
-		Denom:            "usdx",
-		ReferenceAsset:   "usd",
-		ConversionFactor: i(6),
-		DebtFloor:        i(10000000),
-		SavingsRate:      d("0.95"),
+	// cdp DebtParams
+	testDPs := cdptypes.DebtParams{
+		{
+			Denom:                   "usdx",
+			ReferenceAsset:          "usd",
+			ConversionFactor:        i(6),
+			DebtFloor:               i(10000000),
+			SavingsRate:             d("0.95"),
+			SurplusAuctionThreshold: cdptypes.DefaultSurplusThreshold,
+			SurplusAuctionLot:       cdptypes.DefaultSurplusLot,
+			DebtAuctionThreshold:    cdptypes.DefaultDebtThreshold,
+			DebtAuctionLot:          cdptypes.DefaultDebtLot,
+		},
 	}
-	testDPUpdatedDebtFloor := testDP
-	testDPUpdatedDebtFloor.DebtFloor = i(1000)
+	testDPsUpdatedDebtFloor := make(cdptypes.DebtParams, len(testDPs))
+	copy(testDPsUpdatedDebtFloor, testDPs)
+	testDPsUpdatedDebtFloor[0].DebtFloor = i(1000)
 
 	// cdp Genesis
 	testCDPParams := cdptypes.DefaultParams()
 	testCDPParams.CollateralParams = testCPs
-	testCDPParams.DebtParam = testDP
-	testCDPParams.GlobalDebtLimit = testCPs[0].DebtLimit.Add(testCPs[0].DebtLimit) // correct global debt limit to pass genesis validation
+	testCDPParams.DebtParams = testDPs
+	testCDPParams.GlobalDebtLimit = sdk.NewCoins(testCPs[0].DebtLimit.Add(testCPs[0].DebtLimit)) // correct global debt limit to pass genesis validation
 
 	testDeputy, err := sdk.AccAddressFromBech32("kava1xy7hrjy9r0algz9w3gzm8u6mrpq97kwta747gj")
 	suite.Require().NoError(err)
@@ -147,6 +155,14 @@ func (suite *PermissionTestSuite) TestSubParamChangePermission_Allows() {
 	copy(testMsUpdatedActive, testMs)
 	testMsUpdatedActive[1].Active = true
 
+	// auction ReserveParams
+	testRPs := auctiontypes.ReserveParams{
+		auctiontypes.NewReserveParam("bnb", "bnb:usd", d("0.8"), i(8), i(6), 24*time.Hour),
+	}
+	testRPsInvalidConversionFactor := auctiontypes.ReserveParams{
+		auctiontypes.NewReserveParam("bnb", "bnb:usd", d("0.8"), i(8), i(19), 24*time.Hour),
+	}
+
 	testcases := []struct {
 		name          string
 		genState      []app.GenesisState
@@ -163,9 +179,9 @@ func (suite *PermissionTestSuite) TestSubParamChangePermission_Allows() {
 			},
 			permission: types.SubParamChangePermission{
 				AllowedParams: types.AllowedParams{
-					{Subspace: cdptypes.ModuleName, Key: string(cdptypes.KeyDebtThreshold)},
+					{Subspace: cdptypes.ModuleName, Key: string(cdptypes.KeyCircuitBreaker)},
 					{Subspace: cdptypes.ModuleName, Key: string(cdptypes.KeyCollateralParams)},
-					{Subspace: cdptypes.ModuleName, Key: string(cdptypes.KeyDebtParam)},
+					{Subspace: cdptypes.ModuleName, Key: string(cdptypes.KeyDebtParams)},
 					{Subspace: bep3types.ModuleName, Key: string(bep3types.KeyAssetParams)},
 					{Subspace: pricefeedtypes.ModuleName, Key: string(pricefeedtypes.KeyMarkets)},
 				},
@@ -179,8 +195,11 @@ func (suite *PermissionTestSuite) TestSubParamChangePermission_Allows() {
 						Type: "btc-a",
 					},
 				},
-				AllowedDebtParam: types.AllowedDebtParam{
-					DebtFloor: true,
+				AllowedDebtParams: types.AllowedDebtParams{
+					{
+						Denom:     "usdx",
+						DebtFloor: true,
+					},
 				},
 				AllowedAssetParams: types.AllowedAssetParams{
 					{
@@ -207,8 +226,8 @@ func (suite *PermissionTestSuite) TestSubParamChangePermission_Allows() {
 				[]paramstypes.ParamChange{
 					{
 						Subspace: cdptypes.ModuleName,
-						Key:      string(cdptypes.KeyDebtThreshold),
-						Value:    string(suite.cdc.MustMarshalJSON(i(1234))),
+						Key:      string(cdptypes.KeyCircuitBreaker),
+						Value:    string(suite.cdc.MustMarshalJSON(true)),
 					},
 					{
 						Subspace: cdptypes.ModuleName,
@@ -217,8 +236,8 @@ func (suite *PermissionTestSuite) TestSubParamChangePermission_Allows() {
 					},
 					{
 						Subspace: cdptypes.ModuleName,
-						Key:      string(cdptypes.KeyDebtParam),
-						Value:    string(suite.cdc.MustMarshalJSON(testDPUpdatedDebtFloor)),
+						Key:      string(cdptypes.KeyDebtParams),
+						Value:    string(suite.cdc.MustMarshalJSON(testDPsUpdatedDebtFloor)),
 					},
 					{
 						Subspace: bep3types.ModuleName,
@@ -234,6 +253,46 @@ func (suite *PermissionTestSuite) TestSubParamChangePermission_Allows() {
 			),
 			expectAllowed: true,
 		},
+		{
+			name: "allowed (valid reserve params)",
+			permission: types.SubParamChangePermission{
+				AllowedParams: types.AllowedParams{
+					{Subspace: auctiontypes.ModuleName, Key: string(auctiontypes.KeyReserveParams)},
+				},
+			},
+			pubProposal: paramstypes.NewParameterChangeProposal(
+				"A Title",
+				"A description for this proposal.",
+				[]paramstypes.ParamChange{
+					{
+						Subspace: auctiontypes.ModuleName,
+						Key:      string(auctiontypes.KeyReserveParams),
+						Value:    string(suite.cdc.MustMarshalJSON(testRPs)),
+					},
+				},
+			),
+			expectAllowed: true,
+		},
+		{
+			name: "not allowed (reserve param conversion factor out of range)",
+			permission: types.SubParamChangePermission{
+				AllowedParams: types.AllowedParams{
+					{Subspace: auctiontypes.ModuleName, Key: string(auctiontypes.KeyReserveParams)},
+				},
+			},
+			pubProposal: paramstypes.NewParameterChangeProposal(
+				"A Title",
+				"A description for this proposal.",
+				[]paramstypes.ParamChange{
+					{
+						Subspace: auctiontypes.ModuleName,
+						Key:      string(auctiontypes.KeyReserveParams),
+						Value:    string(suite.cdc.MustMarshalJSON(testRPsInvalidConversionFactor)),
+					},
+				},
+			),
+			expectAllowed: false,
+		},
 		{
 			name:          "not allowed (wrong pubproposal type)",
 			permission:    types.SubParamChangePermission{},
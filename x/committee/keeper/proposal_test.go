@@ -72,7 +72,7 @@ func (suite *KeeperTestSuite) TestSubmitProposal() {
 	paramChangePermissionsCom.Permissions = []types.Permission{
 		types.SubParamChangePermission{
 			AllowedParams: types.AllowedParams{
-				{Subspace: cdptypes.ModuleName, Key: string(cdptypes.KeyDebtThreshold)},
+				{Subspace: cdptypes.ModuleName, Key: string(cdptypes.KeyCircuitBreaker)},
 				{Subspace: cdptypes.ModuleName, Key: string(cdptypes.KeyCollateralParams)},
 			},
 			AllowedCollateralParams: types.AllowedCollateralParams{
@@ -100,7 +100,7 @@ func (suite *KeeperTestSuite) TestSubmitProposal() {
 	}}
 	testCDPParams := cdptypes.DefaultParams()
 	testCDPParams.CollateralParams = testCP
-	testCDPParams.GlobalDebtLimit = testCP[0].DebtLimit
+	testCDPParams.GlobalDebtLimit = sdk.NewCoins(testCP[0].DebtLimit)
 
 	newValidCP := make(cdptypes.CollateralParams, len(testCP))
 	copy(newValidCP, testCP)
@@ -133,7 +133,7 @@ func (suite *KeeperTestSuite) TestSubmitProposal() {
 				"A Title", "A description of this proposal.",
 				[]params.ParamChange{
 					{
-						Subspace: "cdp", Key: string(cdptypes.KeyDebtThreshold), Value: string(suite.app.Codec().MustMarshalJSON(i(1000000))),
+						Subspace: "cdp", Key: string(cdptypes.KeyCircuitBreaker), Value: string(suite.app.Codec().MustMarshalJSON(true)),
 					},
 				},
 			),
@@ -180,7 +180,7 @@ func (suite *KeeperTestSuite) TestSubmitProposal() {
 				"A Title", "A description of this proposal.",
 				[]params.ParamChange{
 					{
-						"cdp", string(cdptypes.KeyDebtThreshold), string(suite.app.Codec().MustMarshalJSON(i(1000000000))),
+						"cdp", string(cdptypes.KeyCircuitBreaker), string(suite.app.Codec().MustMarshalJSON(true)),
 					},
 					{
 						"cdp", string(cdptypes.KeyCollateralParams), string(suite.app.Codec().MustMarshalJSON(newValidCP)),
@@ -198,7 +198,7 @@ func (suite *KeeperTestSuite) TestSubmitProposal() {
 				"A Title", "A description of this proposal.",
 				[]params.ParamChange{
 					{
-						"cdp", string(cdptypes.KeyDebtThreshold), string(suite.app.Codec().MustMarshalJSON(i(1000000000))),
+						"cdp", string(cdptypes.KeyCircuitBreaker), string(suite.app.Codec().MustMarshalJSON(true)),
 					},
 					{
 						"cdp", string(cdptypes.KeyCollateralParams), string(suite.app.Codec().MustMarshalJSON(newInvalidCP)),
@@ -424,7 +424,7 @@ func (suite *KeeperTestSuite) TestValidatePubProposal() {
 				[]params.ParamChange{{
 					Subspace: cdptypes.ModuleName,
 					Key:      string(cdptypes.KeyGlobalDebtLimit),
-					Value:    string(types.ModuleCdc.MustMarshalJSON(c("usdx", 100000000000))),
+					Value:    string(types.ModuleCdc.MustMarshalJSON(cs(c("usdx", 100000000000)))),
 				}},
 			),
 			expectErr: false,
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	auctiontypes "github.com/kava-labs/kava/x/auction/types"
 	bep3types "github.com/kava-labs/kava/x/bep3/types"
 	cdptypes "github.com/kava-labs/kava/x/cdp/types"
 	pricefeedtypes "github.com/kava-labs/kava/x/pricefeed/types"
@@ -162,6 +163,122 @@ func (suite *PermissionsTestSuite) TestAllowedCollateralParams_Allows() {
 	}
 }
 
+func (suite *PermissionsTestSuite) TestAllowedDebtParams_Allows() {
+	testDPs := cdptypes.DebtParams{
+		{
+			Denom:                   "usdx",
+			ReferenceAsset:          "usd",
+			ConversionFactor:        i(6),
+			DebtFloor:               i(10000000),
+			SavingsRate:             d("0.95"),
+			SurplusAuctionThreshold: i(500000000000),
+			SurplusAuctionLot:       i(10000000000),
+			DebtAuctionThreshold:    i(100000000000),
+			DebtAuctionLot:          i(10000000000),
+		},
+		{
+			Denom:                   "eurx",
+			ReferenceAsset:          "eur",
+			ConversionFactor:        i(6),
+			DebtFloor:               i(10000000),
+			SavingsRate:             d("0.9"),
+			SurplusAuctionThreshold: i(500000000000),
+			SurplusAuctionLot:       i(10000000000),
+			DebtAuctionThreshold:    i(100000000000),
+			DebtAuctionLot:          i(10000000000),
+		},
+	}
+	updatedTestDPs := make(cdptypes.DebtParams, len(testDPs))
+	updatedTestDPs[0] = testDPs[1]
+	updatedTestDPs[1] = testDPs[0]
+
+	updatedTestDPs[0].DebtFloor = i(1000)            // eurx
+	updatedTestDPs[1].SavingsRate = d("0.5")         // usdx
+	updatedTestDPs[1].DebtAuctionThreshold = i(1000) // usdx
+
+	testcases := []struct {
+		name          string
+		allowed       AllowedDebtParams
+		current       cdptypes.DebtParams
+		incoming      cdptypes.DebtParams
+		expectAllowed bool
+	}{
+		{
+			name: "disallowed add",
+			allowed: AllowedDebtParams{
+				{
+					Denom:       "usdx",
+					SavingsRate: true,
+				},
+				{
+					Denom:     "eurx",
+					DebtFloor: true,
+				},
+			},
+			current:       testDPs[:1],
+			incoming:      testDPs,
+			expectAllowed: false,
+		},
+		{
+			name: "disallowed remove",
+			allowed: AllowedDebtParams{
+				{
+					Denom:       "usdx",
+					SavingsRate: true,
+				},
+				{
+					Denom:     "eurx",
+					DebtFloor: true,
+				},
+			},
+			current:       testDPs,
+			incoming:      testDPs[:1], // removes eurx
+			expectAllowed: false,
+		},
+		{
+			name: "allowed change with different order",
+			allowed: AllowedDebtParams{
+				{
+					Denom:                "usdx",
+					SavingsRate:          true,
+					DebtAuctionThreshold: true,
+				},
+				{
+					Denom:     "eurx",
+					DebtFloor: true,
+				},
+			},
+			current:       testDPs,
+			incoming:      updatedTestDPs,
+			expectAllowed: true,
+		},
+		{
+			name: "disallowed change",
+			allowed: AllowedDebtParams{
+				{
+					Denom:       "usdx",
+					SavingsRate: true,
+				},
+				{
+					Denom:     "eurx",
+					DebtFloor: true,
+				},
+			},
+			current:       testDPs,
+			incoming:      updatedTestDPs,
+			expectAllowed: false,
+		},
+	}
+	for _, tc := range testcases {
+		suite.Run(tc.name, func() {
+			suite.Require().Equal(
+				tc.expectAllowed,
+				tc.allowed.Allows(tc.current, tc.incoming),
+			)
+		})
+	}
+}
+
 func (suite *PermissionsTestSuite) TestAllowedAssetParams_Allows() {
 	deputyAddress := sdk.AccAddress(crypto.AddressHash([]byte("KavaTestUser1")))
 	testAPs := bep3types.AssetParams{
@@ -452,6 +569,16 @@ func (suite *PermissionsTestSuite) TestAllowedCollateralParam_Allows() {
 	newMarketIDCP.SpotMarketID = "btc:usd"
 	newDebtLimitCP.DebtLimit = c("usdx", 1000)
 
+	newPartialLiquidationCP := testCP
+	newPartialLiquidationCP.CloseFactor = d("0.5")
+	newPartialLiquidationCP.TargetRatio = d("1.75")
+
+	newDutchAuctionCP := testCP
+	newDutchAuctionCP.DutchAuction = true
+
+	newLiquidationTWAPWindowCP := testCP
+	newLiquidationTWAPWindowCP.LiquidationTWAPWindow = time.Hour
+
 	testcases := []struct {
 		name          string
 		allowed       AllowedCollateralParam
@@ -514,6 +641,67 @@ func (suite *PermissionsTestSuite) TestAllowedCollateralParam_Allows() {
 			incoming:      newMarketIDAndDebtLimitCP,
 			expectAllowed: false,
 		},
+		{
+			name: "allowed partial liquidation change",
+			allowed: AllowedCollateralParam{
+				Type:        "bnb-a",
+				CloseFactor: true,
+				TargetRatio: true,
+			},
+			current:       testCP,
+			incoming:      newPartialLiquidationCP,
+			expectAllowed: true,
+		},
+		{
+			name: "un-allowed partial liquidation change",
+			allowed: AllowedCollateralParam{
+				Type:        "bnb-a",
+				CloseFactor: true,
+			},
+			current:       testCP,
+			incoming:      newPartialLiquidationCP,
+			expectAllowed: false,
+		},
+		{
+			name: "allowed dutch auction change",
+			allowed: AllowedCollateralParam{
+				Type:         "bnb-a",
+				DutchAuction: true,
+			},
+			current:       testCP,
+			incoming:      newDutchAuctionCP,
+			expectAllowed: true,
+		},
+		{
+			name: "un-allowed dutch auction change",
+			allowed: AllowedCollateralParam{
+				Type:        "bnb-a",
+				CloseFactor: true,
+			},
+			current:       testCP,
+			incoming:      newDutchAuctionCP,
+			expectAllowed: false,
+		},
+		{
+			name: "allowed liquidation twap window change",
+			allowed: AllowedCollateralParam{
+				Type:                  "bnb-a",
+				LiquidationTWAPWindow: true,
+			},
+			current:       testCP,
+			incoming:      newLiquidationTWAPWindowCP,
+			expectAllowed: true,
+		},
+		{
+			name: "un-allowed liquidation twap window change",
+			allowed: AllowedCollateralParam{
+				Type:                "bnb-a",
+				LiquidationMarketID: true,
+			},
+			current:       testCP,
+			incoming:      newLiquidationTWAPWindowCP,
+			expectAllowed: false,
+		},
 		// TODO {
 		// 	name: "nil Int values",
 		// 	allowed: AllowedCollateralParam{
@@ -538,11 +726,15 @@ func (suite *PermissionsTestSuite) TestAllowedCollateralParam_Allows() {
 
 func (suite *PermissionsTestSuite) TestAllowedDebtParam_Allows() {
 	testDP := cdptypes.DebtParam{
-		Denom:            "usdx",
-		ReferenceAsset:   "usd",
-		ConversionFactor: i(6),
-		DebtFloor:        i(10000000),
-		SavingsRate:      d("0.95"),
+		Denom:                   "usdx",
+		ReferenceAsset:          "usd",
+		ConversionFactor:        i(6),
+		DebtFloor:               i(10000000),
+		SavingsRate:             d("0.95"),
+		SurplusAuctionThreshold: i(500000000000),
+		SurplusAuctionLot:       i(10000000000),
+		DebtAuctionThreshold:    i(100000000000),
+		DebtAuctionLot:          i(10000000000),
 	}
 	newDenomDP := testDP
 	newDenomDP.Denom = "usdz"
@@ -550,6 +742,9 @@ func (suite *PermissionsTestSuite) TestAllowedDebtParam_Allows() {
 	newDebtFloorDP := testDP
 	newDebtFloorDP.DebtFloor = i(1000)
 
+	newSurplusLotDP := testDP
+	newSurplusLotDP.SurplusAuctionLot = i(1000)
+
 	newDenomAndDebtFloorDP := testDP
 	newDenomAndDebtFloorDP.Denom = "usdz"
 	newDenomAndDebtFloorDP.DebtFloor = i(1000)
@@ -564,6 +759,7 @@ func (suite *PermissionsTestSuite) TestAllowedDebtParam_Allows() {
 		{
 			name: "allowed change",
 			allowed: AllowedDebtParam{
+				Denom:       "usdx",
 				DebtFloor:   true,
 				SavingsRate: true,
 			},
@@ -574,6 +770,7 @@ func (suite *PermissionsTestSuite) TestAllowedDebtParam_Allows() {
 		{
 			name: "un-allowed change",
 			allowed: AllowedDebtParam{
+				Denom:       "usdx",
 				DebtFloor:   true,
 				SavingsRate: true,
 			},
@@ -584,6 +781,7 @@ func (suite *PermissionsTestSuite) TestAllowedDebtParam_Allows() {
 		{
 			name: "allowed no change",
 			allowed: AllowedDebtParam{
+				Denom:       "usdx",
 				DebtFloor:   true,
 				SavingsRate: true,
 			},
@@ -591,9 +789,41 @@ func (suite *PermissionsTestSuite) TestAllowedDebtParam_Allows() {
 			incoming:      testDP, // no change
 			expectAllowed: true,
 		},
+		{
+			name: "un-allowed auction lot change",
+			allowed: AllowedDebtParam{
+				Denom:       "usdx",
+				DebtFloor:   true,
+				SavingsRate: true,
+			},
+			current:       testDP,
+			incoming:      newSurplusLotDP,
+			expectAllowed: false,
+		},
+		{
+			name: "allowed auction lot change",
+			allowed: AllowedDebtParam{
+				Denom:             "usdx",
+				SurplusAuctionLot: true,
+			},
+			current:       testDP,
+			incoming:      newSurplusLotDP,
+			expectAllowed: true,
+		},
+		{
+			name: "un-allowed change for a different denom",
+			allowed: AllowedDebtParam{
+				Denom:     "eurx",
+				DebtFloor: true,
+			},
+			current:       testDP,
+			incoming:      newDebtFloorDP,
+			expectAllowed: false,
+		},
 		{
 			name: "un-allowed change with allowed change",
 			allowed: AllowedDebtParam{
+				Denom:       "usdx",
 				DebtFloor:   true,
 				SavingsRate: true,
 			},
@@ -738,6 +968,15 @@ func (suite *PermissionsTestSuite) TestAllowedMarket_Allows() {
 	newOraclesAndActiveM.Oracles = nil
 	newOraclesAndActiveM.Active = false
 
+	newOracleWeightsM := testM
+	newOracleWeightsM.OracleWeights = []sdk.Int{sdk.NewInt(2)}
+
+	newSourcesM := testM
+	newSourcesM.Sources = pricefeedtypes.MarketSources{
+		pricefeedtypes.NewMarketSource("bnb:btc", false),
+		pricefeedtypes.NewMarketSource("btc:usd", false),
+	}
+
 	testcases := []struct {
 		name          string
 		allowed       AllowedMarket
@@ -785,6 +1024,46 @@ func (suite *PermissionsTestSuite) TestAllowedMarket_Allows() {
 			incoming:      newOraclesAndActiveM,
 			expectAllowed: false,
 		},
+		{
+			name: "allowed oracle weights change",
+			allowed: AllowedMarket{
+				MarketID:      "bnb:usd",
+				OracleWeights: true,
+			},
+			current:       testM,
+			incoming:      newOracleWeightsM,
+			expectAllowed: true,
+		},
+		{
+			name: "un-allowed oracle weights change",
+			allowed: AllowedMarket{
+				MarketID: "bnb:usd",
+				Active:   true,
+			},
+			current:       testM,
+			incoming:      newOracleWeightsM,
+			expectAllowed: false,
+		},
+		{
+			name: "allowed sources change",
+			allowed: AllowedMarket{
+				MarketID: "bnb:usd",
+				Sources:  true,
+			},
+			current:       testM,
+			incoming:      newSourcesM,
+			expectAllowed: true,
+		},
+		{
+			name: "un-allowed sources change",
+			allowed: AllowedMarket{
+				MarketID:      "bnb:usd",
+				OracleWeights: true,
+			},
+			current:       testM,
+			incoming:      newSourcesM,
+			expectAllowed: false,
+		},
 		// TODO {
 		// 	name: "nil Int values",
 		// 	allowed: AllowedCollateralParam{
@@ -806,3 +1085,87 @@ func (suite *PermissionsTestSuite) TestAllowedMarket_Allows() {
 		})
 	}
 }
+
+func (suite *PermissionsTestSuite) TestAllowedCollateralAuctionParams_Allows() {
+	testCAPs := auctiontypes.CollateralAuctionParams{
+		auctiontypes.NewCollateralAuctionParam("bnb", d("0.05"), time.Hour, 24*time.Hour),
+		auctiontypes.NewCollateralAuctionParam("xrpb", d("0.1"), 2*time.Hour, 48*time.Hour),
+	}
+	updatedTestCAPs := make(auctiontypes.CollateralAuctionParams, len(testCAPs))
+	updatedTestCAPs[0] = auctiontypes.NewCollateralAuctionParam("bnb", d("0.05"), 30*time.Minute, 24*time.Hour)
+	updatedTestCAPs[1] = auctiontypes.NewCollateralAuctionParam("xrpb", d("0.2"), 2*time.Hour, 48*time.Hour)
+
+	testcases := []struct {
+		name          string
+		allowed       AllowedCollateralAuctionParams
+		current       auctiontypes.CollateralAuctionParams
+		incoming      auctiontypes.CollateralAuctionParams
+		expectAllowed bool
+	}{
+		{
+			name: "disallowed add",
+			allowed: AllowedCollateralAuctionParams{
+				NewAllowedCollateralAuctionParam("bnb", true, true, true),
+				NewAllowedCollateralAuctionParam("xrpb", true, true, true),
+			},
+			current:       testCAPs[:1],
+			incoming:      testCAPs,
+			expectAllowed: false,
+		},
+		{
+			name: "disallowed remove",
+			allowed: AllowedCollateralAuctionParams{
+				NewAllowedCollateralAuctionParam("bnb", true, true, true),
+				NewAllowedCollateralAuctionParam("xrpb", true, true, true),
+			},
+			current:       testCAPs,
+			incoming:      testCAPs[:1],
+			expectAllowed: false,
+		},
+		{
+			name: "allowed change",
+			allowed: AllowedCollateralAuctionParams{
+				NewAllowedCollateralAuctionParam("bnb", false, true, false),
+				NewAllowedCollateralAuctionParam("xrpb", true, false, false),
+			},
+			current:       testCAPs,
+			incoming:      updatedTestCAPs,
+			expectAllowed: true,
+		},
+		{
+			name: "un-allowed change",
+			allowed: AllowedCollateralAuctionParams{
+				NewAllowedCollateralAuctionParam("bnb", true, false, true),
+				NewAllowedCollateralAuctionParam("xrpb", true, false, false),
+			},
+			current:       testCAPs,
+			incoming:      updatedTestCAPs,
+			expectAllowed: false,
+		},
+		{
+			name: "change to un-allowed denom",
+			allowed: AllowedCollateralAuctionParams{
+				NewAllowedCollateralAuctionParam("bnb", true, true, true),
+			},
+			current:       testCAPs,
+			incoming:      updatedTestCAPs,
+			expectAllowed: false,
+		},
+		{
+			name:          "no change to un-allowed denoms",
+			allowed:       AllowedCollateralAuctionParams{},
+			current:       testCAPs,
+			incoming:      testCAPs,
+			expectAllowed: false,
+		},
+	}
+
+	for _, tc := range testcases {
+		suite.Run(tc.name, func() {
+			suite.Require().Equal(
+				tc.expectAllowed,
+				tc.allowed.Allows(tc.current, tc.incoming),
+			)
+		})
+	}
+}
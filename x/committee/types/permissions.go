@@ -7,6 +7,7 @@ import (
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	upgrade "github.com/cosmos/cosmos-sdk/x/upgrade"
 
+	auctiontypes "github.com/kava-labs/kava/x/auction/types"
 	bep3types "github.com/kava-labs/kava/x/bep3/types"
 	cdptypes "github.com/kava-labs/kava/x/cdp/types"
 	"github.com/kava-labs/kava/x/pricefeed"
@@ -152,9 +153,11 @@ func (SoftwareUpgradePermission) MarshalYAML() (interface{}, error) {
 type SubParamChangePermission struct {
 	AllowedParams           AllowedParams           `json:"allowed_params" yaml:"allowed_params"`
 	AllowedCollateralParams AllowedCollateralParams `json:"allowed_collateral_params" yaml:"allowed_collateral_params"`
-	AllowedDebtParam        AllowedDebtParam        `json:"allowed_debt_param" yaml:"allowed_debt_param"`
+	AllowedDebtParams       AllowedDebtParams       `json:"allowed_debt_params" yaml:"allowed_debt_params"`
 	AllowedAssetParams      AllowedAssetParams      `json:"allowed_asset_params" yaml:"allowed_asset_params"`
 	AllowedMarkets          AllowedMarkets          `json:"allowed_markets" yaml:"allowed_markets"`
+
+	AllowedCollateralAuctionParams AllowedCollateralAuctionParams `json:"allowed_collateral_auction_params" yaml:"allowed_collateral_auction_params"`
 }
 
 var _ Permission = SubParamChangePermission{}
@@ -164,16 +167,20 @@ func (perm SubParamChangePermission) MarshalYAML() (interface{}, error) {
 		Type                    string                  `yaml:"type"`
 		AllowedParams           AllowedParams           `yaml:"allowed_params"`
 		AllowedCollateralParams AllowedCollateralParams `yaml:"allowed_collateral_params"`
-		AllowedDebtParam        AllowedDebtParam        `yaml:"allowed_debt_param"`
+		AllowedDebtParams       AllowedDebtParams       `yaml:"allowed_debt_params"`
 		AllowedAssetParams      AllowedAssetParams      `yaml:"allowed_asset_params"`
 		AllowedMarkets          AllowedMarkets          `yaml:"allowed_markets"`
+
+		AllowedCollateralAuctionParams AllowedCollateralAuctionParams `yaml:"allowed_collateral_auction_params"`
 	}{
 		Type:                    "param_change_permission",
 		AllowedParams:           perm.AllowedParams,
 		AllowedCollateralParams: perm.AllowedCollateralParams,
-		AllowedDebtParam:        perm.AllowedDebtParam,
+		AllowedDebtParams:       perm.AllowedDebtParams,
 		AllowedAssetParams:      perm.AllowedAssetParams,
 		AllowedMarkets:          perm.AllowedMarkets,
+
+		AllowedCollateralAuctionParams: perm.AllowedCollateralAuctionParams,
 	}
 	return valueToMarshal, nil
 }
@@ -222,13 +229,13 @@ func (perm SubParamChangePermission) Allows(ctx sdk.Context, appCdc *codec.Codec
 		}
 	}
 
-	// Check any DebtParam changes are allowed
+	// Check any DebtParams changes are allowed
 
-	// Get the incoming DebtParam value
+	// Get the incoming DebtParams value
 	var foundIncomingDP bool
-	var incomingDP cdptypes.DebtParam
+	var incomingDP cdptypes.DebtParams
 	for _, change := range proposal.Changes {
-		if !(change.Subspace == cdptypes.ModuleName && change.Key == string(cdptypes.KeyDebtParam)) {
+		if !(change.Subspace == cdptypes.ModuleName && change.Key == string(cdptypes.KeyDebtParams)) {
 			continue
 		}
 		// note: in case of duplicates take the last value
@@ -244,12 +251,12 @@ func (perm SubParamChangePermission) Allows(ctx sdk.Context, appCdc *codec.Codec
 		if !found {
 			return false // not using a panic to help avoid begin blocker panics
 		}
-		var currentDP cdptypes.DebtParam
-		cdpSubspace.Get(ctx, cdptypes.KeyDebtParam, &currentDP) // panics if something goes wrong
+		var currentDP cdptypes.DebtParams
+		cdpSubspace.Get(ctx, cdptypes.KeyDebtParams, &currentDP) // panics if something goes wrong
 
-		// Check the incoming changes in the DebtParam are allowed
-		debtParamChangeAllowed := perm.AllowedDebtParam.Allows(currentDP, incomingDP)
-		if !debtParamChangeAllowed {
+		// Check all the incoming changes in the DebtParams are allowed
+		debtParamChangesAllowed := perm.AllowedDebtParams.Allows(currentDP, incomingDP)
+		if !debtParamChangesAllowed {
 			return false
 		}
 	}
@@ -318,6 +325,52 @@ func (perm SubParamChangePermission) Allows(ctx sdk.Context, appCdc *codec.Codec
 		}
 	}
 
+	// Check any CollateralAuctionParams changes are allowed
+
+	// Get the incoming CollateralAuctionParams value
+	var foundIncomingCAPs bool
+	var incomingCAPs auctiontypes.CollateralAuctionParams
+	for _, change := range proposal.Changes {
+		if !(change.Subspace == auctiontypes.ModuleName && change.Key == string(auctiontypes.KeyCollateralAuctionParams)) {
+			continue
+		}
+		// note: in case of duplicates take the last value
+		foundIncomingCAPs = true
+		if err := appCdc.UnmarshalJSON([]byte(change.Value), &incomingCAPs); err != nil {
+			return false // invalid json value, so just disallow
+		}
+	}
+	// only check if there was a proposed change
+	if foundIncomingCAPs {
+		// Get the current value of the CollateralAuctionParams
+		subspace, found := pk.GetSubspace(auctiontypes.ModuleName)
+		if !found {
+			return false // not using a panic to help avoid begin blocker panics
+		}
+		var currentCAPs auctiontypes.CollateralAuctionParams
+		subspace.Get(ctx, auctiontypes.KeyCollateralAuctionParams, &currentCAPs) // panics if something goes wrong
+
+		// Check all the incoming changes in the CollateralAuctionParams are allowed
+		collateralAuctionParamsChangesAllowed := perm.AllowedCollateralAuctionParams.Allows(currentCAPs, incomingCAPs)
+		if !collateralAuctionParamsChangesAllowed {
+			return false
+		}
+	}
+
+	// Check any ReserveParams changes are valid, as invalid conversion factors would panic when reserve prices are calculated in the auction begin blocker
+	for _, change := range proposal.Changes {
+		if !(change.Subspace == auctiontypes.ModuleName && change.Key == string(auctiontypes.KeyReserveParams)) {
+			continue
+		}
+		var incomingRPs auctiontypes.ReserveParams
+		if err := appCdc.UnmarshalJSON([]byte(change.Value), &incomingRPs); err != nil {
+			return false // invalid json value, so just disallow
+		}
+		if err := incomingRPs.Validate(); err != nil {
+			return false
+		}
+	}
+
 	return true
 }
 
@@ -372,36 +425,47 @@ func (acps AllowedCollateralParams) Allows(current, incoming cdptypes.Collateral
 }
 
 type AllowedCollateralParam struct {
-	Type                string `json:"type" yaml:"type"`
-	Denom               bool   `json:"denom" yaml:"denom"`
-	LiquidationRatio    bool   `json:"liquidation_ratio" yaml:"liquidation_ratio"`
-	DebtLimit           bool   `json:"debt_limit" yaml:"debt_limit"`
-	StabilityFee        bool   `json:"stability_fee" yaml:"stability_fee"`
-	AuctionSize         bool   `json:"auction_size" yaml:"auction_size"`
-	LiquidationPenalty  bool   `json:"liquidation_penalty" yaml:"liquidation_penalty"`
-	Prefix              bool   `json:"prefix" yaml:"prefix"`
-	SpotMarketID        bool   `json:"spot_market_id" yaml:"spot_market_id"`
-	LiquidationMarketID bool   `json:"liquidation_market_id" yaml:"liquidation_market_id"`
-	ConversionFactor    bool   `json:"conversion_factor" yaml:"conversion_factor"`
+	Type                   string `json:"type" yaml:"type"`
+	Denom                  bool   `json:"denom" yaml:"denom"`
+	LiquidationRatio       bool   `json:"liquidation_ratio" yaml:"liquidation_ratio"`
+	DebtLimit              bool   `json:"debt_limit" yaml:"debt_limit"`
+	StabilityFee           bool   `json:"stability_fee" yaml:"stability_fee"`
+	AuctionSize            bool   `json:"auction_size" yaml:"auction_size"`
+	LiquidationPenalty     bool   `json:"liquidation_penalty" yaml:"liquidation_penalty"`
+	Prefix                 bool   `json:"prefix" yaml:"prefix"`
+	SpotMarketID           bool   `json:"spot_market_id" yaml:"spot_market_id"`
+	LiquidationMarketID    bool   `json:"liquidation_market_id" yaml:"liquidation_market_id"`
+	ConversionFactor       bool   `json:"conversion_factor" yaml:"conversion_factor"`
+	CloseFactor            bool   `json:"close_factor" yaml:"close_factor"`
+	TargetRatio            bool   `json:"target_ratio" yaml:"target_ratio"`
+	KeeperRewardPercentage bool   `json:"keeper_reward_percentage" yaml:"keeper_reward_percentage"`
+	DutchAuction           bool   `json:"dutch_auction" yaml:"dutch_auction"`
+	LiquidationTWAPWindow  bool   `json:"liquidation_twap_window" yaml:"liquidation_twap_window"`
 }
 
 // NewAllowedCollateralParam return a new AllowedCollateralParam
 func NewAllowedCollateralParam(
 	ctype string, denom, liqRatio, debtLimit,
 	stabilityFee, auctionSize, liquidationPenalty,
-	prefix, spotMarket, liquidationMarket, conversionFactor bool) AllowedCollateralParam {
+	prefix, spotMarket, liquidationMarket, conversionFactor,
+	closeFactor, targetRatio, keeperReward, dutchAuction, liquidationTWAPWindow bool) AllowedCollateralParam {
 	return AllowedCollateralParam{
-		Type:                ctype,
-		Denom:               denom,
-		LiquidationRatio:    liqRatio,
-		DebtLimit:           debtLimit,
-		StabilityFee:        stabilityFee,
-		AuctionSize:         auctionSize,
-		LiquidationPenalty:  liquidationPenalty,
-		Prefix:              prefix,
-		SpotMarketID:        spotMarket,
-		LiquidationMarketID: liquidationMarket,
-		ConversionFactor:    conversionFactor,
+		Type:                   ctype,
+		Denom:                  denom,
+		LiquidationRatio:       liqRatio,
+		DebtLimit:              debtLimit,
+		StabilityFee:           stabilityFee,
+		AuctionSize:            auctionSize,
+		LiquidationPenalty:     liquidationPenalty,
+		Prefix:                 prefix,
+		SpotMarketID:           spotMarket,
+		LiquidationMarketID:    liquidationMarket,
+		ConversionFactor:       conversionFactor,
+		CloseFactor:            closeFactor,
+		TargetRatio:            targetRatio,
+		KeeperRewardPercentage: keeperReward,
+		DutchAuction:           dutchAuction,
+		LiquidationTWAPWindow:  liquidationTWAPWindow,
 	}
 }
 
@@ -416,24 +480,100 @@ func (acp AllowedCollateralParam) Allows(current, incoming cdptypes.CollateralPa
 		((current.Prefix == incoming.Prefix) || acp.Prefix) &&
 		((current.SpotMarketID == incoming.SpotMarketID) || acp.SpotMarketID) &&
 		((current.LiquidationMarketID == incoming.LiquidationMarketID) || acp.LiquidationMarketID) &&
-		(current.ConversionFactor.Equal(incoming.ConversionFactor) || acp.ConversionFactor)
+		(current.ConversionFactor.Equal(incoming.ConversionFactor) || acp.ConversionFactor) &&
+		(decsEqual(current.CloseFactor, incoming.CloseFactor) || acp.CloseFactor) &&
+		(decsEqual(current.TargetRatio, incoming.TargetRatio) || acp.TargetRatio) &&
+		(decsEqual(current.KeeperRewardPercentage, incoming.KeeperRewardPercentage) || acp.KeeperRewardPercentage) &&
+		((current.DutchAuction == incoming.DutchAuction) || acp.DutchAuction) &&
+		((current.LiquidationTWAPWindow == incoming.LiquidationTWAPWindow) || acp.LiquidationTWAPWindow)
 	return allowed
 }
 
+// decsEqual compares two decimals that may be unset, treating unset as zero
+func decsEqual(a, b sdk.Dec) bool {
+	if a.IsNil() {
+		a = sdk.ZeroDec()
+	}
+	if b.IsNil() {
+		b = sdk.ZeroDec()
+	}
+	return a.Equal(b)
+}
+
+type AllowedDebtParams []AllowedDebtParam
+
+func (adps AllowedDebtParams) Allows(current, incoming cdptypes.DebtParams) bool {
+	allAllowed := true
+
+	// do not allow DebtParams to be added or removed
+	// this checks both lists are the same size, then below checks each incoming matches a current
+	if len(incoming) != len(current) {
+		return false
+	}
+
+	// for each param struct, check it is allowed, and if it is not, check the value has not changed
+	for _, incomingDP := range incoming {
+		// 1) check incoming dp is in list of allowed dps
+		var foundAllowedDP bool
+		var allowedDP AllowedDebtParam
+		for _, p := range adps {
+			if p.Denom != incomingDP.Denom {
+				continue
+			}
+			foundAllowedDP = true
+			allowedDP = p
+		}
+		if !foundAllowedDP {
+			// incoming had a DebtParam that wasn't in the list of allowed ones
+			return false
+		}
+
+		// 2) Check incoming changes are individually allowed
+		// find existing DebtParam
+		var foundCurrentDP bool
+		var currentDP cdptypes.DebtParam
+		for _, p := range current {
+			if p.Denom != incomingDP.Denom {
+				continue
+			}
+			foundCurrentDP = true
+			currentDP = p
+		}
+		if !foundCurrentDP {
+			return false // not allowed to add param to list
+		}
+		// check changed values are all allowed
+		allowed := allowedDP.Allows(currentDP, incomingDP)
+
+		allAllowed = allAllowed && allowed
+	}
+	return allAllowed
+}
+
+// AllowedDebtParam cdp debt parameters that can be changed by committee
 type AllowedDebtParam struct {
-	Denom            bool `json:"denom" yaml:"denom"`
-	ReferenceAsset   bool `json:"reference_asset" yaml:"reference_asset"`
-	ConversionFactor bool `json:"conversion_factor" yaml:"conversion_factor"`
-	DebtFloor        bool `json:"debt_floor" yaml:"debt_floor"`
-	SavingsRate      bool `json:"savings_rate" yaml:"savings_rate"`
+	Denom                   string `json:"denom" yaml:"denom"`
+	ReferenceAsset          bool   `json:"reference_asset" yaml:"reference_asset"`
+	ConversionFactor        bool   `json:"conversion_factor" yaml:"conversion_factor"`
+	DebtFloor               bool   `json:"debt_floor" yaml:"debt_floor"`
+	SavingsRate             bool   `json:"savings_rate" yaml:"savings_rate"`
+	SurplusAuctionThreshold bool   `json:"surplus_auction_threshold" yaml:"surplus_auction_threshold"`
+	SurplusAuctionLot       bool   `json:"surplus_auction_lot" yaml:"surplus_auction_lot"`
+	DebtAuctionThreshold    bool   `json:"debt_auction_threshold" yaml:"debt_auction_threshold"`
+	DebtAuctionLot          bool   `json:"debt_auction_lot" yaml:"debt_auction_lot"`
 }
 
+// Allows cdp DebtParam parameters than can be changed by committee
 func (adp AllowedDebtParam) Allows(current, incoming cdptypes.DebtParam) bool {
-	allowed := ((current.Denom == incoming.Denom) || adp.Denom) &&
+	allowed := ((adp.Denom == current.Denom) && (adp.Denom == incoming.Denom)) && // require denoms to be all equal
 		((current.ReferenceAsset == incoming.ReferenceAsset) || adp.ReferenceAsset) &&
 		(current.ConversionFactor.Equal(incoming.ConversionFactor) || adp.ConversionFactor) &&
 		(current.DebtFloor.Equal(incoming.DebtFloor) || adp.DebtFloor) &&
-		(current.SavingsRate.Equal(incoming.SavingsRate) || adp.SavingsRate)
+		(current.SavingsRate.Equal(incoming.SavingsRate) || adp.SavingsRate) &&
+		(current.SurplusAuctionThreshold.Equal(incoming.SurplusAuctionThreshold) || adp.SurplusAuctionThreshold) &&
+		(current.SurplusAuctionLot.Equal(incoming.SurplusAuctionLot) || adp.SurplusAuctionLot) &&
+		(current.DebtAuctionThreshold.Equal(incoming.DebtAuctionThreshold) || adp.DebtAuctionThreshold) &&
+		(current.DebtAuctionLot.Equal(incoming.DebtAuctionLot) || adp.DebtAuctionLot)
 	return allowed
 }
 
@@ -560,11 +700,16 @@ func (ams AllowedMarkets) Allows(current, incoming pricefeedtypes.Markets) bool
 }
 
 type AllowedMarket struct {
-	MarketID   string `json:"market_id" yaml:"market_id"`
-	BaseAsset  bool   `json:"base_asset" yaml:"base_asset"`
-	QuoteAsset bool   `json:"quote_asset" yaml:"quote_asset"`
-	Oracles    bool   `json:"oracles" yaml:"oracles"`
-	Active     bool   `json:"active" yaml:"active"`
+	MarketID           string `json:"market_id" yaml:"market_id"`
+	BaseAsset          bool   `json:"base_asset" yaml:"base_asset"`
+	QuoteAsset         bool   `json:"quote_asset" yaml:"quote_asset"`
+	Oracles            bool   `json:"oracles" yaml:"oracles"`
+	Active             bool   `json:"active" yaml:"active"`
+	MaxPriceChange     bool   `json:"max_price_change" yaml:"max_price_change"`
+	Quorum             bool   `json:"quorum" yaml:"quorum"`
+	MaxOracleDeviation bool   `json:"max_oracle_deviation" yaml:"max_oracle_deviation"`
+	OracleWeights      bool   `json:"oracle_weights" yaml:"oracle_weights"`
+	Sources            bool   `json:"sources" yaml:"sources"`
 }
 
 func (am AllowedMarket) Allows(current, incoming pricefeedtypes.Market) bool {
@@ -572,11 +717,117 @@ func (am AllowedMarket) Allows(current, incoming pricefeedtypes.Market) bool {
 		((current.BaseAsset == incoming.BaseAsset) || am.BaseAsset) &&
 		((current.QuoteAsset == incoming.QuoteAsset) || am.QuoteAsset) &&
 		(addressesEqual(current.Oracles, incoming.Oracles) || am.Oracles) &&
-		((current.Active == incoming.Active) || am.Active)
+		((current.Active == incoming.Active) || am.Active) &&
+		(decsEqual(current.MaxPriceChange, incoming.MaxPriceChange) || am.MaxPriceChange) &&
+		((current.Quorum == incoming.Quorum) || am.Quorum) &&
+		(decsEqual(current.MaxOracleDeviation, incoming.MaxOracleDeviation) || am.MaxOracleDeviation) &&
+		(intsEqual(current.OracleWeights, incoming.OracleWeights) || am.OracleWeights) &&
+		(marketSourcesEqual(current.Sources, incoming.Sources) || am.Sources)
+	return allowed
+}
+
+type AllowedCollateralAuctionParams []AllowedCollateralAuctionParam
+
+func (acaps AllowedCollateralAuctionParams) Allows(current, incoming auctiontypes.CollateralAuctionParams) bool {
+	allAllowed := true
+
+	// do not allow CollateralAuctionParams to be added or removed
+	// this checks both lists are the same size, then below checks each incoming matches a current
+	if len(incoming) != len(current) {
+		return false
+	}
+
+	// for each param struct, check it is allowed, and if it is not, check the value has not changed
+	for _, incomingCAP := range incoming {
+		// 1) check incoming cap is in list of allowed caps
+		var foundAllowedCAP bool
+		var allowedCAP AllowedCollateralAuctionParam
+		for _, p := range acaps {
+			if p.Denom != incomingCAP.Denom {
+				continue
+			}
+			foundAllowedCAP = true
+			allowedCAP = p
+		}
+		if !foundAllowedCAP {
+			// incoming had a CollateralAuctionParam that wasn't in the list of allowed ones
+			return false
+		}
+
+		// 2) Check incoming changes are individually allowed
+		// find existing CollateralAuctionParam
+		var foundCurrentCAP bool
+		var currentCAP auctiontypes.CollateralAuctionParam
+		for _, p := range current {
+			if p.Denom != incomingCAP.Denom {
+				continue
+			}
+			foundCurrentCAP = true
+			currentCAP = p
+		}
+		if !foundCurrentCAP {
+			return false // not allowed to add param to list
+		}
+		// check changed values are all allowed
+		allowed := allowedCAP.Allows(currentCAP, incomingCAP)
+
+		allAllowed = allAllowed && allowed
+	}
+	return allAllowed
+}
+
+// AllowedCollateralAuctionParam auction collateral auction parameters that can be changed by committee
+type AllowedCollateralAuctionParam struct {
+	Denom               string `json:"denom" yaml:"denom"`
+	IncrementCollateral bool   `json:"increment_collateral" yaml:"increment_collateral"`
+	BidDuration         bool   `json:"bid_duration" yaml:"bid_duration"`
+	MaxAuctionDuration  bool   `json:"max_auction_duration" yaml:"max_auction_duration"`
+}
+
+// NewAllowedCollateralAuctionParam returns a new AllowedCollateralAuctionParam
+func NewAllowedCollateralAuctionParam(denom string, incrementCollateral, bidDuration, maxAuctionDuration bool) AllowedCollateralAuctionParam {
+	return AllowedCollateralAuctionParam{
+		Denom:               denom,
+		IncrementCollateral: incrementCollateral,
+		BidDuration:         bidDuration,
+		MaxAuctionDuration:  maxAuctionDuration,
+	}
+}
+
+// Allows auction CollateralAuctionParam parameters than can be changed by committee
+func (acap AllowedCollateralAuctionParam) Allows(current, incoming auctiontypes.CollateralAuctionParam) bool {
+	allowed := ((acap.Denom == current.Denom) && (acap.Denom == incoming.Denom)) && // require denoms to be all equal
+		(current.IncrementCollateral.Equal(incoming.IncrementCollateral) || acap.IncrementCollateral) &&
+		((current.BidDuration == incoming.BidDuration) || acap.BidDuration) &&
+		((current.MaxAuctionDuration == incoming.MaxAuctionDuration) || acap.MaxAuctionDuration)
 	return allowed
 }
 
 // addressesEqual check if slices of addresses are equal, the order matters
+func marketSourcesEqual(sources1, sources2 pricefeedtypes.MarketSources) bool {
+	if len(sources1) != len(sources2) {
+		return false
+	}
+	for i := range sources1 {
+		if sources1[i] != sources2[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func intsEqual(ints1, ints2 []sdk.Int) bool {
+	if len(ints1) != len(ints2) {
+		return false
+	}
+	for i := range ints1 {
+		if !ints1[i].Equal(ints2[i]) {
+			return false
+		}
+	}
+	return true
+}
+
 func addressesEqual(addrs1, addrs2 []sdk.AccAddress) bool {
 	if len(addrs1) != len(addrs2) {
 		return false
@@ -1,16 +1,29 @@
 package harvest
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// BeginBlocker applies rewards to liquidity providers and delegators according to params
+// BeginBlocker applies rewards to liquidity providers and delegators according to params, and accrues interest on borrows
 func BeginBlocker(ctx sdk.Context, k Keeper) {
 	k.ApplyDepositRewards(ctx)
 	if k.ShouldDistributeValidatorRewards(ctx, k.BondDenom(ctx)) {
 		k.ApplyDelegationRewards(ctx, k.BondDenom(ctx))
 		k.SetPreviousDelegationDistribution(ctx, ctx.BlockTime(), k.BondDenom(ctx))
 	}
+	k.InitializeSuppliedCoins(ctx)
+	for _, mm := range k.GetParams(ctx).MoneyMarkets {
+		// a money market that fails to accrue interest is skipped until the next block, rather than halting the chain
+		cacheCtx, write := ctx.CacheContext()
+		err := k.AccrueInterest(cacheCtx, mm.Denom)
+		if err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("couldn't accrue interest for %s: %v", mm.Denom, err))
+			continue
+		}
+		write()
+	}
 	k.ApplyInterestRateUpdates(ctx)
 	k.SetPreviousBlockTime(ctx, ctx.BlockTime())
 }
@@ -75,14 +75,15 @@ var (
 
 	// variable aliases
 	BorrowsKeyPrefix                  = types.BorrowsKeyPrefix
+	ClaimTypesClaimQuery              = types.ClaimTypesClaimQuery
 	ClaimsKeyPrefix                   = types.ClaimsKeyPrefix
 	DefaultActive                     = types.DefaultActive
+	DefaultCloseFactor                = types.DefaultCloseFactor
 	DefaultDelegatorSchedules         = types.DefaultDelegatorSchedules
 	DefaultDistributionTimes          = types.DefaultDistributionTimes
 	DefaultGovSchedules               = types.DefaultGovSchedules
 	DefaultLPSchedules                = types.DefaultLPSchedules
 	DefaultPreviousBlockTime          = types.DefaultPreviousBlockTime
-	ClaimTypesClaimQuery              = types.ClaimTypesClaimQuery
 	DepositsKeyPrefix                 = types.DepositsKeyPrefix
 	ErrAccountNotFound                = types.ErrAccountNotFound
 	ErrClaimExpired                   = types.ErrClaimExpired
@@ -92,8 +93,8 @@ var (
 	ErrInsufficientModAccountBalance  = types.ErrInsufficientModAccountBalance
 	ErrInvaliWithdrawAmount           = types.ErrInvalidWithdrawAmount
 	ErrInvalidAccountType             = types.ErrInvalidAccountType
-	ErrInvalidDepositDenom            = types.ErrInvalidDepositDenom
 	ErrInvalidClaimType               = types.ErrInvalidClaimType
+	ErrInvalidDepositDenom            = types.ErrInvalidDepositDenom
 	ErrInvalidMultiplier              = types.ErrInvalidMultiplier
 	ErrLPScheduleNotFound             = types.ErrLPScheduleNotFound
 	ErrZeroClaim                      = types.ErrZeroClaim
@@ -41,6 +41,8 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		queryDepositsCmd(queryRoute, cdc),
 		queryClaimsCmd(queryRoute, cdc),
 		queryBorrowsCmd(queryRoute, cdc),
+		queryMaxWithdrawCmd(queryRoute, cdc),
+		queryAccountHealthCmd(queryRoute, cdc),
 	)...)
 
 	return harvestQueryCmd
@@ -325,3 +327,86 @@ func queryBorrowedCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		},
 	}
 }
+
+func queryMaxWithdrawCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "max-withdraw [owner-addr]",
+		Short: "query the maximum amount of each deposit an address can withdraw",
+		Long: strings.TrimSpace(`Query the maximum amount of each of an address's deposits that can be withdrawn without leaving its borrow over the loan-to-value limit.
+Each amount assumes the other deposits are left in place, so withdrawing the maximum of one deposit lowers the maximum of the others:
+
+		Example:
+		$ kvcli q harvest max-withdraw kava1l0xsq2z7gqd7yly0g40y5836g0appumark77ny`,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			owner, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			params := types.NewQueryMaxWithdrawParams(owner)
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			// Query
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryGetMaxWithdraw)
+			res, height, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+			cliCtx = cliCtx.WithHeight(height)
+
+			// Decode and print results
+			var maxWithdraw sdk.Coins
+			if err := cdc.UnmarshalJSON(res, &maxWithdraw); err != nil {
+				return fmt.Errorf("failed to unmarshal max withdraw: %w", err)
+			}
+			return cliCtx.PrintOutput(maxWithdraw)
+		},
+	}
+}
+
+func queryAccountHealthCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "account-health [owner-addr]",
+		Short: "query the value of an address's deposits and borrow and its distance to liquidation",
+		Long: strings.TrimSpace(`Query the USD value of an address's deposits and borrow, its borrow limit and remaining borrow capacity, its loan-to-value and how far its deposits can fall in value before it can be liquidated:
+
+		Example:
+		$ kvcli q harvest account-health kava1l0xsq2z7gqd7yly0g40y5836g0appumark77ny`,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			owner, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			params := types.NewQueryAccountHealthParams(owner)
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			// Query
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryGetAccountHealth)
+			res, height, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+			cliCtx = cliCtx.WithHeight(height)
+
+			// Decode and print results
+			var health types.AccountHealth
+			if err := cdc.UnmarshalJSON(res, &health); err != nil {
+				return fmt.Errorf("failed to unmarshal account health: %w", err)
+			}
+			return cliCtx.PrintOutput(health)
+		},
+	}
+}
@@ -34,6 +34,8 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 		getCmdWithdraw(cdc),
 		getCmdClaimReward(cdc),
 		getCmdBorrow(cdc),
+		getCmdRepay(cdc),
+		getCmdLiquidate(cdc),
 	)...)
 
 	return harvestTxCmd
@@ -144,3 +146,64 @@ func getCmdBorrow(cdc *codec.Codec) *cobra.Command {
 		},
 	}
 }
+
+func getCmdRepay(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "repay [owner-addr] [1000000000ukava]",
+		Short: "repay tokens borrowed from the harvest protocol",
+		Long:  strings.TrimSpace(`repays tokens borrowed from the harvest protocol by the owner, amounts greater than the amount owed are reduced to the amount owed`),
+		Args:  cobra.ExactArgs(2),
+		Example: fmt.Sprintf(
+			`%s tx %s repay kava1l0xsq2z7gqd7yly0g40y5836g0appumark77ny 1000000000ukava --from <key>`, version.ClientName, types.ModuleName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			owner, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			coins, err := sdk.ParseCoins(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRepay(cliCtx.GetFromAddress(), owner, coins)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+func getCmdLiquidate(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "liquidate [borrower-addr]",
+		Short: "liquidate a borrower that is over their loan-to-value limit",
+		Long:  strings.TrimSpace(`repays the whole borrow of a borrower that is over their loan-to-value limit, in exchange for the borrower's deposits at a discount`),
+		Args:  cobra.ExactArgs(1),
+		Example: fmt.Sprintf(
+			`%s tx %s liquidate kava1l0xsq2z7gqd7yly0g40y5836g0appumark77ny --from <key>`, version.ClientName, types.ModuleName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			borrower, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgLiquidate(cliCtx.GetFromAddress(), borrower)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
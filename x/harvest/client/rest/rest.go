@@ -48,3 +48,18 @@ type PostClaimReq struct {
 	DepositType  string         `json:"deposit_type" yaml:"deposit_type"`
 	Multiplier   string         `json:"multiplier" yaml:"multiplier"`
 }
+
+// PostRepayReq defines the properties of a repay request's body
+type PostRepayReq struct {
+	BaseReq rest.BaseReq   `json:"base_req" yaml:"base_req"`
+	From    sdk.AccAddress `json:"from" yaml:"from"`
+	Owner   sdk.AccAddress `json:"owner" yaml:"owner"`
+	Amount  sdk.Coins      `json:"amount" yaml:"amount"`
+}
+
+// PostLiquidateReq defines the properties of a liquidate request's body
+type PostLiquidateReq struct {
+	BaseReq  rest.BaseReq   `json:"base_req" yaml:"base_req"`
+	From     sdk.AccAddress `json:"from" yaml:"from"`
+	Borrower sdk.AccAddress `json:"borrower" yaml:"borrower"`
+}
@@ -11,6 +11,10 @@ import (
 
 // Borrow funds
 func (k Keeper) Borrow(ctx sdk.Context, borrower sdk.AccAddress, coins sdk.Coins) error {
+	// Sync the user's borrow and deposits so that limits are validated against the interest accrued so far
+	k.SyncBorrowInterest(ctx, borrower)
+	k.SyncSupplyInterest(ctx, borrower)
+
 	// Validate borrow amount within user and protocol limits
 	err := k.ValidateBorrow(ctx, borrower, coins)
 	if err != nil {
@@ -37,9 +41,10 @@ func (k Keeper) Borrow(ctx sdk.Context, borrower sdk.AccAddress, coins sdk.Coins
 	// Update user's borrow in store
 	borrow, found := k.GetBorrow(ctx, borrower)
 	if !found {
-		borrow = types.NewBorrow(borrower, coins)
+		borrow = types.NewBorrow(borrower, coins, k.getBorrowInterestFactors(ctx, coins))
 	} else {
 		borrow.Amount = borrow.Amount.Add(coins...)
+		borrow.Index = k.getBorrowInterestFactors(ctx, borrow.Amount)
 	}
 	k.SetBorrow(ctx, borrow)
 
@@ -63,45 +68,28 @@ func (k Keeper) ValidateBorrow(ctx sdk.Context, borrower sdk.AccAddress, amount
 		return types.ErrBorrowEmptyCoins
 	}
 
-	// Get the proposed borrow USD value
-	moneyMarketCache := map[string]types.MoneyMarket{}
-	proprosedBorrowUSDValue := sdk.ZeroDec()
+	// Validate the requested borrow of each asset against the money market's global borrow limit
+	totalBorrowedCoins, found := k.GetBorrowedCoins(ctx)
+	if !found {
+		totalBorrowedCoins = sdk.NewCoins()
+	}
 	for _, coin := range amount {
-		moneyMarket, ok := moneyMarketCache[coin.Denom]
-		// Fetch money market and store in local cache
-		if !ok {
-			newMoneyMarket, found := k.GetMoneyMarket(ctx, coin.Denom)
-			if !found {
-				return sdkerrors.Wrapf(types.ErrMarketNotFound, "no market found for denom %s", coin.Denom)
-			}
-			moneyMarketCache[coin.Denom] = newMoneyMarket
-			moneyMarket = newMoneyMarket
-		}
-
-		// Calculate this coin's USD value and add it borrow's total USD value
-		assetPriceInfo, err := k.pricefeedKeeper.GetCurrentPrice(ctx, moneyMarket.SpotMarketID)
-		if err != nil {
-			return sdkerrors.Wrapf(types.ErrPriceNotFound, "no price found for market %s", moneyMarket.SpotMarketID)
+		moneyMarket, found := k.GetMoneyMarket(ctx, coin.Denom)
+		if !found {
+			return sdkerrors.Wrapf(types.ErrMarketNotFound, "no market found for denom %s", coin.Denom)
 		}
-		coinUSDValue := sdk.NewDecFromInt(coin.Amount).Quo(sdk.NewDecFromInt(moneyMarket.ConversionFactor)).Mul(assetPriceInfo.Price)
-
-		// Validate the requested borrow value for the asset against the money market's global borrow limit
 		if moneyMarket.BorrowLimit.HasMaxLimit {
-			var assetTotalBorrowedAmount sdk.Int
-			totalBorrowedCoins, found := k.GetBorrowedCoins(ctx)
-			if !found {
-				assetTotalBorrowedAmount = sdk.ZeroInt()
-			} else {
-				assetTotalBorrowedAmount = totalBorrowedCoins.AmountOf(coin.Denom)
-			}
-			newProposedAssetTotalBorrowedAmount := sdk.NewDecFromInt(assetTotalBorrowedAmount.Add(coin.Amount))
+			newProposedAssetTotalBorrowedAmount := sdk.NewDecFromInt(totalBorrowedCoins.AmountOf(coin.Denom).Add(coin.Amount))
 			if newProposedAssetTotalBorrowedAmount.GT(moneyMarket.BorrowLimit.MaximumLimit) {
 				return sdkerrors.Wrapf(types.ErrGreaterThanAssetBorrowLimit,
 					"proposed borrow would result in %s borrowed, but the maximum global asset borrow limit is %s",
 					newProposedAssetTotalBorrowedAmount, moneyMarket.BorrowLimit.MaximumLimit)
 			}
 		}
-		proprosedBorrowUSDValue = proprosedBorrowUSDValue.Add(coinUSDValue)
+	}
+	proposedBorrowValue, err := k.getBorrowValue(ctx, amount)
+	if err != nil {
+		return err
 	}
 
 	// Get the total borrowable USD amount at user's existing deposits
@@ -109,57 +97,23 @@ func (k Keeper) ValidateBorrow(ctx sdk.Context, borrower sdk.AccAddress, amount
 	if len(deposits) == 0 {
 		return sdkerrors.Wrapf(types.ErrDepositsNotFound, "no deposits found for %s", borrower)
 	}
-	totalBorrowableAmount := sdk.ZeroDec()
-	for _, deposit := range deposits {
-		moneyMarket, ok := moneyMarketCache[deposit.Amount.Denom]
-		// Fetch money market and store in local cache
-		if !ok {
-			newMoneyMarket, found := k.GetMoneyMarket(ctx, deposit.Amount.Denom)
-			if !found {
-				return sdkerrors.Wrapf(types.ErrMarketNotFound, "no market found for denom %s", deposit.Amount.Denom)
-			}
-			moneyMarketCache[deposit.Amount.Denom] = newMoneyMarket
-			moneyMarket = newMoneyMarket
-		}
-
-		// Calculate the borrowable amount and add it to the user's total borrowable amount
-		assetPriceInfo, err := k.pricefeedKeeper.GetCurrentPrice(ctx, moneyMarket.SpotMarketID)
-		if err != nil {
-			sdkerrors.Wrapf(types.ErrPriceNotFound, "no price found for market %s", moneyMarket.SpotMarketID)
-		}
-		depositUSDValue := sdk.NewDecFromInt(deposit.Amount.Amount).Quo(sdk.NewDecFromInt(moneyMarket.ConversionFactor)).Mul(assetPriceInfo.Price)
-		borrowableAmountForDeposit := depositUSDValue.Mul(moneyMarket.BorrowLimit.LoanToValue)
-		totalBorrowableAmount = totalBorrowableAmount.Add(borrowableAmountForDeposit)
+	borrowLimit, err := k.getBorrowLimit(ctx, deposits)
+	if err != nil {
+		return err
 	}
 
 	// Get the total USD value of user's existing borrows
-	existingBorrowUSDValue := sdk.ZeroDec()
+	existingBorrowValue := sdk.ZeroDec()
 	existingBorrow, found := k.GetBorrow(ctx, borrower)
 	if found {
-		for _, borrowedCoin := range existingBorrow.Amount {
-			moneyMarket, ok := moneyMarketCache[borrowedCoin.Denom]
-			// Fetch money market and store in local cache
-			if !ok {
-				newMoneyMarket, found := k.GetMoneyMarket(ctx, borrowedCoin.Denom)
-				if !found {
-					return sdkerrors.Wrapf(types.ErrMarketNotFound, "no market found for denom %s", borrowedCoin.Denom)
-				}
-				moneyMarketCache[borrowedCoin.Denom] = newMoneyMarket
-				moneyMarket = newMoneyMarket
-			}
-
-			// Calculate this borrow coin's USD value and add it to the total previous borrowed USD value
-			assetPriceInfo, err := k.pricefeedKeeper.GetCurrentPrice(ctx, moneyMarket.SpotMarketID)
-			if err != nil {
-				return sdkerrors.Wrapf(types.ErrPriceNotFound, "no price found for market %s", moneyMarket.SpotMarketID)
-			}
-			coinUSDValue := sdk.NewDecFromInt(borrowedCoin.Amount).Quo(sdk.NewDecFromInt(moneyMarket.ConversionFactor)).Mul(assetPriceInfo.Price)
-			existingBorrowUSDValue = existingBorrowUSDValue.Add(coinUSDValue)
+		existingBorrowValue, err = k.getBorrowValue(ctx, existingBorrow.Amount)
+		if err != nil {
+			return err
 		}
 	}
 
 	// Validate that the proposed borrow's USD value is within user's borrowable limit
-	if proprosedBorrowUSDValue.GT(totalBorrowableAmount.Sub(existingBorrowUSDValue)) {
+	if proposedBorrowValue.GT(borrowLimit.Sub(existingBorrowValue)) {
 		return sdkerrors.Wrapf(types.ErrInsufficientLoanToValue, "requested borrow %s is greater than maximum valid borrow", amount)
 	}
 	return nil
@@ -23,12 +23,14 @@ func (k Keeper) Deposit(ctx sdk.Context, depositor sdk.AccAddress, amount sdk.Co
 
 	deposit, found := k.GetDeposit(ctx, depositor, amount.Denom)
 	if !found {
-		deposit = types.NewDeposit(depositor, amount)
+		deposit = types.NewDeposit(depositor, amount, k.getSupplyInterestFactor(ctx, amount.Denom))
 	} else {
+		deposit = k.syncDepositInterest(ctx, deposit)
 		deposit.Amount = deposit.Amount.Add(amount)
 	}
 
 	k.SetDeposit(ctx, deposit)
+	k.IncrementSuppliedCoins(ctx, sdk.NewCoins(amount))
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -55,6 +57,10 @@ func (k Keeper) ValidateDeposit(ctx sdk.Context, amount sdk.Coin) error {
 
 // Withdraw returns some or all of a deposit back to original depositor
 func (k Keeper) Withdraw(ctx sdk.Context, depositor sdk.AccAddress, amount sdk.Coin) error {
+	// Sync the deposits and borrow so that the withdrawal is validated against the interest accrued so far
+	k.SyncSupplyInterest(ctx, depositor)
+	k.SyncBorrowInterest(ctx, depositor)
+
 	deposit, found := k.GetDeposit(ctx, depositor, amount.Denom)
 	if !found {
 		return sdkerrors.Wrapf(types.ErrDepositNotFound, "no %s deposit found for %s", amount.Denom, depositor)
@@ -63,7 +69,17 @@ func (k Keeper) Withdraw(ctx sdk.Context, depositor sdk.AccAddress, amount sdk.C
 		return sdkerrors.Wrapf(types.ErrInvalidWithdrawAmount, "%s>%s", amount, deposit.Amount)
 	}
 
-	err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleAccountName, depositor, sdk.NewCoins(amount))
+	err := k.ValidateWithdraw(ctx, depositor, amount)
+	if err != nil {
+		return err
+	}
+
+	err = k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleAccountName, depositor, sdk.NewCoins(amount))
+	if err != nil {
+		return err
+	}
+
+	err = k.DecrementSuppliedCoins(ctx, sdk.NewCoins(amount))
 	if err != nil {
 		return err
 	}
@@ -95,6 +111,127 @@ func (k Keeper) Withdraw(ctx sdk.Context, depositor sdk.AccAddress, amount sdk.C
 	return nil
 }
 
+// ValidateWithdraw validates that a withdrawal leaves the depositor's borrow within the loan-to-value limit of their remaining deposits
+func (k Keeper) ValidateWithdraw(ctx sdk.Context, depositor sdk.AccAddress, amount sdk.Coin) error {
+	borrow, found := k.GetBorrow(ctx, depositor)
+	if !found {
+		return nil
+	}
+	borrowValue, err := k.getBorrowValue(ctx, borrow.Amount)
+	if err != nil {
+		return err
+	}
+
+	var remainingDeposits []types.Deposit
+	for _, deposit := range k.GetDepositsByUser(ctx, depositor) {
+		if deposit.Amount.Denom == amount.Denom {
+			deposit.Amount = deposit.Amount.Sub(amount)
+		}
+		remainingDeposits = append(remainingDeposits, deposit)
+	}
+	borrowLimit, err := k.getBorrowLimit(ctx, remainingDeposits)
+	if err != nil {
+		return err
+	}
+
+	if borrowValue.GT(borrowLimit) {
+		return sdkerrors.Wrapf(types.ErrWithdrawExceedsBorrowLimit,
+			"withdrawing %s would leave borrow value %s over the borrow limit %s", amount, borrowValue, borrowLimit)
+	}
+	return nil
+}
+
+// GetMaxWithdraw returns the largest amount of each of a depositor's deposits that can be withdrawn without leaving their
+// borrow over the loan-to-value limit of their remaining deposits. Interest accrued since the last sync is included, and
+// deposits that cannot be withdrawn at all are left out. Each amount assumes the other deposits are left in place, so the
+// amounts are not jointly withdrawable: withdrawing the maximum of one deposit lowers the maximum of the others.
+func (k Keeper) GetMaxWithdraw(ctx sdk.Context, depositor sdk.AccAddress) (sdk.Coins, error) {
+	var deposits []types.Deposit
+	for _, deposit := range k.GetDepositsByUser(ctx, depositor) {
+		deposits = append(deposits, k.syncDepositInterest(ctx, deposit))
+	}
+
+	maxWithdraw := sdk.NewCoins()
+	borrow, found := k.GetBorrow(ctx, depositor)
+	if !found {
+		for _, deposit := range deposits {
+			maxWithdraw = maxWithdraw.Add(deposit.Amount)
+		}
+		return maxWithdraw, nil
+	}
+
+	borrow = k.syncBorrowInterest(ctx, borrow)
+	borrowValue, err := k.getBorrowValue(ctx, borrow.Amount)
+	if err != nil {
+		return sdk.Coins{}, err
+	}
+	borrowLimit, err := k.getBorrowLimit(ctx, deposits)
+	if err != nil {
+		return sdk.Coins{}, err
+	}
+	headroom := borrowLimit.Sub(borrowValue)
+	if !headroom.IsPositive() {
+		return maxWithdraw, nil
+	}
+
+	// Each deposit can be withdrawn until the borrow limit it removes uses up the headroom
+	for _, deposit := range deposits {
+		depositValue, moneyMarket, err := k.getCoinValue(ctx, deposit.Amount)
+		if err != nil {
+			return sdk.Coins{}, err
+		}
+		depositBorrowLimit := depositValue.Mul(moneyMarket.BorrowLimit.LoanToValue)
+		if depositBorrowLimit.LTE(headroom) {
+			maxWithdraw = maxWithdraw.Add(deposit.Amount)
+			continue
+		}
+		withdrawAmount := sdk.NewDecFromInt(deposit.Amount.Amount).Mul(headroom).Quo(depositBorrowLimit).TruncateInt()
+		maxWithdraw = maxWithdraw.Add(sdk.NewCoin(deposit.Amount.Denom, withdrawAmount))
+	}
+	return maxWithdraw, nil
+}
+
+// IncrementSuppliedCoins increments the amount of supplied coins by the newCoins parameter
+func (k Keeper) IncrementSuppliedCoins(ctx sdk.Context, newCoins sdk.Coins) {
+	suppliedCoins, found := k.GetSuppliedCoins(ctx)
+	if !found {
+		k.SetSuppliedCoins(ctx, newCoins)
+	} else {
+		k.SetSuppliedCoins(ctx, suppliedCoins.Add(newCoins...))
+	}
+}
+
+// InitializeSuppliedCoins sets the supplied coins to the sum of all deposits if they have not been set.
+// Deposits made before the supplied coins were tracked would otherwise earn no supplier interest and could not be withdrawn.
+func (k Keeper) InitializeSuppliedCoins(ctx sdk.Context) {
+	_, found := k.GetSuppliedCoins(ctx)
+	if found {
+		return
+	}
+	suppliedCoins := sdk.NewCoins()
+	k.IterateDeposits(ctx, func(deposit types.Deposit) bool {
+		suppliedCoins = suppliedCoins.Add(deposit.Amount)
+		return false
+	})
+	k.SetSuppliedCoins(ctx, suppliedCoins)
+}
+
+// DecrementSuppliedCoins decrements the amount of supplied coins by the coins parameter
+func (k Keeper) DecrementSuppliedCoins(ctx sdk.Context, coins sdk.Coins) error {
+	suppliedCoins, found := k.GetSuppliedCoins(ctx)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrSuppliedCoinsNotFound, "cannot withdraw coins if no coins are currently supplied")
+	}
+
+	updatedSuppliedCoins, isAnyNegative := suppliedCoins.SafeSub(coins)
+	if isAnyNegative {
+		return types.ErrNegativeSuppliedCoins
+	}
+
+	k.SetSuppliedCoins(ctx, updatedSuppliedCoins)
+	return nil
+}
+
 // GetTotalDeposited returns the total amount deposited for the input deposit type and deposit denom
 func (k Keeper) GetTotalDeposited(ctx sdk.Context, depositDenom string) (total sdk.Int) {
 	var macc supplyExported.ModuleAccountI
@@ -11,6 +11,7 @@ import (
 
 	"github.com/kava-labs/kava/app"
 	"github.com/kava-labs/kava/x/harvest/types"
+	"github.com/kava-labs/kava/x/pricefeed"
 )
 
 func (suite *KeeperTestSuite) TestDeposit() {
@@ -108,9 +109,10 @@ func (suite *KeeperTestSuite) TestDeposit() {
 				),
 				},
 				types.MoneyMarkets{
-					types.NewMoneyMarket("usdx", false, sdk.NewDec(1000000000000000), loanToValue, "usdx:usd", sdk.NewInt(1000000), types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10"))),
-					types.NewMoneyMarket("ukava", false, sdk.NewDec(1000000000000000), loanToValue, "kava:usd", sdk.NewInt(1000000), types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10"))),
+					types.NewMoneyMarket("usdx", false, sdk.NewDec(1000000000000000), loanToValue, "usdx:usd", sdk.NewInt(1000000), types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")), sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("0.05")),
+					types.NewMoneyMarket("ukava", false, sdk.NewDec(1000000000000000), loanToValue, "kava:usd", sdk.NewInt(1000000), types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")), sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("0.05")),
 				},
+				types.DefaultCloseFactor,
 			), types.DefaultPreviousBlockTime, types.DefaultDistributionTimes)
 			tApp.InitializeFromGenesisStates(authGS, app.GenesisState{types.ModuleName: types.ModuleCdc.MustMarshalJSON(harvestGS)})
 			keeper := tApp.GetHarvestKeeper()
@@ -251,9 +253,10 @@ func (suite *KeeperTestSuite) TestWithdraw() {
 				),
 				},
 				types.MoneyMarkets{
-					types.NewMoneyMarket("usdx", false, sdk.NewDec(1000000000000000), loanToValue, "usdx:usd", sdk.NewInt(1000000), types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10"))),
-					types.NewMoneyMarket("ukava", false, sdk.NewDec(1000000000000000), loanToValue, "kava:usd", sdk.NewInt(1000000), types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10"))),
+					types.NewMoneyMarket("usdx", false, sdk.NewDec(1000000000000000), loanToValue, "usdx:usd", sdk.NewInt(1000000), types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")), sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("0.05")),
+					types.NewMoneyMarket("ukava", false, sdk.NewDec(1000000000000000), loanToValue, "kava:usd", sdk.NewInt(1000000), types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")), sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("0.05")),
 				},
+				types.DefaultCloseFactor,
 			), types.DefaultPreviousBlockTime, types.DefaultDistributionTimes)
 			tApp.InitializeFromGenesisStates(authGS, app.GenesisState{types.ModuleName: types.ModuleCdc.MustMarshalJSON(harvestGS)})
 			keeper := tApp.GetHarvestKeeper()
@@ -289,3 +292,173 @@ func (suite *KeeperTestSuite) TestWithdraw() {
 
 	}
 }
+
+func (suite *KeeperTestSuite) TestWithdrawWithBorrow() {
+	type args struct {
+		borrowCoins         sdk.Coins
+		withdrawAmount      sdk.Coin
+		expectedMaxWithdraw sdk.Coins
+		depositExists       bool
+		finalDepositAmount  sdk.Coin
+	}
+	type errArgs struct {
+		expectPass bool
+		contains   string
+	}
+	type withdrawTest struct {
+		name    string
+		args    args
+		errArgs errArgs
+	}
+	depositor := sdk.AccAddress(crypto.AddressHash([]byte("test")))
+	testCases := []withdrawTest{
+		{
+			"valid: no borrow",
+			args{
+				borrowCoins:         sdk.NewCoins(),
+				withdrawAmount:      sdk.NewCoin("ukava", sdk.NewInt(100*KAVA_CF)),
+				expectedMaxWithdraw: sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(100*KAVA_CF))),
+				depositExists:       false,
+				finalDepositAmount:  sdk.Coin{},
+			},
+			errArgs{
+				expectPass: true,
+				contains:   "",
+			},
+		},
+		{
+			"valid: withdraw up to the borrow limit",
+			args{
+				borrowCoins:    sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(120*USDX_CF))),
+				withdrawAmount: sdk.NewCoin("ukava", sdk.NewInt(25*KAVA_CF)),
+				// 100 KAVA x $2.00 price x 0.8 loan-to-value = $160 borrow limit, $40 over the $120 borrow is 25 KAVA
+				expectedMaxWithdraw: sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(25*KAVA_CF))),
+				depositExists:       true,
+				finalDepositAmount:  sdk.NewCoin("ukava", sdk.NewInt(75*KAVA_CF)),
+			},
+			errArgs{
+				expectPass: true,
+				contains:   "",
+			},
+		},
+		{
+			"invalid: withdraw over the borrow limit",
+			args{
+				borrowCoins:         sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(120*USDX_CF))),
+				withdrawAmount:      sdk.NewCoin("ukava", sdk.NewInt(26*KAVA_CF)),
+				expectedMaxWithdraw: sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(25*KAVA_CF))),
+				depositExists:       false,
+				finalDepositAmount:  sdk.Coin{},
+			},
+			errArgs{
+				expectPass: false,
+				contains:   "withdrawal would leave borrow over the loan-to-value limit",
+			},
+		},
+		{
+			"invalid: borrow at the borrow limit",
+			args{
+				borrowCoins:         sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(160*USDX_CF))),
+				withdrawAmount:      sdk.NewCoin("ukava", sdk.NewInt(1)),
+				expectedMaxWithdraw: sdk.NewCoins(),
+				depositExists:       false,
+				finalDepositAmount:  sdk.Coin{},
+			},
+			errArgs{
+				expectPass: false,
+				contains:   "withdrawal would leave borrow over the loan-to-value limit",
+			},
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			// Initialize test app and set context
+			tApp := app.NewTestApp()
+			ctx := tApp.NewContext(true, abci.Header{Height: 1, Time: tmtime.Now()})
+
+			// Auth module genesis state
+			authGS := app.NewAuthGenState(
+				[]sdk.AccAddress{depositor},
+				[]sdk.Coins{sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(100*KAVA_CF)))})
+
+			// Harvest module genesis state
+			harvestGS := types.NewGenesisState(types.NewParams(
+				true,
+				types.DistributionSchedules{
+					types.NewDistributionSchedule(true, "ukava", time.Date(2020, 10, 8, 14, 0, 0, 0, time.UTC), time.Date(2020, 11, 22, 14, 0, 0, 0, time.UTC), sdk.NewCoin("hard", sdk.NewInt(5000)), time.Date(2021, 11, 22, 14, 0, 0, 0, time.UTC), types.Multipliers{types.NewMultiplier(types.Small, 0, sdk.MustNewDecFromStr("0.33")), types.NewMultiplier(types.Medium, 6, sdk.MustNewDecFromStr("0.5")), types.NewMultiplier(types.Medium, 24, sdk.OneDec())}),
+				},
+				types.DelegatorDistributionSchedules{},
+				types.MoneyMarkets{
+					types.NewMoneyMarket("usdx", false, sdk.NewDec(100000000*USDX_CF), sdk.MustNewDecFromStr("1"), "usdx:usd", sdk.NewInt(USDX_CF), types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")), sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("0.05")),
+					types.NewMoneyMarket("ukava", false, sdk.NewDec(100000000*KAVA_CF), sdk.MustNewDecFromStr("0.8"), "kava:usd", sdk.NewInt(KAVA_CF), types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")), sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("0.05")),
+				},
+				types.DefaultCloseFactor,
+			), types.DefaultPreviousBlockTime, types.DefaultDistributionTimes)
+
+			// Pricefeed module genesis state
+			pricefeedGS := pricefeed.GenesisState{
+				Params: pricefeed.Params{
+					Markets: []pricefeed.Market{
+						{MarketID: "usdx:usd", BaseAsset: "usdx", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+						{MarketID: "kava:usd", BaseAsset: "kava", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+					},
+				},
+				PostedPrices: []pricefeed.PostedPrice{
+					{
+						MarketID:      "usdx:usd",
+						OracleAddress: sdk.AccAddress{},
+						Price:         sdk.MustNewDecFromStr("1.00"),
+						Expiry:        time.Now().Add(1 * time.Hour),
+					},
+					{
+						MarketID:      "kava:usd",
+						OracleAddress: sdk.AccAddress{},
+						Price:         sdk.MustNewDecFromStr("2.00"),
+						Expiry:        time.Now().Add(1 * time.Hour),
+					},
+				},
+			}
+
+			// Initialize test application
+			tApp.InitializeFromGenesisStates(authGS,
+				app.GenesisState{pricefeed.ModuleName: pricefeed.ModuleCdc.MustMarshalJSON(pricefeedGS)},
+				app.GenesisState{types.ModuleName: types.ModuleCdc.MustMarshalJSON(harvestGS)})
+
+			// Mint coins to Harvest module account
+			supplyKeeper := tApp.GetSupplyKeeper()
+			supplyKeeper.MintCoins(ctx, types.ModuleAccountName, sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(200*USDX_CF))))
+
+			keeper := tApp.GetHarvestKeeper()
+			suite.app = tApp
+			suite.ctx = ctx
+			suite.keeper = keeper
+
+			err := suite.keeper.Deposit(suite.ctx, depositor, sdk.NewCoin("ukava", sdk.NewInt(100*KAVA_CF)))
+			suite.Require().NoError(err)
+			if !tc.args.borrowCoins.IsZero() {
+				err = suite.keeper.Borrow(suite.ctx, depositor, tc.args.borrowCoins)
+				suite.Require().NoError(err)
+			}
+
+			maxWithdraw, err := suite.keeper.GetMaxWithdraw(suite.ctx, depositor)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.args.expectedMaxWithdraw, maxWithdraw)
+
+			err = suite.keeper.Withdraw(suite.ctx, depositor, tc.args.withdrawAmount)
+
+			if tc.errArgs.expectPass {
+				suite.Require().NoError(err)
+				deposit, f := suite.keeper.GetDeposit(suite.ctx, depositor, "ukava")
+				if tc.args.depositExists {
+					suite.Require().True(f)
+					suite.Require().Equal(tc.args.finalDepositAmount, deposit.Amount)
+				} else {
+					suite.Require().False(f)
+				}
+			} else {
+				suite.Require().Error(err)
+				suite.Require().True(strings.Contains(err.Error(), tc.errArgs.contains), err.Error())
+			}
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/harvest/types"
+)
+
+// GetAccountHealth returns the USD value of an address's deposits and borrow, including the interest accrued since they
+// were last synced, along with its borrow limit and how far its deposits can fall in value before it can be liquidated.
+// An error is returned if the price of any of the address's coins is missing or stale.
+func (k Keeper) GetAccountHealth(ctx sdk.Context, owner sdk.AccAddress) (types.AccountHealth, error) {
+	deposits := types.CoinValues{}
+	depositValue := sdk.ZeroDec()
+	borrowLimit := sdk.ZeroDec()
+	for _, deposit := range k.GetDepositsByUser(ctx, owner) {
+		deposit = k.syncDepositInterest(ctx, deposit)
+		coinValue, moneyMarket, err := k.getCoinValue(ctx, deposit.Amount)
+		if err != nil {
+			return types.AccountHealth{}, err
+		}
+		deposits = append(deposits, types.NewCoinValue(deposit.Amount, coinValue))
+		depositValue = depositValue.Add(coinValue)
+		borrowLimit = borrowLimit.Add(coinValue.Mul(moneyMarket.BorrowLimit.LoanToValue))
+	}
+
+	borrows := types.CoinValues{}
+	borrowValue := sdk.ZeroDec()
+	borrow, found := k.GetBorrow(ctx, owner)
+	if found {
+		borrow = k.syncBorrowInterest(ctx, borrow)
+		for _, coin := range borrow.Amount {
+			coinValue, _, err := k.getCoinValue(ctx, coin)
+			if err != nil {
+				return types.AccountHealth{}, err
+			}
+			borrows = append(borrows, types.NewCoinValue(coin, coinValue))
+			borrowValue = borrowValue.Add(coinValue)
+		}
+	}
+
+	borrowCapacity := sdk.MaxDec(borrowLimit.Sub(borrowValue), sdk.ZeroDec())
+	loanToValue := sdk.ZeroDec()
+	if depositValue.IsPositive() {
+		loanToValue = borrowValue.Quo(depositValue)
+	}
+	// The borrow limit falls in proportion to the deposit value, and the borrow can be liquidated once it is below the borrow value
+	liquidationDistance := sdk.ZeroDec()
+	if borrowLimit.IsPositive() {
+		liquidationDistance = sdk.MaxDec(sdk.OneDec().Sub(borrowValue.Quo(borrowLimit)), sdk.ZeroDec())
+	}
+
+	return types.NewAccountHealth(owner, deposits, borrows, depositValue, borrowValue, borrowLimit,
+		borrowCapacity, loanToValue, liquidationDistance), nil
+}
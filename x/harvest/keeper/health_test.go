@@ -0,0 +1,181 @@
+package keeper_test
+
+import (
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+	tmtime "github.com/tendermint/tendermint/types/time"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/harvest/types"
+	"github.com/kava-labs/kava/x/pricefeed"
+)
+
+func (suite *KeeperTestSuite) TestGetAccountHealth() {
+	type args struct {
+		borrowCoins     sdk.Coins
+		expirePriceKAVA bool
+		expectedHealth  types.AccountHealth
+	}
+	type errArgs struct {
+		expectPass bool
+		contains   string
+	}
+	type accountHealthTest struct {
+		name    string
+		args    args
+		errArgs errArgs
+	}
+	owner := sdk.AccAddress(crypto.AddressHash([]byte("test")))
+	testCases := []accountHealthTest{
+		{
+			"valid: no borrow",
+			args{
+				borrowCoins:     sdk.NewCoins(),
+				expirePriceKAVA: false,
+				expectedHealth: types.NewAccountHealth(
+					owner,
+					types.CoinValues{types.NewCoinValue(sdk.NewCoin("ukava", sdk.NewInt(100*KAVA_CF)), sdk.MustNewDecFromStr("200"))},
+					types.CoinValues{},
+					sdk.MustNewDecFromStr("200"),
+					sdk.ZeroDec(),
+					sdk.MustNewDecFromStr("160"),
+					sdk.MustNewDecFromStr("160"),
+					sdk.ZeroDec(),
+					sdk.OneDec(),
+				),
+			},
+			errArgs{
+				expectPass: true,
+				contains:   "",
+			},
+		},
+		{
+			"valid: borrow",
+			args{
+				borrowCoins:     sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(120*USDX_CF))),
+				expirePriceKAVA: false,
+				// 100 KAVA x $2.00 price x 0.8 loan-to-value = $160 borrow limit, which can fall by 25% to the $120 borrow
+				expectedHealth: types.NewAccountHealth(
+					owner,
+					types.CoinValues{types.NewCoinValue(sdk.NewCoin("ukava", sdk.NewInt(100*KAVA_CF)), sdk.MustNewDecFromStr("200"))},
+					types.CoinValues{types.NewCoinValue(sdk.NewCoin("usdx", sdk.NewInt(120*USDX_CF)), sdk.MustNewDecFromStr("120"))},
+					sdk.MustNewDecFromStr("200"),
+					sdk.MustNewDecFromStr("120"),
+					sdk.MustNewDecFromStr("160"),
+					sdk.MustNewDecFromStr("40"),
+					sdk.MustNewDecFromStr("0.6"),
+					sdk.MustNewDecFromStr("0.25"),
+				),
+			},
+			errArgs{
+				expectPass: true,
+				contains:   "",
+			},
+		},
+		{
+			"invalid: expired deposit price",
+			args{
+				borrowCoins:     sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(120*USDX_CF))),
+				expirePriceKAVA: true,
+				expectedHealth:  types.AccountHealth{},
+			},
+			errArgs{
+				expectPass: false,
+				contains:   "no price found for market",
+			},
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			// Initialize test app and set context
+			tApp := app.NewTestApp()
+			ctx := tApp.NewContext(true, abci.Header{Height: 1, Time: tmtime.Now()})
+
+			// Auth module genesis state
+			authGS := app.NewAuthGenState(
+				[]sdk.AccAddress{owner},
+				[]sdk.Coins{sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(100*KAVA_CF)))})
+
+			// Harvest module genesis state
+			harvestGS := types.NewGenesisState(types.NewParams(
+				true,
+				types.DistributionSchedules{
+					types.NewDistributionSchedule(true, "ukava", time.Date(2020, 10, 8, 14, 0, 0, 0, time.UTC), time.Date(2020, 11, 22, 14, 0, 0, 0, time.UTC), sdk.NewCoin("hard", sdk.NewInt(5000)), time.Date(2021, 11, 22, 14, 0, 0, 0, time.UTC), types.Multipliers{types.NewMultiplier(types.Small, 0, sdk.MustNewDecFromStr("0.33")), types.NewMultiplier(types.Medium, 6, sdk.MustNewDecFromStr("0.5")), types.NewMultiplier(types.Medium, 24, sdk.OneDec())}),
+				},
+				types.DelegatorDistributionSchedules{},
+				types.MoneyMarkets{
+					types.NewMoneyMarket("usdx", false, sdk.NewDec(100000000*USDX_CF), sdk.MustNewDecFromStr("1"), "usdx:usd", sdk.NewInt(USDX_CF), types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")), sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("0.05")),
+					types.NewMoneyMarket("ukava", false, sdk.NewDec(100000000*KAVA_CF), sdk.MustNewDecFromStr("0.8"), "kava:usd", sdk.NewInt(KAVA_CF), types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")), sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("0.05")),
+				},
+				types.DefaultCloseFactor,
+			), types.DefaultPreviousBlockTime, types.DefaultDistributionTimes)
+
+			// Pricefeed module genesis state
+			pricefeedGS := pricefeed.GenesisState{
+				Params: pricefeed.Params{
+					Markets: []pricefeed.Market{
+						{MarketID: "usdx:usd", BaseAsset: "usdx", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+						{MarketID: "kava:usd", BaseAsset: "kava", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+					},
+				},
+				PostedPrices: []pricefeed.PostedPrice{
+					{
+						MarketID:      "usdx:usd",
+						OracleAddress: sdk.AccAddress{},
+						Price:         sdk.MustNewDecFromStr("1.00"),
+						Expiry:        time.Now().Add(100 * time.Hour),
+					},
+					{
+						MarketID:      "kava:usd",
+						OracleAddress: sdk.AccAddress{},
+						Price:         sdk.MustNewDecFromStr("2.00"),
+						Expiry:        time.Now().Add(1 * time.Hour),
+					},
+				},
+			}
+
+			// Initialize test application
+			tApp.InitializeFromGenesisStates(authGS,
+				app.GenesisState{pricefeed.ModuleName: pricefeed.ModuleCdc.MustMarshalJSON(pricefeedGS)},
+				app.GenesisState{types.ModuleName: types.ModuleCdc.MustMarshalJSON(harvestGS)})
+
+			// Mint coins to Harvest module account
+			supplyKeeper := tApp.GetSupplyKeeper()
+			supplyKeeper.MintCoins(ctx, types.ModuleAccountName, sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(200*USDX_CF))))
+
+			keeper := tApp.GetHarvestKeeper()
+			suite.app = tApp
+			suite.ctx = ctx
+			suite.keeper = keeper
+
+			err := suite.keeper.Deposit(suite.ctx, owner, sdk.NewCoin("ukava", sdk.NewInt(100*KAVA_CF)))
+			suite.Require().NoError(err)
+			if !tc.args.borrowCoins.IsZero() {
+				err = suite.keeper.Borrow(suite.ctx, owner, tc.args.borrowCoins)
+				suite.Require().NoError(err)
+			}
+
+			// Expire the KAVA price, which clears the current price of the market
+			if tc.args.expirePriceKAVA {
+				suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(2 * time.Hour))
+				pricefeedKeeper := tApp.GetPriceFeedKeeper()
+				err = pricefeedKeeper.SetCurrentPrices(suite.ctx, "kava:usd")
+				suite.Require().Error(err)
+			}
+
+			health, err := suite.keeper.GetAccountHealth(suite.ctx, owner)
+
+			if tc.errArgs.expectPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.args.expectedHealth, health)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().True(strings.Contains(err.Error(), tc.errArgs.contains), err.Error())
+			}
+		})
+	}
+}
@@ -2,9 +2,14 @@ package keeper
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
 	"github.com/kava-labs/kava/x/harvest/types"
 )
 
+// SecondsPerYear is the number of seconds in a year
+const SecondsPerYear = uint64(31536000)
+
 // ApplyInterestRateUpdates translates the current interest rate models from the params to the store
 func (k Keeper) ApplyInterestRateUpdates(ctx sdk.Context) {
 	denomSet := map[string]bool{}
@@ -29,3 +34,221 @@ func (k Keeper) ApplyInterestRateUpdates(ctx sdk.Context) {
 		return false
 	})
 }
+
+// AccrueInterest applies accrued interest to total borrows, supplies and reserves by calculating
+// interest from the last checkpoint time and writing the updated values to the store.
+func (k Keeper) AccrueInterest(ctx sdk.Context, denom string) error {
+	previousAccrualTime, found := k.GetPreviousAccrualTime(ctx, denom)
+	if !found {
+		k.SetPreviousAccrualTime(ctx, denom, ctx.BlockTime())
+		return nil
+	}
+
+	timeElapsed := ctx.BlockTime().Unix() - previousAccrualTime.Unix()
+	if timeElapsed <= 0 {
+		return nil
+	}
+
+	// Get current protocol state and hold in memory as 'prior'
+	cashPrior := k.supplyKeeper.GetModuleAccount(ctx, types.ModuleAccountName).GetCoins().AmountOf(denom)
+
+	borrowedCoinsPrior := sdk.NewCoin(denom, sdk.ZeroInt())
+	borrowedCoins, found := k.GetBorrowedCoins(ctx)
+	if found {
+		borrowedCoinsPrior = sdk.NewCoin(denom, borrowedCoins.AmountOf(denom))
+	}
+	if borrowedCoinsPrior.IsZero() {
+		k.SetPreviousAccrualTime(ctx, denom, ctx.BlockTime())
+		return nil
+	}
+
+	reservesPrior, found := k.GetTotalReserves(ctx)
+	if !found {
+		reservesPrior = sdk.NewCoins()
+	}
+
+	borrowInterestFactorPrior, found := k.GetBorrowInterestFactor(ctx, denom)
+	if !found {
+		borrowInterestFactorPrior = sdk.OneDec()
+	}
+
+	// Fetch money market from the store
+	mm, found := k.GetMoneyMarket(ctx, denom)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrMarketNotFound, "%s", denom)
+	}
+	model, found := k.GetInterestRateModel(ctx, denom)
+	if !found {
+		model = mm.InterestRateModel
+	}
+
+	// GetBorrowRate calculates the current interest rate based on utilization (the fraction of supply that has been borrowed)
+	borrowRateApy, err := CalculateBorrowRate(model, sdk.NewDecFromInt(cashPrior), sdk.NewDecFromInt(borrowedCoinsPrior.Amount), sdk.NewDecFromInt(reservesPrior.AmountOf(denom)))
+	if err != nil {
+		return err
+	}
+
+	// Convert from APY to SPY, expressed as (1 + borrow rate)
+	borrowRateSpy, err := APYToSPY(borrowRateApy)
+	if err != nil {
+		return err
+	}
+
+	interestFactor := CalculateInterestFactor(borrowRateSpy, uint64(timeElapsed))
+	// Round the accumulated interest up so that the total borrowed is never less than the sum of every borrow
+	interestBorrowAccumulated := interestFactor.Sub(sdk.OneDec()).MulInt(borrowedCoinsPrior.Amount).Ceil().TruncateInt()
+	if interestBorrowAccumulated.IsZero() {
+		// Leave the previous accrual time unchanged so that interest keeps accruing on small borrows
+		return nil
+	}
+	reservesNew := sdk.NewDecFromInt(interestBorrowAccumulated).Mul(mm.ReserveFactor).TruncateInt()
+	interestSupplyAccumulated := interestBorrowAccumulated.Sub(reservesNew)
+
+	// Credit the rest of the interest to suppliers, or to the reserves if nothing is supplied
+	suppliedCoins, found := k.GetSuppliedCoins(ctx)
+	if !found {
+		suppliedCoins = sdk.NewCoins()
+	}
+	suppliedPrior := suppliedCoins.AmountOf(denom)
+	if suppliedPrior.IsPositive() {
+		supplyInterestFactorPrior, found := k.GetSupplyInterestFactor(ctx, denom)
+		if !found {
+			supplyInterestFactorPrior = sdk.OneDec()
+		}
+		supplyInterestFactor := sdk.OneDec().Add(sdk.NewDecFromInt(interestSupplyAccumulated).QuoInt(suppliedPrior))
+		k.SetSupplyInterestFactor(ctx, denom, supplyInterestFactorPrior.Mul(supplyInterestFactor))
+		k.SetSuppliedCoins(ctx, suppliedCoins.Add(sdk.NewCoin(denom, interestSupplyAccumulated)))
+	} else {
+		reservesNew = interestBorrowAccumulated
+	}
+
+	k.SetBorrowInterestFactor(ctx, denom, borrowInterestFactorPrior.Mul(interestFactor))
+	k.IncrementBorrowedCoins(ctx, sdk.NewCoins(sdk.NewCoin(denom, interestBorrowAccumulated)))
+	if reservesNew.IsPositive() {
+		k.SetTotalReserves(ctx, reservesPrior.Add(sdk.NewCoin(denom, reservesNew)))
+	}
+	k.SetPreviousAccrualTime(ctx, denom, ctx.BlockTime())
+
+	return nil
+}
+
+// SyncBorrowInterest updates the user's owed interest on newly borrowed coins to the latest global state
+func (k Keeper) SyncBorrowInterest(ctx sdk.Context, addr sdk.AccAddress) {
+	borrow, found := k.GetBorrow(ctx, addr)
+	if !found {
+		return
+	}
+	k.SetBorrow(ctx, k.syncBorrowInterest(ctx, borrow))
+}
+
+// SyncSupplyInterest updates the user's deposits with the supplier interest earned since their last sync
+func (k Keeper) SyncSupplyInterest(ctx sdk.Context, addr sdk.AccAddress) {
+	for _, deposit := range k.GetDepositsByUser(ctx, addr) {
+		k.SetDeposit(ctx, k.syncDepositInterest(ctx, deposit))
+	}
+}
+
+// syncBorrowInterest returns the borrow with the interest accrued since its last sync added to its amount
+func (k Keeper) syncBorrowInterest(ctx sdk.Context, borrow types.Borrow) types.Borrow {
+	updatedAmount := sdk.NewCoins()
+	for _, coin := range borrow.Amount {
+		globalInterestFactor, found := k.GetBorrowInterestFactor(ctx, coin.Denom)
+		if !found {
+			globalInterestFactor = sdk.OneDec()
+		}
+		userInterestFactor, found := borrow.Index.GetInterestFactor(coin.Denom)
+		if !found || userInterestFactor.IsZero() {
+			userInterestFactor = globalInterestFactor
+		}
+		// Calculate interest owed by user since the last sync
+		updatedAmount = updatedAmount.Add(sdk.NewCoin(coin.Denom, sdk.NewDecFromInt(coin.Amount).Quo(userInterestFactor).Mul(globalInterestFactor).TruncateInt()))
+	}
+
+	borrow.Amount = updatedAmount
+	borrow.Index = k.getBorrowInterestFactors(ctx, borrow.Amount)
+	return borrow
+}
+
+// syncDepositInterest returns the deposit with the supplier interest earned since its last sync added to its amount
+func (k Keeper) syncDepositInterest(ctx sdk.Context, deposit types.Deposit) types.Deposit {
+	globalInterestFactor := k.getSupplyInterestFactor(ctx, deposit.Amount.Denom)
+	if deposit.Index.IsNil() || deposit.Index.IsZero() {
+		deposit.Index = globalInterestFactor
+	}
+	updatedAmount := sdk.NewDecFromInt(deposit.Amount.Amount).Quo(deposit.Index).Mul(globalInterestFactor).TruncateInt()
+	deposit.Amount = sdk.NewCoin(deposit.Amount.Denom, updatedAmount)
+	deposit.Index = globalInterestFactor
+	return deposit
+}
+
+// getBorrowInterestFactors returns the current borrow interest factor of each denom in the input coins
+func (k Keeper) getBorrowInterestFactors(ctx sdk.Context, coins sdk.Coins) types.BorrowInterestFactors {
+	var interestFactors types.BorrowInterestFactors
+	for _, coin := range coins {
+		interestFactor, found := k.GetBorrowInterestFactor(ctx, coin.Denom)
+		if !found {
+			interestFactor = sdk.OneDec()
+		}
+		interestFactors = interestFactors.SetInterestFactor(coin.Denom, interestFactor)
+	}
+	return interestFactors
+}
+
+// getSupplyInterestFactor returns the current supply interest factor of a denom, which starts at one
+func (k Keeper) getSupplyInterestFactor(ctx sdk.Context, denom string) sdk.Dec {
+	interestFactor, found := k.GetSupplyInterestFactor(ctx, denom)
+	if !found {
+		return sdk.OneDec()
+	}
+	return interestFactor
+}
+
+// CalculateUtilizationRatio calculates an asset's current utilization rate
+func CalculateUtilizationRatio(cash, borrows, reserves sdk.Dec) (sdk.Dec, error) {
+	// Utilization rate is 0 when there are no borrows
+	if borrows.IsZero() {
+		return sdk.ZeroDec(), nil
+	}
+
+	totalSupply := cash.Add(borrows).Sub(reserves)
+	if !totalSupply.IsPositive() {
+		return sdk.ZeroDec(), sdkerrors.Wrapf(types.ErrInvalidUtilizationRatio, "total supply %s must be positive", totalSupply)
+	}
+
+	return sdk.MinDec(sdk.OneDec(), borrows.Quo(totalSupply)), nil
+}
+
+// CalculateBorrowRate calculates the borrow rate, which is the annual rate paid by borrowers. The rate
+// increases with utilization by the base multiplier up to the kink, and by the jump multiplier above it.
+func CalculateBorrowRate(model types.InterestRateModel, cash, borrows, reserves sdk.Dec) (sdk.Dec, error) {
+	utilRatio, err := CalculateUtilizationRatio(cash, borrows, reserves)
+	if err != nil {
+		return sdk.ZeroDec(), err
+	}
+
+	// Calculate normal borrow rate (under kink)
+	if utilRatio.LTE(model.Kink) {
+		return utilRatio.Mul(model.BaseMultiplier).Add(model.BaseRateAPY), nil
+	}
+
+	// Calculate jump borrow rate (over kink)
+	normalRate := model.Kink.Mul(model.BaseMultiplier).Add(model.BaseRateAPY)
+	excessUtil := utilRatio.Sub(model.Kink)
+	return excessUtil.Mul(model.JumpMultiplier).Add(normalRate), nil
+}
+
+// APYToSPY converts an annual interest rate into the equivalent per second interest rate, compounded every second.
+// The result is expressed as one plus the rate, so that a 10% APY of 0.10 is returned as 1.000000003022265981.
+func APYToSPY(apy sdk.Dec) (sdk.Dec, error) {
+	spy, err := sdk.OneDec().Add(apy).ApproxRoot(SecondsPerYear)
+	if err != nil {
+		return sdk.ZeroDec(), err
+	}
+	return spy, nil
+}
+
+// CalculateInterestFactor calculates the interest scaling factor,
+// which is equal to: (per-second interest rate ^ number of seconds elapsed)
+func CalculateInterestFactor(perSecondInterestRate sdk.Dec, secondsElapsed uint64) sdk.Dec {
+	return perSecondInterestRate.Power(secondsElapsed)
+}
@@ -0,0 +1,233 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/harvest/keeper"
+	"github.com/kava-labs/kava/x/harvest/types"
+	"github.com/kava-labs/kava/x/pricefeed"
+)
+
+func (suite *KeeperTestSuite) TestCalculateUtilizationRatio() {
+	type args struct {
+		cash                 sdk.Dec
+		borrows              sdk.Dec
+		reserves             sdk.Dec
+		expectedUtilizations sdk.Dec
+	}
+	testCases := []struct {
+		name       string
+		args       args
+		expectPass bool
+	}{
+		{
+			"no borrows",
+			args{
+				cash:                 sdk.MustNewDecFromStr("1000"),
+				borrows:              sdk.ZeroDec(),
+				reserves:             sdk.MustNewDecFromStr("100"),
+				expectedUtilizations: sdk.ZeroDec(),
+			},
+			true,
+		},
+		{
+			"half utilized",
+			args{
+				cash:                 sdk.MustNewDecFromStr("500"),
+				borrows:              sdk.MustNewDecFromStr("500"),
+				reserves:             sdk.ZeroDec(),
+				expectedUtilizations: sdk.MustNewDecFromStr("0.5"),
+			},
+			true,
+		},
+		{
+			"reserves are excluded from supply",
+			args{
+				cash:                 sdk.MustNewDecFromStr("600"),
+				borrows:              sdk.MustNewDecFromStr("500"),
+				reserves:             sdk.MustNewDecFromStr("100"),
+				expectedUtilizations: sdk.MustNewDecFromStr("0.5"),
+			},
+			true,
+		},
+		{
+			"utilization is capped at one",
+			args{
+				cash:                 sdk.ZeroDec(),
+				borrows:              sdk.MustNewDecFromStr("500"),
+				reserves:             sdk.MustNewDecFromStr("100"),
+				expectedUtilizations: sdk.OneDec(),
+			},
+			true,
+		},
+		{
+			"negative supply",
+			args{
+				cash:                 sdk.ZeroDec(),
+				borrows:              sdk.MustNewDecFromStr("100"),
+				reserves:             sdk.MustNewDecFromStr("200"),
+				expectedUtilizations: sdk.ZeroDec(),
+			},
+			false,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			utilRatio, err := keeper.CalculateUtilizationRatio(tc.args.cash, tc.args.borrows, tc.args.reserves)
+			if tc.expectPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.args.expectedUtilizations, utilRatio)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestCalculateBorrowRate() {
+	model := types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("0.2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("2"))
+	testCases := []struct {
+		name         string
+		cash         sdk.Dec
+		borrows      sdk.Dec
+		expectedRate sdk.Dec
+	}{
+		{"no borrows", sdk.MustNewDecFromStr("1000"), sdk.ZeroDec(), sdk.MustNewDecFromStr("0.05")},
+		{"below kink", sdk.MustNewDecFromStr("500"), sdk.MustNewDecFromStr("500"), sdk.MustNewDecFromStr("0.15")},
+		{"at kink", sdk.MustNewDecFromStr("200"), sdk.MustNewDecFromStr("800"), sdk.MustNewDecFromStr("0.21")},
+		{"above kink", sdk.MustNewDecFromStr("100"), sdk.MustNewDecFromStr("900"), sdk.MustNewDecFromStr("0.41")},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			rate, err := keeper.CalculateBorrowRate(model, tc.cash, tc.borrows, sdk.ZeroDec())
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expectedRate, rate)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestAPYToSPY() {
+	testCases := []struct {
+		name string
+		apy  sdk.Dec
+	}{
+		{"zero", sdk.ZeroDec()},
+		{"5%", sdk.MustNewDecFromStr("0.05")},
+		{"10%", sdk.MustNewDecFromStr("0.1")},
+		{"100%", sdk.OneDec()},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			spy, err := keeper.APYToSPY(tc.apy)
+			suite.Require().NoError(err)
+			// compounding the per second rate for a year gives back the annual rate
+			interestFactor := keeper.CalculateInterestFactor(spy, keeper.SecondsPerYear)
+			suite.Require().True(interestFactor.Sub(sdk.OneDec().Add(tc.apy)).Abs().LT(sdk.MustNewDecFromStr("0.000000001")), interestFactor.String())
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestAccrueInterest() {
+	borrower := sdk.AccAddress(crypto.AddressHash([]byte("borrower")))
+	supplier := sdk.AccAddress(crypto.AddressHash([]byte("supplier")))
+	reserveFactor := sdk.MustNewDecFromStr("0.05")
+	startTime := time.Date(2020, 12, 15, 14, 0, 0, 0, time.UTC)
+
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, abci.Header{Height: 1, Time: startTime})
+
+	authGS := app.NewAuthGenState(
+		[]sdk.AccAddress{borrower, supplier},
+		[]sdk.Coins{sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(100*KAVA_CF))), sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(100*USDX_CF)))})
+
+	// 50% utilization gives a borrow rate of 0.05 + 0.5 * 0.1 = 10% APY
+	harvestGS := types.NewGenesisState(types.NewParams(
+		true,
+		types.DistributionSchedules{
+			types.NewDistributionSchedule(true, "ukava", time.Date(2020, 10, 8, 14, 0, 0, 0, time.UTC), time.Date(2020, 11, 22, 14, 0, 0, 0, time.UTC), sdk.NewCoin("hard", sdk.NewInt(5000)), time.Date(2021, 11, 22, 14, 0, 0, 0, time.UTC), types.Multipliers{types.NewMultiplier(types.Small, 0, sdk.MustNewDecFromStr("0.33"))}),
+			types.NewDistributionSchedule(true, "usdx", time.Date(2020, 10, 8, 14, 0, 0, 0, time.UTC), time.Date(2020, 11, 22, 14, 0, 0, 0, time.UTC), sdk.NewCoin("hard", sdk.NewInt(5000)), time.Date(2021, 11, 22, 14, 0, 0, 0, time.UTC), types.Multipliers{types.NewMultiplier(types.Small, 0, sdk.MustNewDecFromStr("0.33"))}),
+		},
+		types.DelegatorDistributionSchedules{},
+		types.MoneyMarkets{
+			types.NewMoneyMarket("usdx", false, sdk.NewDec(100000000*USDX_CF), sdk.MustNewDecFromStr("1"), "usdx:usd", sdk.NewInt(USDX_CF), types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("0.1"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")), reserveFactor, sdk.MustNewDecFromStr("0.05")),
+			types.NewMoneyMarket("ukava", false, sdk.NewDec(100000000*KAVA_CF), sdk.MustNewDecFromStr("0.8"), "kava:usd", sdk.NewInt(KAVA_CF), types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("0.1"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")), reserveFactor, sdk.MustNewDecFromStr("0.05")),
+		},
+		types.DefaultCloseFactor,
+	), types.DefaultPreviousBlockTime, types.DefaultDistributionTimes)
+
+	pricefeedGS := pricefeed.GenesisState{
+		Params: pricefeed.Params{
+			Markets: []pricefeed.Market{
+				{MarketID: "usdx:usd", BaseAsset: "usdx", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+				{MarketID: "kava:usd", BaseAsset: "kava", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+			},
+		},
+		PostedPrices: []pricefeed.PostedPrice{
+			{MarketID: "usdx:usd", OracleAddress: sdk.AccAddress{}, Price: sdk.MustNewDecFromStr("1.00"), Expiry: startTime.Add(2 * 365 * 24 * time.Hour)},
+			{MarketID: "kava:usd", OracleAddress: sdk.AccAddress{}, Price: sdk.MustNewDecFromStr("2.00"), Expiry: startTime.Add(2 * 365 * 24 * time.Hour)},
+		},
+	}
+
+	tApp.InitializeFromGenesisStates(authGS,
+		app.GenesisState{pricefeed.ModuleName: pricefeed.ModuleCdc.MustMarshalJSON(pricefeedGS)},
+		app.GenesisState{types.ModuleName: types.ModuleCdc.MustMarshalJSON(harvestGS)})
+	suite.app = tApp
+	suite.ctx = ctx
+	suite.keeper = tApp.GetHarvestKeeper()
+
+	suite.Require().NoError(suite.keeper.Deposit(suite.ctx, supplier, sdk.NewCoin("usdx", sdk.NewInt(100*USDX_CF))))
+	suite.Require().NoError(suite.keeper.Deposit(suite.ctx, borrower, sdk.NewCoin("ukava", sdk.NewInt(100*KAVA_CF))))
+	suite.Require().NoError(suite.keeper.Borrow(suite.ctx, borrower, sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(50*USDX_CF)))))
+
+	// The first accrual only records the accrual time
+	suite.Require().NoError(suite.keeper.AccrueInterest(suite.ctx, "usdx"))
+	_, found := suite.keeper.GetBorrowInterestFactor(suite.ctx, "usdx")
+	suite.Require().False(found)
+
+	suite.ctx = suite.ctx.WithBlockTime(startTime.Add(time.Duration(keeper.SecondsPerYear) * time.Second))
+	suite.Require().NoError(suite.keeper.AccrueInterest(suite.ctx, "usdx"))
+
+	spy, err := keeper.APYToSPY(sdk.MustNewDecFromStr("0.1"))
+	suite.Require().NoError(err)
+	expectedInterestFactor := keeper.CalculateInterestFactor(spy, keeper.SecondsPerYear)
+	interestFactor, found := suite.keeper.GetBorrowInterestFactor(suite.ctx, "usdx")
+	suite.Require().True(found)
+	suite.Require().Equal(expectedInterestFactor, interestFactor)
+
+	// 10% interest on 50 USDX, rounded up
+	expectedInterest := sdk.NewInt(5*USDX_CF + 1)
+	expectedReserves := sdk.NewDecFromInt(expectedInterest).Mul(reserveFactor).TruncateInt()
+	borrowedCoins, _ := suite.keeper.GetBorrowedCoins(suite.ctx)
+	suite.Require().Equal(sdk.NewInt(50*USDX_CF).Add(expectedInterest), borrowedCoins.AmountOf("usdx"))
+	reserves, _ := suite.keeper.GetTotalReserves(suite.ctx)
+	suite.Require().Equal(expectedReserves, reserves.AmountOf("usdx"))
+	suppliedCoins, _ := suite.keeper.GetSuppliedCoins(suite.ctx)
+	suite.Require().Equal(sdk.NewInt(100*USDX_CF).Add(expectedInterest).Sub(expectedReserves), suppliedCoins.AmountOf("usdx"))
+
+	// The borrower owes the interest once their borrow is synced
+	suite.keeper.SyncBorrowInterest(suite.ctx, borrower)
+	borrow, _ := suite.keeper.GetBorrow(suite.ctx, borrower)
+	suite.Require().Equal(sdk.NewInt(55*USDX_CF), borrow.Amount.AmountOf("usdx"))
+	userInterestFactor, _ := borrow.Index.GetInterestFactor("usdx")
+	suite.Require().Equal(interestFactor, userInterestFactor)
+	suite.Require().True(borrow.Amount.AmountOf("usdx").LTE(borrowedCoins.AmountOf("usdx")))
+
+	// The supplier earns the interest that was not sent to the reserves
+	suite.keeper.SyncSupplyInterest(suite.ctx, supplier)
+	deposit, _ := suite.keeper.GetDeposit(suite.ctx, supplier, "usdx")
+	suite.Require().Equal(sdk.NewInt(100*USDX_CF).Add(expectedInterest).Sub(expectedReserves), deposit.Amount.Amount)
+
+	// Repaying the synced borrow in full deletes it
+	err = tApp.GetSupplyKeeper().MintCoins(suite.ctx, types.ModuleAccountName, sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(5*USDX_CF))))
+	suite.Require().NoError(err)
+	err = tApp.GetSupplyKeeper().SendCoinsFromModuleToAccount(suite.ctx, types.ModuleAccountName, borrower, sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(5*USDX_CF))))
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.keeper.Repay(suite.ctx, borrower, borrower, sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(55*USDX_CF)))))
+	_, found = suite.keeper.GetBorrow(suite.ctx, borrower)
+	suite.Require().False(found)
+}
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -8,6 +9,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/params/subspace"
 
+	"github.com/tendermint/tendermint/libs/log"
+
 	"github.com/kava-labs/kava/x/harvest/types"
 )
 
@@ -41,6 +44,11 @@ func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, paramstore subspace.Subspace,
 	}
 }
 
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
 // GetPreviousBlockTime get the blocktime for the previous block
 func (k Keeper) GetPreviousBlockTime(ctx sdk.Context) (blockTime time.Time, found bool) {
 	store := prefix.NewStore(ctx.KVStore(k.key), types.PreviousBlockTimeKey)
@@ -240,8 +248,12 @@ func (k Keeper) IterateBorrows(ctx sdk.Context, cb func(borrow types.Borrow) (st
 // SetBorrowedCoins sets the total amount of coins currently borrowed in the store
 func (k Keeper) SetBorrowedCoins(ctx sdk.Context, borrowedCoins sdk.Coins) {
 	store := prefix.NewStore(ctx.KVStore(k.key), types.BorrowedCoinsPrefix)
-	bz := k.cdc.MustMarshalBinaryBare(borrowedCoins)
-	store.Set([]byte{}, bz)
+	if borrowedCoins.Empty() {
+		store.Delete([]byte{})
+	} else {
+		bz := k.cdc.MustMarshalBinaryBare(borrowedCoins)
+		store.Set([]byte{}, bz)
+	}
 }
 
 // GetBorrowedCoins returns an sdk.Coins object from the store representing all currently borrowed coins
@@ -295,3 +307,106 @@ func (k Keeper) IterateInterestRateModels(ctx sdk.Context, cb func(denom string,
 		}
 	}
 }
+
+// GetBorrowInterestFactor returns the current borrow interest factor for an individual denom
+func (k Keeper) GetBorrowInterestFactor(ctx sdk.Context, denom string) (sdk.Dec, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.BorrowInterestFactorPrefix)
+	bz := store.Get([]byte(denom))
+	if bz == nil {
+		return sdk.ZeroDec(), false
+	}
+	var interestFactor sdk.Dec
+	k.cdc.MustUnmarshalBinaryBare(bz, &interestFactor)
+	return interestFactor, true
+}
+
+// SetBorrowInterestFactor sets the current borrow interest factor for an individual denom
+func (k Keeper) SetBorrowInterestFactor(ctx sdk.Context, denom string, interestFactor sdk.Dec) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.BorrowInterestFactorPrefix)
+	bz := k.cdc.MustMarshalBinaryBare(interestFactor)
+	store.Set([]byte(denom), bz)
+}
+
+// GetSupplyInterestFactor returns the current supply interest factor for an individual denom
+func (k Keeper) GetSupplyInterestFactor(ctx sdk.Context, denom string) (sdk.Dec, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.SupplyInterestFactorPrefix)
+	bz := store.Get([]byte(denom))
+	if bz == nil {
+		return sdk.ZeroDec(), false
+	}
+	var interestFactor sdk.Dec
+	k.cdc.MustUnmarshalBinaryBare(bz, &interestFactor)
+	return interestFactor, true
+}
+
+// SetSupplyInterestFactor sets the current supply interest factor for an individual denom
+func (k Keeper) SetSupplyInterestFactor(ctx sdk.Context, denom string, interestFactor sdk.Dec) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.SupplyInterestFactorPrefix)
+	bz := k.cdc.MustMarshalBinaryBare(interestFactor)
+	store.Set([]byte(denom), bz)
+}
+
+// GetPreviousAccrualTime returns the last time an individual market accrued interest
+func (k Keeper) GetPreviousAccrualTime(ctx sdk.Context, denom string) (time.Time, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PreviousAccrualTimePrefix)
+	bz := store.Get([]byte(denom))
+	if bz == nil {
+		return time.Time{}, false
+	}
+	var previousAccrualTime time.Time
+	k.cdc.MustUnmarshalBinaryBare(bz, &previousAccrualTime)
+	return previousAccrualTime, true
+}
+
+// SetPreviousAccrualTime sets the most recent accrual time for a particular market
+func (k Keeper) SetPreviousAccrualTime(ctx sdk.Context, denom string, previousAccrualTime time.Time) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PreviousAccrualTimePrefix)
+	bz := k.cdc.MustMarshalBinaryBare(previousAccrualTime)
+	store.Set([]byte(denom), bz)
+}
+
+// GetTotalReserves returns the interest reserves of every market
+func (k Keeper) GetTotalReserves(ctx sdk.Context) (sdk.Coins, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.TotalReservesPrefix)
+	bz := store.Get([]byte{})
+	if bz == nil {
+		return sdk.Coins{}, false
+	}
+	var totalReserves sdk.Coins
+	k.cdc.MustUnmarshalBinaryBare(bz, &totalReserves)
+	return totalReserves, true
+}
+
+// SetTotalReserves sets the interest reserves of every market
+func (k Keeper) SetTotalReserves(ctx sdk.Context, coins sdk.Coins) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.TotalReservesPrefix)
+	if coins.Empty() {
+		store.Delete([]byte{})
+	} else {
+		bz := k.cdc.MustMarshalBinaryBare(coins)
+		store.Set([]byte{}, bz)
+	}
+}
+
+// SetSuppliedCoins sets the total amount of coins currently supplied in the store
+func (k Keeper) SetSuppliedCoins(ctx sdk.Context, suppliedCoins sdk.Coins) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.SuppliedCoinsPrefix)
+	if suppliedCoins.Empty() {
+		store.Delete([]byte{})
+	} else {
+		bz := k.cdc.MustMarshalBinaryBare(suppliedCoins)
+		store.Set([]byte{}, bz)
+	}
+}
+
+// GetSuppliedCoins returns an sdk.Coins object from the store representing all currently supplied coins, including supplier interest
+func (k Keeper) GetSuppliedCoins(ctx sdk.Context) (sdk.Coins, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.SuppliedCoinsPrefix)
+	bz := store.Get([]byte{})
+	if bz == nil {
+		return sdk.Coins{}, false
+	}
+	var suppliedCoins sdk.Coins
+	k.cdc.MustUnmarshalBinaryBare(bz, &suppliedCoins)
+	return suppliedCoins, true
+}
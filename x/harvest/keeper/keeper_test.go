@@ -73,7 +73,7 @@ func (suite *KeeperTestSuite) TestGetSetPreviousDelegatorDistribution() {
 }
 
 func (suite *KeeperTestSuite) TestGetSetDeleteDeposit() {
-	dep := types.NewDeposit(sdk.AccAddress("test"), sdk.NewCoin("bnb", sdk.NewInt(100)))
+	dep := types.NewDeposit(sdk.AccAddress("test"), sdk.NewCoin("bnb", sdk.NewInt(100)), sdk.OneDec())
 
 	_, f := suite.keeper.GetDeposit(suite.ctx, sdk.AccAddress("test"), "bnb")
 	suite.Require().False(f)
@@ -93,7 +93,7 @@ func (suite *KeeperTestSuite) TestGetSetDeleteDeposit() {
 
 func (suite *KeeperTestSuite) TestIterateDeposits() {
 	for i := 0; i < 5; i++ {
-		dep := types.NewDeposit(sdk.AccAddress("test"+string(i)), sdk.NewCoin("bnb", sdk.NewInt(100)))
+		dep := types.NewDeposit(sdk.AccAddress("test"+string(i)), sdk.NewCoin("bnb", sdk.NewInt(100)), sdk.OneDec())
 		suite.Require().NotPanics(func() { suite.keeper.SetDeposit(suite.ctx, dep) })
 	}
 	var deposits []types.Deposit
@@ -104,13 +104,30 @@ func (suite *KeeperTestSuite) TestIterateDeposits() {
 	suite.Require().Equal(5, len(deposits))
 }
 
+func (suite *KeeperTestSuite) TestInitializeSuppliedCoins() {
+	// deposits made before supplied coins were tracked are added to them
+	suite.keeper.SetDeposit(suite.ctx, types.NewDeposit(sdk.AccAddress("test1"), sdk.NewCoin("bnb", sdk.NewInt(100)), sdk.OneDec()))
+	suite.keeper.SetDeposit(suite.ctx, types.NewDeposit(sdk.AccAddress("test2"), sdk.NewCoin("bnb", sdk.NewInt(50)), sdk.OneDec()))
+	suite.keeper.SetDeposit(suite.ctx, types.NewDeposit(sdk.AccAddress("test2"), sdk.NewCoin("ukava", sdk.NewInt(20)), sdk.OneDec()))
+	suite.keeper.InitializeSuppliedCoins(suite.ctx)
+	suppliedCoins, found := suite.keeper.GetSuppliedCoins(suite.ctx)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin("bnb", sdk.NewInt(150)), sdk.NewCoin("ukava", sdk.NewInt(20))), suppliedCoins)
+
+	// supplied coins that are already set are left unchanged
+	suite.keeper.SetSuppliedCoins(suite.ctx, sdk.NewCoins(sdk.NewCoin("bnb", sdk.NewInt(160))))
+	suite.keeper.InitializeSuppliedCoins(suite.ctx)
+	suppliedCoins, _ = suite.keeper.GetSuppliedCoins(suite.ctx)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin("bnb", sdk.NewInt(160))), suppliedCoins)
+}
+
 func (suite *KeeperTestSuite) TestIterateDepositsByDenom() {
 	for i := 0; i < 5; i++ {
-		depA := types.NewDeposit(sdk.AccAddress("test"+string(i)), sdk.NewCoin("bnb", sdk.NewInt(100)))
+		depA := types.NewDeposit(sdk.AccAddress("test"+string(i)), sdk.NewCoin("bnb", sdk.NewInt(100)), sdk.OneDec())
 		suite.Require().NotPanics(func() { suite.keeper.SetDeposit(suite.ctx, depA) })
-		depB := types.NewDeposit(sdk.AccAddress("test"+string(i)), sdk.NewCoin("bnb", sdk.NewInt(100)))
+		depB := types.NewDeposit(sdk.AccAddress("test"+string(i)), sdk.NewCoin("bnb", sdk.NewInt(100)), sdk.OneDec())
 		suite.Require().NotPanics(func() { suite.keeper.SetDeposit(suite.ctx, depB) })
-		depC := types.NewDeposit(sdk.AccAddress("test"+string(i)), sdk.NewCoin("btcb", sdk.NewInt(100)))
+		depC := types.NewDeposit(sdk.AccAddress("test"+string(i)), sdk.NewCoin("btcb", sdk.NewInt(100)), sdk.OneDec())
 		suite.Require().NotPanics(func() { suite.keeper.SetDeposit(suite.ctx, depC) })
 	}
 
@@ -174,6 +191,30 @@ func (suite *KeeperTestSuite) TestGetSetDeleteInterestRateModel() {
 
 }
 
+func (suite *KeeperTestSuite) TestGetSetBorrowInterestFactor() {
+	_, f := suite.keeper.GetBorrowInterestFactor(suite.ctx, "bnb")
+	suite.Require().False(f)
+
+	suite.keeper.SetBorrowInterestFactor(suite.ctx, "bnb", sdk.MustNewDecFromStr("1.05"))
+
+	interestFactor, f := suite.keeper.GetBorrowInterestFactor(suite.ctx, "bnb")
+	suite.Require().True(f)
+	suite.Require().Equal(sdk.MustNewDecFromStr("1.05"), interestFactor)
+}
+
+func (suite *KeeperTestSuite) TestGetSetPreviousAccrualTime() {
+	now := tmtime.Now()
+
+	_, f := suite.keeper.GetPreviousAccrualTime(suite.ctx, "bnb")
+	suite.Require().False(f)
+
+	suite.NotPanics(func() { suite.keeper.SetPreviousAccrualTime(suite.ctx, "bnb", now) })
+
+	pat, f := suite.keeper.GetPreviousAccrualTime(suite.ctx, "bnb")
+	suite.True(f)
+	suite.Equal(now, pat)
+}
+
 func (suite *KeeperTestSuite) TestIterateInterestRateModels() {
 	testDenom := "test"
 	var setModels types.InterestRateModels
@@ -0,0 +1,186 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/kava-labs/kava/x/harvest/types"
+)
+
+// Liquidate repays part of the borrow of a borrower whose borrow value is greater than the loan-to-value limit of their deposits.
+// The liquidator pays back the close factor of each borrowed coin to the harvest module account, and receives deposits worth
+// the repaid borrow at the liquidation discount of each deposit's money market. Deposits without a money market or price are
+// valued at zero and are not seized. The rest of the borrow and the deposits that are not seized are kept by the borrower.
+func (k Keeper) Liquidate(ctx sdk.Context, liquidator, borrower sdk.AccAddress) error {
+	// Sync the borrow and deposits so that the liquidation includes the interest accrued so far
+	k.SyncBorrowInterest(ctx, borrower)
+	k.SyncSupplyInterest(ctx, borrower)
+
+	borrow, found := k.GetBorrow(ctx, borrower)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrBorrowNotFound, "no borrow found for %s", borrower)
+	}
+	deposits := k.GetDepositsByUser(ctx, borrower)
+	if len(deposits) == 0 {
+		return sdkerrors.Wrapf(types.ErrDepositsNotFound, "no deposits found for %s", borrower)
+	}
+
+	borrowValue, err := k.getBorrowValue(ctx, borrow.Amount)
+	if err != nil {
+		return err
+	}
+
+	totalDepositValue := sdk.ZeroDec()
+	borrowLimit := sdk.ZeroDec()
+	seizable := make([]bool, len(deposits))
+	liquidationDiscounts := make([]sdk.Dec, len(deposits))
+	for i, deposit := range deposits {
+		depositValue, moneyMarket, err := k.getCoinValue(ctx, deposit.Amount)
+		if err != nil {
+			// a deposit that can't be valued doesn't count towards the borrow limit
+			continue
+		}
+		totalDepositValue = totalDepositValue.Add(depositValue)
+		borrowLimit = borrowLimit.Add(depositValue.Mul(moneyMarket.BorrowLimit.LoanToValue))
+		seizable[i] = true
+		liquidationDiscounts[i] = moneyMarket.LiquidationDiscount
+	}
+
+	if borrowValue.LTE(borrowLimit) {
+		return sdkerrors.Wrapf(types.ErrBorrowNotLiquidatable, "borrow value %s is within the borrow limit %s", borrowValue, borrowLimit)
+	}
+
+	// The liquidator pays back the close factor of each borrowed coin, which is returned to the pool
+	repayCoins := k.getLiquidationRepayCoins(ctx, borrow.Amount)
+	repayValue, err := k.getBorrowValue(ctx, repayCoins)
+	if err != nil {
+		return err
+	}
+	err = k.supplyKeeper.SendCoinsFromAccountToModule(ctx, liquidator, types.ModuleAccountName, repayCoins)
+	if err != nil {
+		return err
+	}
+	err = k.DecrementBorrowedCoins(ctx, repayCoins)
+	if err != nil {
+		return err
+	}
+	borrow.Amount = borrow.Amount.Sub(repayCoins)
+	if borrow.Amount.IsZero() {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeDeleteHarvestBorrow,
+				sdk.NewAttribute(types.AttributeKeyBorrower, borrower.String()),
+			),
+		)
+		k.DeleteBorrow(ctx, borrow)
+	} else {
+		k.SetBorrow(ctx, borrow)
+	}
+
+	// Each deposit pays for the share of the repaid borrow equal to its share of the deposit value, at its liquidation discount
+	debtRatio := sdk.OneDec()
+	if totalDepositValue.IsPositive() {
+		debtRatio = repayValue.Quo(totalDepositValue)
+	}
+	seizedCoins := sdk.NewCoins()
+	for i, deposit := range deposits {
+		if !seizable[i] {
+			continue
+		}
+		seizeRatio := sdk.MinDec(sdk.OneDec(), debtRatio.Quo(sdk.OneDec().Sub(liquidationDiscounts[i])))
+		seizedAmount := sdk.NewDecFromInt(deposit.Amount.Amount).Mul(seizeRatio).TruncateInt()
+		if !seizedAmount.IsPositive() {
+			continue
+		}
+		seizedCoin := sdk.NewCoin(deposit.Amount.Denom, seizedAmount)
+		seizedCoins = seizedCoins.Add(seizedCoin)
+
+		if deposit.Amount.IsEqual(seizedCoin) {
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeDeleteHarvestDeposit,
+					sdk.NewAttribute(types.AttributeKeyDepositor, borrower.String()),
+					sdk.NewAttribute(types.AttributeKeyDepositDenom, deposit.Amount.Denom),
+				),
+			)
+			k.DeleteDeposit(ctx, deposit)
+			continue
+		}
+		deposit.Amount = deposit.Amount.Sub(seizedCoin)
+		k.SetDeposit(ctx, deposit)
+	}
+
+	if !seizedCoins.Empty() {
+		err = k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleAccountName, liquidator, seizedCoins)
+		if err != nil {
+			return err
+		}
+		err = k.DecrementSuppliedCoins(ctx, seizedCoins)
+		if err != nil {
+			return err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeHarvestLiquidation,
+			sdk.NewAttribute(types.AttributeKeyLiquidator, liquidator.String()),
+			sdk.NewAttribute(types.AttributeKeyBorrower, borrower.String()),
+			sdk.NewAttribute(types.AttributeKeyRepayCoins, repayCoins.String()),
+			sdk.NewAttribute(types.AttributeKeySeizedCoins, seizedCoins.String()),
+		),
+	)
+
+	return nil
+}
+
+// getLiquidationRepayCoins returns the close factor of each borrowed coin, rounded up so that dust borrows are repaid in full
+func (k Keeper) getLiquidationRepayCoins(ctx sdk.Context, borrowed sdk.Coins) sdk.Coins {
+	closeFactor := k.GetParams(ctx).CloseFactor
+	repayCoins := sdk.NewCoins()
+	for _, coin := range borrowed {
+		repayAmount := sdk.MinInt(coin.Amount, sdk.NewDecFromInt(coin.Amount).Mul(closeFactor).Ceil().TruncateInt())
+		repayCoins = repayCoins.Add(sdk.NewCoin(coin.Denom, repayAmount))
+	}
+	return repayCoins
+}
+
+// getCoinValue returns the USD value of a coin at the spot price of its money market, along with the money market
+func (k Keeper) getCoinValue(ctx sdk.Context, coin sdk.Coin) (sdk.Dec, types.MoneyMarket, error) {
+	moneyMarket, found := k.GetMoneyMarket(ctx, coin.Denom)
+	if !found {
+		return sdk.ZeroDec(), types.MoneyMarket{}, sdkerrors.Wrapf(types.ErrMarketNotFound, "no market found for denom %s", coin.Denom)
+	}
+	assetPriceInfo, err := k.pricefeedKeeper.GetCurrentPrice(ctx, moneyMarket.SpotMarketID)
+	if err != nil {
+		return sdk.ZeroDec(), types.MoneyMarket{}, sdkerrors.Wrapf(types.ErrPriceNotFound, "no price found for market %s", moneyMarket.SpotMarketID)
+	}
+	coinValue := sdk.NewDecFromInt(coin.Amount).Quo(sdk.NewDecFromInt(moneyMarket.ConversionFactor)).Mul(assetPriceInfo.Price)
+	return coinValue, moneyMarket, nil
+}
+
+// getBorrowValue returns the USD value of borrowed coins at the spot prices of their money markets
+func (k Keeper) getBorrowValue(ctx sdk.Context, coins sdk.Coins) (sdk.Dec, error) {
+	borrowValue := sdk.ZeroDec()
+	for _, coin := range coins {
+		coinValue, _, err := k.getCoinValue(ctx, coin)
+		if err != nil {
+			return sdk.ZeroDec(), err
+		}
+		borrowValue = borrowValue.Add(coinValue)
+	}
+	return borrowValue, nil
+}
+
+// getBorrowLimit returns the USD value that can be borrowed against deposits at the loan-to-value of their money markets
+func (k Keeper) getBorrowLimit(ctx sdk.Context, deposits []types.Deposit) (sdk.Dec, error) {
+	borrowLimit := sdk.ZeroDec()
+	for _, deposit := range deposits {
+		depositValue, moneyMarket, err := k.getCoinValue(ctx, deposit.Amount)
+		if err != nil {
+			return sdk.ZeroDec(), err
+		}
+		borrowLimit = borrowLimit.Add(depositValue.Mul(moneyMarket.BorrowLimit.LoanToValue))
+	}
+	return borrowLimit, nil
+}
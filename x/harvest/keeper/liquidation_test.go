@@ -0,0 +1,286 @@
+package keeper_test
+
+import (
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+	tmtime "github.com/tendermint/tendermint/types/time"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/harvest/types"
+	"github.com/kava-labs/kava/x/pricefeed"
+)
+
+func (suite *KeeperTestSuite) TestLiquidate() {
+	type args struct {
+		borrowCoins               sdk.Coins
+		liquidatorCoins           sdk.Coins
+		priceKAVA                 sdk.Dec
+		closeFactor               sdk.Dec
+		unpricedDeposit           sdk.Coins
+		expectedLiquidatorBalance sdk.Coins
+		expectedDeposit           sdk.Coins
+		expectedBorrow            sdk.Coins
+	}
+	type errArgs struct {
+		expectPass bool
+		contains   string
+	}
+	type liquidateTest struct {
+		name    string
+		args    args
+		errArgs errArgs
+	}
+	borrower := sdk.AccAddress(crypto.AddressHash([]byte("borrower")))
+	liquidator := sdk.AccAddress(crypto.AddressHash([]byte("keeper")))
+	testCases := []liquidateTest{
+		{
+			"valid: part of the deposit is seized",
+			args{
+				borrowCoins:     sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(150*USDX_CF))),
+				liquidatorCoins: sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(200*USDX_CF))),
+				priceKAVA:       sdk.MustNewDecFromStr("1.80"), // 100 KAVA x $1.80 price = $180, $144 borrow limit
+				closeFactor:     sdk.OneDec(),
+				unpricedDeposit: sdk.NewCoins(),
+				// $150 borrow / $180 deposit / (1 - 0.05 discount) = 87.72% of the deposit
+				expectedLiquidatorBalance: sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(50*USDX_CF)), sdk.NewCoin("ukava", sdk.NewInt(87719298))),
+				expectedDeposit:           sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(12280702))),
+				expectedBorrow:            sdk.NewCoins(),
+			},
+			errArgs{
+				expectPass: true,
+				contains:   "",
+			},
+		},
+		{
+			"valid: the whole deposit is seized",
+			args{
+				borrowCoins:               sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(150*USDX_CF))),
+				liquidatorCoins:           sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(200*USDX_CF))),
+				priceKAVA:                 sdk.MustNewDecFromStr("1.50"),
+				closeFactor:               sdk.OneDec(),
+				unpricedDeposit:           sdk.NewCoins(),
+				expectedLiquidatorBalance: sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(50*USDX_CF)), sdk.NewCoin("ukava", sdk.NewInt(100*KAVA_CF))),
+				expectedDeposit:           sdk.NewCoins(),
+				expectedBorrow:            sdk.NewCoins(),
+			},
+			errArgs{
+				expectPass: true,
+				contains:   "",
+			},
+		},
+		{
+			"valid: the close factor of the borrow is repaid",
+			args{
+				borrowCoins:     sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(150*USDX_CF))),
+				liquidatorCoins: sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(200*USDX_CF))),
+				priceKAVA:       sdk.MustNewDecFromStr("1.80"),
+				closeFactor:     sdk.MustNewDecFromStr("0.5"),
+				unpricedDeposit: sdk.NewCoins(),
+				// $75 repaid / $180 deposit / (1 - 0.05 discount) = 43.86% of the deposit
+				expectedLiquidatorBalance: sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(125*USDX_CF)), sdk.NewCoin("ukava", sdk.NewInt(43859649))),
+				expectedDeposit:           sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(56140351))),
+				expectedBorrow:            sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(75*USDX_CF))),
+			},
+			errArgs{
+				expectPass: true,
+				contains:   "",
+			},
+		},
+		{
+			"valid: a deposit without a money market is valued at zero and not seized",
+			args{
+				borrowCoins:               sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(150*USDX_CF))),
+				liquidatorCoins:           sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(200*USDX_CF))),
+				priceKAVA:                 sdk.MustNewDecFromStr("1.80"),
+				closeFactor:               sdk.OneDec(),
+				unpricedDeposit:           sdk.NewCoins(sdk.NewCoin("bnb", sdk.NewInt(100*BNB_CF))),
+				expectedLiquidatorBalance: sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(50*USDX_CF)), sdk.NewCoin("ukava", sdk.NewInt(87719298))),
+				expectedDeposit:           sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(12280702))),
+				expectedBorrow:            sdk.NewCoins(),
+			},
+			errArgs{
+				expectPass: true,
+				contains:   "",
+			},
+		},
+		{
+			"invalid: within loan-to-value limit",
+			args{
+				borrowCoins:               sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(150*USDX_CF))),
+				liquidatorCoins:           sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(200*USDX_CF))),
+				priceKAVA:                 sdk.MustNewDecFromStr("2.00"),
+				closeFactor:               sdk.OneDec(),
+				unpricedDeposit:           sdk.NewCoins(),
+				expectedLiquidatorBalance: sdk.NewCoins(),
+				expectedDeposit:           sdk.NewCoins(),
+				expectedBorrow:            sdk.NewCoins(),
+			},
+			errArgs{
+				expectPass: false,
+				contains:   "borrow not liquidatable",
+			},
+		},
+		{
+			"invalid: no borrow",
+			args{
+				borrowCoins:               sdk.NewCoins(),
+				liquidatorCoins:           sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(200*USDX_CF))),
+				priceKAVA:                 sdk.MustNewDecFromStr("1.50"),
+				closeFactor:               sdk.OneDec(),
+				unpricedDeposit:           sdk.NewCoins(),
+				expectedLiquidatorBalance: sdk.NewCoins(),
+				expectedDeposit:           sdk.NewCoins(),
+				expectedBorrow:            sdk.NewCoins(),
+			},
+			errArgs{
+				expectPass: false,
+				contains:   "borrow not found",
+			},
+		},
+		{
+			"invalid: liquidator cannot repay the borrow",
+			args{
+				borrowCoins:               sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(150*USDX_CF))),
+				liquidatorCoins:           sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(100*USDX_CF))),
+				priceKAVA:                 sdk.MustNewDecFromStr("1.50"),
+				closeFactor:               sdk.OneDec(),
+				unpricedDeposit:           sdk.NewCoins(),
+				expectedLiquidatorBalance: sdk.NewCoins(),
+				expectedDeposit:           sdk.NewCoins(),
+				expectedBorrow:            sdk.NewCoins(),
+			},
+			errArgs{
+				expectPass: false,
+				contains:   "insufficient funds",
+			},
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			// Initialize test app and set context
+			tApp := app.NewTestApp()
+			ctx := tApp.NewContext(true, abci.Header{Height: 1, Time: tmtime.Now()})
+
+			// Auth module genesis state
+			authGS := app.NewAuthGenState(
+				[]sdk.AccAddress{borrower, liquidator},
+				[]sdk.Coins{sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(100*KAVA_CF))).Add(tc.args.unpricedDeposit...), tc.args.liquidatorCoins})
+
+			// Harvest module genesis state
+			harvestGS := types.NewGenesisState(types.NewParams(
+				true,
+				types.DistributionSchedules{
+					types.NewDistributionSchedule(true, "ukava", time.Date(2020, 10, 8, 14, 0, 0, 0, time.UTC), time.Date(2020, 11, 22, 14, 0, 0, 0, time.UTC), sdk.NewCoin("hard", sdk.NewInt(5000)), time.Date(2021, 11, 22, 14, 0, 0, 0, time.UTC), types.Multipliers{types.NewMultiplier(types.Small, 0, sdk.MustNewDecFromStr("0.33")), types.NewMultiplier(types.Medium, 6, sdk.MustNewDecFromStr("0.5")), types.NewMultiplier(types.Medium, 24, sdk.OneDec())}),
+					types.NewDistributionSchedule(true, "bnb", time.Date(2020, 10, 8, 14, 0, 0, 0, time.UTC), time.Date(2020, 11, 22, 14, 0, 0, 0, time.UTC), sdk.NewCoin("hard", sdk.NewInt(5000)), time.Date(2021, 11, 22, 14, 0, 0, 0, time.UTC), types.Multipliers{types.NewMultiplier(types.Small, 0, sdk.MustNewDecFromStr("0.33")), types.NewMultiplier(types.Medium, 6, sdk.MustNewDecFromStr("0.5")), types.NewMultiplier(types.Medium, 24, sdk.OneDec())}),
+				},
+				types.DelegatorDistributionSchedules{},
+				types.MoneyMarkets{
+					types.NewMoneyMarket("usdx", false, sdk.NewDec(100000000*USDX_CF), sdk.MustNewDecFromStr("1"), "usdx:usd", sdk.NewInt(USDX_CF), types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")), sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("0.05")),
+					types.NewMoneyMarket("ukava", false, sdk.NewDec(100000000*KAVA_CF), sdk.MustNewDecFromStr("0.8"), "kava:usd", sdk.NewInt(KAVA_CF), types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")), sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("0.05")),
+				},
+				tc.args.closeFactor,
+			), types.DefaultPreviousBlockTime, types.DefaultDistributionTimes)
+
+			// Pricefeed module genesis state
+			pricefeedGS := pricefeed.GenesisState{
+				Params: pricefeed.Params{
+					Markets: []pricefeed.Market{
+						{MarketID: "usdx:usd", BaseAsset: "usdx", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+						{MarketID: "kava:usd", BaseAsset: "kava", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+					},
+				},
+				PostedPrices: []pricefeed.PostedPrice{
+					{
+						MarketID:      "usdx:usd",
+						OracleAddress: sdk.AccAddress{},
+						Price:         sdk.MustNewDecFromStr("1.00"),
+						Expiry:        time.Now().Add(1 * time.Hour),
+					},
+					{
+						MarketID:      "kava:usd",
+						OracleAddress: sdk.AccAddress{},
+						Price:         sdk.MustNewDecFromStr("2.00"),
+						Expiry:        time.Now().Add(1 * time.Hour),
+					},
+				},
+			}
+
+			// Initialize test application
+			tApp.InitializeFromGenesisStates(authGS,
+				app.GenesisState{pricefeed.ModuleName: pricefeed.ModuleCdc.MustMarshalJSON(pricefeedGS)},
+				app.GenesisState{types.ModuleName: types.ModuleCdc.MustMarshalJSON(harvestGS)})
+
+			// Mint coins to Harvest module account
+			supplyKeeper := tApp.GetSupplyKeeper()
+			supplyKeeper.MintCoins(ctx, types.ModuleAccountName, sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(200*USDX_CF))))
+
+			keeper := tApp.GetHarvestKeeper()
+			suite.app = tApp
+			suite.ctx = ctx
+			suite.keeper = keeper
+
+			// Deposit 100 KAVA and borrow at a $2.00 KAVA price
+			err := suite.keeper.Deposit(suite.ctx, borrower, sdk.NewCoin("ukava", sdk.NewInt(100*KAVA_CF)))
+			suite.Require().NoError(err)
+			if !tc.args.borrowCoins.IsZero() {
+				err = suite.keeper.Borrow(suite.ctx, borrower, tc.args.borrowCoins)
+				suite.Require().NoError(err)
+			}
+			for _, coin := range tc.args.unpricedDeposit {
+				err = suite.keeper.Deposit(suite.ctx, borrower, coin)
+				suite.Require().NoError(err)
+			}
+
+			// Update the KAVA price
+			pricefeedKeeper := tApp.GetPriceFeedKeeper()
+			_, err = pricefeedKeeper.SetPrice(suite.ctx, sdk.AccAddress{}, "kava:usd", tc.args.priceKAVA, time.Now().Add(1*time.Hour))
+			suite.Require().NoError(err)
+			err = pricefeedKeeper.SetCurrentPrices(suite.ctx, "kava:usd")
+			suite.Require().NoError(err)
+
+			err = suite.keeper.Liquidate(suite.ctx, liquidator, borrower)
+
+			if tc.errArgs.expectPass {
+				suite.Require().NoError(err)
+
+				// Check liquidator balance
+				acc := suite.getAccount(liquidator)
+				suite.Require().Equal(tc.args.expectedLiquidatorBalance, acc.GetCoins())
+
+				// Check the rest of the borrow and the remaining deposits are kept by the borrower
+				borrow, f := suite.keeper.GetBorrow(suite.ctx, borrower)
+				if tc.args.expectedBorrow.IsZero() {
+					suite.Require().False(f)
+				} else {
+					suite.Require().True(f)
+					suite.Require().Equal(tc.args.expectedBorrow, borrow.Amount)
+				}
+				for _, coin := range tc.args.unpricedDeposit {
+					deposit, f := suite.keeper.GetDeposit(suite.ctx, borrower, coin.Denom)
+					suite.Require().True(f)
+					suite.Require().Equal(coin, deposit.Amount)
+				}
+				deposit, f := suite.keeper.GetDeposit(suite.ctx, borrower, "ukava")
+				if tc.args.expectedDeposit.IsZero() {
+					suite.Require().False(f)
+				} else {
+					suite.Require().True(f)
+					suite.Require().Equal(tc.args.expectedDeposit, sdk.NewCoins(deposit.Amount))
+				}
+
+				// Check the repaid borrow is returned to the pool
+				mAcc := suite.getModuleAccount(types.ModuleAccountName)
+				suite.Require().Equal(sdk.NewInt(200*USDX_CF).Sub(tc.args.expectedBorrow.AmountOf("usdx")), mAcc.GetCoins().AmountOf("usdx"))
+				borrowedCoins, _ := suite.keeper.GetBorrowedCoins(suite.ctx)
+				suite.Require().Equal(tc.args.expectedBorrow, borrowedCoins)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().True(strings.Contains(err.Error(), tc.errArgs.contains), err.Error())
+			}
+		})
+	}
+}
@@ -28,6 +28,10 @@ func NewQuerier(k Keeper) sdk.Querier {
 			return queryGetBorrows(ctx, req, k)
 		case types.QueryGetBorrowed:
 			return queryGetBorrowed(ctx, req, k)
+		case types.QueryGetMaxWithdraw:
+			return queryGetMaxWithdraw(ctx, req, k)
+		case types.QueryGetAccountHealth:
+			return queryGetAccountHealth(ctx, req, k)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown %s query endpoint", types.ModuleName)
 		}
@@ -289,3 +293,43 @@ func queryGetBorrowed(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte,
 
 	return bz, nil
 }
+
+func queryGetMaxWithdraw(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryMaxWithdrawParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	maxWithdraw, err := k.GetMaxWithdraw(ctx, params.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, maxWithdraw)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+func queryGetAccountHealth(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryAccountHealthParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	health, err := k.GetAccountHealth(ctx, params.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, health)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
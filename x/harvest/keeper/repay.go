@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/kava-labs/kava/x/harvest/types"
+)
+
+// Repay borrowed funds, the sender pays back coins borrowed by the owner
+func (k Keeper) Repay(ctx sdk.Context, sender, owner sdk.AccAddress, coins sdk.Coins) error {
+	// Sync the borrow so that the interest accrued so far can be repaid
+	k.SyncBorrowInterest(ctx, owner)
+
+	borrow, found := k.GetBorrow(ctx, owner)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrBorrowNotFound, "no borrow found for %s", owner)
+	}
+
+	// Cap the payment at the amount owed so that overpayments are not taken from the sender
+	payment, err := k.CalculatePaymentAmount(borrow.Amount, coins)
+	if err != nil {
+		return err
+	}
+
+	// Sends coins from the sender to the Harvest module account
+	err = k.supplyKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleAccountName, payment)
+	if err != nil {
+		return err
+	}
+
+	// Update the owner's borrow in store
+	borrow.Amount = borrow.Amount.Sub(payment)
+	if borrow.Amount.Empty() {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeDeleteHarvestBorrow,
+				sdk.NewAttribute(types.AttributeKeyBorrower, owner.String()),
+			),
+		)
+		k.DeleteBorrow(ctx, borrow)
+	} else {
+		borrow.Index = k.getBorrowInterestFactors(ctx, borrow.Amount)
+		k.SetBorrow(ctx, borrow)
+	}
+
+	// Update total borrowed amount
+	err = k.DecrementBorrowedCoins(ctx, payment)
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeHarvestRepay,
+			sdk.NewAttribute(types.AttributeKeySender, sender.String()),
+			sdk.NewAttribute(types.AttributeKeyOwner, owner.String()),
+			sdk.NewAttribute(types.AttributeKeyRepayCoins, payment.String()),
+		),
+	)
+
+	return nil
+}
+
+// CalculatePaymentAmount returns the coins to repay, reducing any coin that is greater than the amount owed to the amount owed
+func (k Keeper) CalculatePaymentAmount(owed sdk.Coins, payment sdk.Coins) (sdk.Coins, error) {
+	repayment := sdk.NewCoins()
+	for _, coin := range payment {
+		owedAmount := owed.AmountOf(coin.Denom)
+		if owedAmount.IsZero() {
+			return sdk.Coins{}, sdkerrors.Wrapf(types.ErrInvalidRepaymentDenom, "%s", coin.Denom)
+		}
+		repayment = repayment.Add(sdk.NewCoin(coin.Denom, sdk.MinInt(coin.Amount, owedAmount)))
+	}
+	return repayment, nil
+}
@@ -0,0 +1,284 @@
+package keeper_test
+
+import (
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+	tmtime "github.com/tendermint/tendermint/types/time"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/harvest/types"
+	"github.com/kava-labs/kava/x/pricefeed"
+)
+
+func (suite *KeeperTestSuite) TestRepay() {
+	type args struct {
+		borrower               sdk.AccAddress
+		repayer                sdk.AccAddress
+		initialBorrowerCoins   sdk.Coins
+		initialRepayerCoins    sdk.Coins
+		depositCoins           sdk.Coins
+		borrowCoins            sdk.Coins
+		repayCoins             sdk.Coins
+		expectedRepayerBalance sdk.Coins
+		expectedBorrow         sdk.Coins
+		expectedBorrowedCoins  sdk.Coins
+	}
+	type errArgs struct {
+		expectPass bool
+		contains   string
+	}
+	type repayTest struct {
+		name    string
+		args    args
+		errArgs errArgs
+	}
+	borrower := sdk.AccAddress(crypto.AddressHash([]byte("borrower")))
+	thirdParty := sdk.AccAddress(crypto.AddressHash([]byte("thirdParty")))
+	testCases := []repayTest{
+		{
+			"valid: partial repay",
+			args{
+				borrower:               borrower,
+				repayer:                borrower,
+				initialBorrowerCoins:   sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(100*KAVA_CF))),
+				initialRepayerCoins:    sdk.NewCoins(),
+				depositCoins:           sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(100*KAVA_CF))),
+				borrowCoins:            sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(50*USDX_CF))),
+				repayCoins:             sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(10*USDX_CF))),
+				expectedRepayerBalance: sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(40*USDX_CF))),
+				expectedBorrow:         sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(40*USDX_CF))),
+				expectedBorrowedCoins:  sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(40*USDX_CF))),
+			},
+			errArgs{
+				expectPass: true,
+				contains:   "",
+			},
+		},
+		{
+			"valid: full repay deletes borrow",
+			args{
+				borrower:               borrower,
+				repayer:                borrower,
+				initialBorrowerCoins:   sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(100*KAVA_CF))),
+				initialRepayerCoins:    sdk.NewCoins(),
+				depositCoins:           sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(100*KAVA_CF))),
+				borrowCoins:            sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(50*USDX_CF))),
+				repayCoins:             sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(50*USDX_CF))),
+				expectedRepayerBalance: sdk.NewCoins(),
+				expectedBorrow:         sdk.NewCoins(),
+				expectedBorrowedCoins:  sdk.NewCoins(),
+			},
+			errArgs{
+				expectPass: true,
+				contains:   "",
+			},
+		},
+		{
+			"valid: overpayment is capped at the amount owed",
+			args{
+				borrower:               borrower,
+				repayer:                thirdParty,
+				initialBorrowerCoins:   sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(100*KAVA_CF))),
+				initialRepayerCoins:    sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(100*USDX_CF))),
+				depositCoins:           sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(100*KAVA_CF))),
+				borrowCoins:            sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(50*USDX_CF))),
+				repayCoins:             sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(80*USDX_CF))),
+				expectedRepayerBalance: sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(50*USDX_CF))),
+				expectedBorrow:         sdk.NewCoins(),
+				expectedBorrowedCoins:  sdk.NewCoins(),
+			},
+			errArgs{
+				expectPass: true,
+				contains:   "",
+			},
+		},
+		{
+			"valid: third party repays part of a multi-coin borrow",
+			args{
+				borrower:               borrower,
+				repayer:                thirdParty,
+				initialBorrowerCoins:   sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(100*KAVA_CF))),
+				initialRepayerCoins:    sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(100*USDX_CF))),
+				depositCoins:           sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(100*KAVA_CF))),
+				borrowCoins:            sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(50*USDX_CF)), sdk.NewCoin("ukava", sdk.NewInt(10*KAVA_CF))),
+				repayCoins:             sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(50*USDX_CF))),
+				expectedRepayerBalance: sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(50*USDX_CF))),
+				expectedBorrow:         sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(10*KAVA_CF))),
+				expectedBorrowedCoins:  sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(10*KAVA_CF))),
+			},
+			errArgs{
+				expectPass: true,
+				contains:   "",
+			},
+		},
+		{
+			"invalid: no borrow",
+			args{
+				borrower:               borrower,
+				repayer:                thirdParty,
+				initialBorrowerCoins:   sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(100*KAVA_CF))),
+				initialRepayerCoins:    sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(100*USDX_CF))),
+				depositCoins:           sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(100*KAVA_CF))),
+				borrowCoins:            sdk.NewCoins(),
+				repayCoins:             sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(50*USDX_CF))),
+				expectedRepayerBalance: sdk.NewCoins(),
+				expectedBorrow:         sdk.NewCoins(),
+				expectedBorrowedCoins:  sdk.NewCoins(),
+			},
+			errArgs{
+				expectPass: false,
+				contains:   "borrow not found",
+			},
+		},
+		{
+			"invalid: repayment denom was not borrowed",
+			args{
+				borrower:               borrower,
+				repayer:                borrower,
+				initialBorrowerCoins:   sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(100*KAVA_CF))),
+				initialRepayerCoins:    sdk.NewCoins(),
+				depositCoins:           sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(50*KAVA_CF))),
+				borrowCoins:            sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(50*USDX_CF))),
+				repayCoins:             sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(10*KAVA_CF))),
+				expectedRepayerBalance: sdk.NewCoins(),
+				expectedBorrow:         sdk.NewCoins(),
+				expectedBorrowedCoins:  sdk.NewCoins(),
+			},
+			errArgs{
+				expectPass: false,
+				contains:   "no coins of this type borrowed",
+			},
+		},
+		{
+			"invalid: insufficient funds",
+			args{
+				borrower:               borrower,
+				repayer:                thirdParty,
+				initialBorrowerCoins:   sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(100*KAVA_CF))),
+				initialRepayerCoins:    sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(10*USDX_CF))),
+				depositCoins:           sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(100*KAVA_CF))),
+				borrowCoins:            sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(50*USDX_CF))),
+				repayCoins:             sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(20*USDX_CF))),
+				expectedRepayerBalance: sdk.NewCoins(),
+				expectedBorrow:         sdk.NewCoins(),
+				expectedBorrowedCoins:  sdk.NewCoins(),
+			},
+			errArgs{
+				expectPass: false,
+				contains:   "insufficient funds",
+			},
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			// Initialize test app and set context
+			tApp := app.NewTestApp()
+			ctx := tApp.NewContext(true, abci.Header{Height: 1, Time: tmtime.Now()})
+
+			// Auth module genesis state
+			authGS := app.NewAuthGenState(
+				[]sdk.AccAddress{tc.args.borrower, thirdParty},
+				[]sdk.Coins{tc.args.initialBorrowerCoins, tc.args.initialRepayerCoins})
+			if tc.args.repayer.Equals(tc.args.borrower) {
+				authGS = app.NewAuthGenState([]sdk.AccAddress{tc.args.borrower}, []sdk.Coins{tc.args.initialBorrowerCoins})
+			}
+
+			// Harvest module genesis state
+			harvestGS := types.NewGenesisState(types.NewParams(
+				true,
+				types.DistributionSchedules{
+					types.NewDistributionSchedule(true, "ukava", time.Date(2020, 10, 8, 14, 0, 0, 0, time.UTC), time.Date(2020, 11, 22, 14, 0, 0, 0, time.UTC), sdk.NewCoin("hard", sdk.NewInt(5000)), time.Date(2021, 11, 22, 14, 0, 0, 0, time.UTC), types.Multipliers{types.NewMultiplier(types.Small, 0, sdk.MustNewDecFromStr("0.33")), types.NewMultiplier(types.Medium, 6, sdk.MustNewDecFromStr("0.5")), types.NewMultiplier(types.Medium, 24, sdk.OneDec())}),
+				},
+				types.DelegatorDistributionSchedules{types.NewDelegatorDistributionSchedule(
+					types.NewDistributionSchedule(true, "usdx", time.Date(2020, 10, 8, 14, 0, 0, 0, time.UTC), time.Date(2025, 10, 8, 14, 0, 0, 0, time.UTC), sdk.NewCoin("hard", sdk.NewInt(500)), time.Date(2026, 10, 8, 14, 0, 0, 0, time.UTC), types.Multipliers{types.NewMultiplier(types.Small, 0, sdk.MustNewDecFromStr("0.33")), types.NewMultiplier(types.Medium, 6, sdk.MustNewDecFromStr("0.5")), types.NewMultiplier(types.Medium, 24, sdk.OneDec())}),
+					time.Hour*24,
+				),
+				},
+				types.MoneyMarkets{
+					types.NewMoneyMarket("usdx", false, sdk.NewDec(100000000*USDX_CF), sdk.MustNewDecFromStr("1"), "usdx:usd", sdk.NewInt(USDX_CF), types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")), sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("0.05")),
+					types.NewMoneyMarket("ukava", false, sdk.NewDec(100000000*KAVA_CF), sdk.MustNewDecFromStr("0.8"), "kava:usd", sdk.NewInt(KAVA_CF), types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")), sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("0.05")),
+				},
+				types.DefaultCloseFactor,
+			), types.DefaultPreviousBlockTime, types.DefaultDistributionTimes)
+
+			// Pricefeed module genesis state
+			pricefeedGS := pricefeed.GenesisState{
+				Params: pricefeed.Params{
+					Markets: []pricefeed.Market{
+						{MarketID: "usdx:usd", BaseAsset: "usdx", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+						{MarketID: "kava:usd", BaseAsset: "kava", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+					},
+				},
+				PostedPrices: []pricefeed.PostedPrice{
+					{
+						MarketID:      "usdx:usd",
+						OracleAddress: sdk.AccAddress{},
+						Price:         sdk.MustNewDecFromStr("1.00"),
+						Expiry:        time.Now().Add(1 * time.Hour),
+					},
+					{
+						MarketID:      "kava:usd",
+						OracleAddress: sdk.AccAddress{},
+						Price:         sdk.MustNewDecFromStr("2.00"),
+						Expiry:        time.Now().Add(1 * time.Hour),
+					},
+				},
+			}
+
+			// Initialize test application
+			tApp.InitializeFromGenesisStates(authGS,
+				app.GenesisState{pricefeed.ModuleName: pricefeed.ModuleCdc.MustMarshalJSON(pricefeedGS)},
+				app.GenesisState{types.ModuleName: types.ModuleCdc.MustMarshalJSON(harvestGS)})
+
+			// Mint coins to Harvest module account
+			supplyKeeper := tApp.GetSupplyKeeper()
+			harvestMaccCoins := sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(1000*KAVA_CF)), sdk.NewCoin("usdx", sdk.NewInt(200*USDX_CF)))
+			supplyKeeper.MintCoins(ctx, types.ModuleAccountName, harvestMaccCoins)
+
+			keeper := tApp.GetHarvestKeeper()
+			suite.app = tApp
+			suite.ctx = ctx
+			suite.keeper = keeper
+
+			// Deposit and borrow
+			for _, depositCoin := range tc.args.depositCoins {
+				err := suite.keeper.Deposit(suite.ctx, tc.args.borrower, depositCoin)
+				suite.Require().NoError(err)
+			}
+			if !tc.args.borrowCoins.IsZero() {
+				err := suite.keeper.Borrow(suite.ctx, tc.args.borrower, tc.args.borrowCoins)
+				suite.Require().NoError(err)
+			}
+
+			err := suite.keeper.Repay(suite.ctx, tc.args.repayer, tc.args.borrower, tc.args.repayCoins)
+
+			if tc.errArgs.expectPass {
+				suite.Require().NoError(err)
+
+				// Check repayer balance
+				acc := suite.getAccount(tc.args.repayer)
+				suite.Require().Equal(tc.args.expectedRepayerBalance.String(), acc.GetCoins().String())
+
+				// Check the borrow is updated, or deleted once fully repaid
+				borrow, f := suite.keeper.GetBorrow(suite.ctx, tc.args.borrower)
+				if tc.args.expectedBorrow.IsZero() {
+					suite.Require().False(f)
+				} else {
+					suite.Require().True(f)
+					suite.Require().Equal(tc.args.expectedBorrow, borrow.Amount)
+				}
+
+				// Check total borrowed coins
+				borrowedCoins, _ := suite.keeper.GetBorrowedCoins(suite.ctx)
+				suite.Require().Equal(tc.args.expectedBorrowedCoins.String(), borrowedCoins.String())
+			} else {
+				suite.Require().Error(err)
+				suite.Require().True(strings.Contains(err.Error(), tc.errArgs.contains), err.Error())
+			}
+		})
+	}
+}
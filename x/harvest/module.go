@@ -78,15 +78,17 @@ type AppModule struct {
 	AppModuleBasic
 
 	keeper          Keeper
+	accountKeeper   types.AccountKeeper
 	supplyKeeper    types.SupplyKeeper
 	pricefeedKeeper types.PricefeedKeeper
 }
 
 // NewAppModule creates a new AppModule object
-func NewAppModule(keeper Keeper, supplyKeeper types.SupplyKeeper, pricefeedKeeper types.PricefeedKeeper) AppModule {
+func NewAppModule(keeper Keeper, accountKeeper types.AccountKeeper, supplyKeeper types.SupplyKeeper, pricefeedKeeper types.PricefeedKeeper) AppModule {
 	return AppModule{
 		AppModuleBasic:  AppModuleBasic{},
 		keeper:          keeper,
+		accountKeeper:   accountKeeper,
 		supplyKeeper:    supplyKeeper,
 		pricefeedKeeper: pricefeedKeeper,
 	}
@@ -169,5 +171,5 @@ func (AppModuleBasic) RegisterStoreDecoder(sdr sdk.StoreDecoderRegistry) {
 
 // WeightedOperations returns the all the harvest module operations with their respective weights.
 func (am AppModule) WeightedOperations(simState module.SimulationState) []sim.WeightedOperation {
-	return nil
+	return simulation.WeightedOperations(simState.AppParams, simState.Cdc, am.accountKeeper, am.keeper)
 }
@@ -0,0 +1,100 @@
+package simulation
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/simapp/helpers"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	appparams "github.com/kava-labs/kava/app/params"
+	"github.com/kava-labs/kava/x/harvest/keeper"
+	"github.com/kava-labs/kava/x/harvest/types"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgRepay = "op_weight_msg_repay"
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simulation.AppParams, cdc *codec.Codec, ak types.AccountKeeper, k keeper.Keeper,
+) simulation.WeightedOperations {
+	var weightMsgRepay int
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgRepay, &weightMsgRepay, nil,
+		func(_ *rand.Rand) {
+			weightMsgRepay = appparams.DefaultWeightMsgRepay
+		},
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgRepay,
+			SimulateMsgRepay(ak, k),
+		),
+	}
+}
+
+// SimulateMsgRepay generates a MsgRepay that pays back some or all of a random borrow, from the borrower or a third party
+func SimulateMsgRepay(ak types.AccountKeeper, k keeper.Keeper) simulation.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account, chainID string,
+	) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+
+		var borrows []types.Borrow
+		k.IterateBorrows(ctx, func(borrow types.Borrow) bool {
+			borrows = append(borrows, borrow)
+			return false
+		})
+		if len(borrows) == 0 {
+			return simulation.NewOperationMsgBasic(types.ModuleName, "no-operation", "no borrows to repay", false, nil), nil, nil
+		}
+		borrow := borrows[r.Intn(len(borrows))]
+		owed := borrow.Amount[r.Intn(len(borrow.Amount))]
+
+		simAccount, _ := simulation.RandomAcc(r, accs)
+		acc := ak.GetAccount(ctx, simAccount.Address)
+		if acc == nil {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+		spendableCoins := acc.SpendableCoins(ctx.BlockTime())
+		maxRepay := sdk.MinInt(spendableCoins.AmountOf(owed.Denom), owed.Amount)
+		if !maxRepay.IsPositive() {
+			return simulation.NewOperationMsgBasic(types.ModuleName, "no-operation", "sender cannot pay back any of the borrow", false, nil), nil, nil
+		}
+		repayAmount, err := simulation.RandPositiveInt(r, maxRepay)
+		if err != nil {
+			return simulation.NoOpMsg(types.ModuleName), nil, err
+		}
+		repayCoins := sdk.NewCoins(sdk.NewCoin(owed.Denom, repayAmount))
+
+		fees, err := simulation.RandomFees(r, ctx, spendableCoins.Sub(repayCoins))
+		if err != nil {
+			return simulation.NoOpMsg(types.ModuleName), nil, err
+		}
+
+		msg := types.NewMsgRepay(acc.GetAddress(), borrow.Borrower, repayCoins)
+
+		tx := helpers.GenTx(
+			[]sdk.Msg{msg},
+			fees,
+			helpers.DefaultGenTxGas,
+			chainID,
+			[]uint64{acc.GetAccountNumber()},
+			[]uint64{acc.GetSequence()},
+			simAccount.PrivKey,
+		)
+
+		_, _, err = app.Deliver(tx)
+		if err != nil {
+			return simulation.NewOperationMsg(msg, false, fmt.Sprintf("%+v", err)), nil, err
+		}
+
+		return simulation.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
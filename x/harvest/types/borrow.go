@@ -6,14 +6,55 @@ import (
 
 // Borrow defines an amount of coins borrowed from a harvest module account
 type Borrow struct {
-	Borrower sdk.AccAddress `json:"borrower" yaml:"borrower"`
-	Amount   sdk.Coins      `json:"amount" yaml:"amount"`
+	Borrower sdk.AccAddress        `json:"borrower" yaml:"borrower"`
+	Amount   sdk.Coins             `json:"amount" yaml:"amount"`
+	Index    BorrowInterestFactors `json:"index" yaml:"index"`
 }
 
 // NewBorrow returns a new Borrow instance
-func NewBorrow(borrower sdk.AccAddress, amount sdk.Coins) Borrow {
+func NewBorrow(borrower sdk.AccAddress, amount sdk.Coins, index BorrowInterestFactors) Borrow {
 	return Borrow{
 		Borrower: borrower,
 		Amount:   amount,
+		Index:    index,
 	}
 }
+
+// BorrowInterestFactor defines an individual borrow interest factor
+type BorrowInterestFactor struct {
+	Denom string  `json:"denom" yaml:"denom"`
+	Value sdk.Dec `json:"value" yaml:"value"`
+}
+
+// NewBorrowInterestFactor returns a new BorrowInterestFactor instance
+func NewBorrowInterestFactor(denom string, value sdk.Dec) BorrowInterestFactor {
+	return BorrowInterestFactor{
+		Denom: denom,
+		Value: value,
+	}
+}
+
+// BorrowInterestFactors is a slice of BorrowInterestFactor, because Amino won't marshal maps
+type BorrowInterestFactors []BorrowInterestFactor
+
+// GetInterestFactor returns a denom's interest factor value
+func (bifs BorrowInterestFactors) GetInterestFactor(denom string) (sdk.Dec, bool) {
+	for _, bif := range bifs {
+		if bif.Denom == denom {
+			return bif.Value, true
+		}
+	}
+	return sdk.ZeroDec(), false
+}
+
+// SetInterestFactor sets a denom's interest factor value
+func (bifs BorrowInterestFactors) SetInterestFactor(denom string, value sdk.Dec) BorrowInterestFactors {
+	for i, bif := range bifs {
+		if bif.Denom == denom {
+			bif.Value = value
+			bifs[i] = bif
+			return bifs
+		}
+	}
+	return append(bifs, NewBorrowInterestFactor(denom, value))
+}
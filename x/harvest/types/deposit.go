@@ -8,12 +8,14 @@ import (
 type Deposit struct {
 	Depositor sdk.AccAddress `json:"depositor" yaml:"depositor"`
 	Amount    sdk.Coin       `json:"amount" yaml:"amount"`
+	Index     sdk.Dec        `json:"index" yaml:"index"`
 }
 
 // NewDeposit returns a new deposit
-func NewDeposit(depositor sdk.AccAddress, amount sdk.Coin) Deposit {
+func NewDeposit(depositor sdk.AccAddress, amount sdk.Coin, index sdk.Dec) Deposit {
 	return Deposit{
 		Depositor: depositor,
 		Amount:    amount,
+		Index:     index,
 	}
 }
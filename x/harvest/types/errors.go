@@ -55,4 +55,18 @@ var (
 	ErrGreaterThanAssetBorrowLimit = sdkerrors.Register(ModuleName, 24, "fails global asset borrow limit validation")
 	// ErrBorrowEmptyCoins error for when you cannot borrow empty coins
 	ErrBorrowEmptyCoins = sdkerrors.Register(ModuleName, 25, "cannot borrow zero coins")
+	// ErrBorrowNotFound error for when a user's borrow is not found in the store
+	ErrBorrowNotFound = sdkerrors.Register(ModuleName, 26, "borrow not found")
+	// ErrInvalidRepaymentDenom error for when a repayment includes coins that have not been borrowed
+	ErrInvalidRepaymentDenom = sdkerrors.Register(ModuleName, 27, "no coins of this type borrowed")
+	// ErrSuppliedCoinsNotFound error for when the total amount of supplied coins cannot be found
+	ErrSuppliedCoinsNotFound = sdkerrors.Register(ModuleName, 28, "no supplied coins found")
+	// ErrNegativeSuppliedCoins error for when substracting coins from the total supplied balance results in a negative amount
+	ErrNegativeSuppliedCoins = sdkerrors.Register(ModuleName, 29, "subtraction results in negative supply amount")
+	// ErrInvalidUtilizationRatio error for when the total supply used to calculate a utilization ratio is negative
+	ErrInvalidUtilizationRatio = sdkerrors.Register(ModuleName, 30, "invalid utilization ratio")
+	// ErrBorrowNotLiquidatable error for when a borrow is within its loan-to-value limit and cannot be liquidated
+	ErrBorrowNotLiquidatable = sdkerrors.Register(ModuleName, 31, "borrow not liquidatable")
+	// ErrWithdrawExceedsBorrowLimit error for when a withdrawal would leave a borrow over the loan-to-value limit of the remaining deposits
+	ErrWithdrawExceedsBorrowLimit = sdkerrors.Register(ModuleName, 32, "withdrawal would leave borrow over the loan-to-value limit")
 )
@@ -9,6 +9,9 @@ const (
 	EventTypeHarvestWithdrawal            = "harvest_withdrawal"
 	EventTypeClaimHarvestReward           = "claim_harvest_reward"
 	EventTypeHarvestBorrow                = "harvest_borrow"
+	EventTypeHarvestRepay                 = "harvest_repay"
+	EventTypeDeleteHarvestBorrow          = "delete_harvest_borrow"
+	EventTypeHarvestLiquidation           = "harvest_liquidation"
 	AttributeValueCategory                = ModuleName
 	AttributeKeyBlockHeight               = "block_height"
 	AttributeKeyRewardsDistribution       = "rewards_distributed"
@@ -22,4 +25,9 @@ const (
 	AttributeKeyBorrow                    = "borrow"
 	AttributeKeyBorrower                  = "borrower"
 	AttributeKeyBorrowCoins               = "borrow_coins"
+	AttributeKeySender                    = "sender"
+	AttributeKeyOwner                     = "owner"
+	AttributeKeyRepayCoins                = "repay_coins"
+	AttributeKeyLiquidator                = "liquidator"
+	AttributeKeySeizedCoins               = "seized_coins"
 )
@@ -52,6 +52,7 @@ func (suite *GenesisTestSuite) TestGenesisValidation() {
 					),
 					},
 					types.DefaultMoneyMarkets,
+					types.DefaultCloseFactor,
 				),
 				pbt: time.Date(2020, 10, 8, 12, 0, 0, 0, time.UTC),
 				pdts: types.GenesisDistributionTimes{
@@ -75,6 +76,7 @@ func (suite *GenesisTestSuite) TestGenesisValidation() {
 					),
 					},
 					types.DefaultMoneyMarkets,
+					types.DefaultCloseFactor,
 				),
 				pbt: time.Time{},
 				pdts: types.GenesisDistributionTimes{
@@ -98,6 +100,7 @@ func (suite *GenesisTestSuite) TestGenesisValidation() {
 					),
 					},
 					types.DefaultMoneyMarkets,
+					types.DefaultCloseFactor,
 				),
 				pbt: time.Date(2020, 10, 8, 12, 0, 0, 0, time.UTC),
 				pdts: types.GenesisDistributionTimes{
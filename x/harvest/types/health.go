@@ -0,0 +1,85 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// CoinValue is a coin and its USD value at the spot price of its money market
+type CoinValue struct {
+	Coin  sdk.Coin `json:"coin" yaml:"coin"`
+	Value sdk.Dec  `json:"value" yaml:"value"`
+}
+
+// NewCoinValue returns a new CoinValue
+func NewCoinValue(coin sdk.Coin, value sdk.Dec) CoinValue {
+	return CoinValue{
+		Coin:  coin,
+		Value: value,
+	}
+}
+
+// String implements fmt.Stringer
+func (cv CoinValue) String() string {
+	return fmt.Sprintf("%s ($%s)", cv.Coin, cv.Value)
+}
+
+// CoinValues a collection of CoinValue objects
+type CoinValues []CoinValue
+
+// String implements fmt.Stringer
+func (cvs CoinValues) String() string {
+	values := make([]string, len(cvs))
+	for i, cv := range cvs {
+		values[i] = cv.String()
+	}
+	return strings.Join(values, ", ")
+}
+
+// AccountHealth is the USD value of an address's deposits and borrow, and how close the borrow is to being liquidated
+type AccountHealth struct {
+	Owner               sdk.AccAddress `json:"owner" yaml:"owner"`
+	Deposits            CoinValues     `json:"deposits" yaml:"deposits"`                         // deposits including the supplier interest earned so far
+	Borrows             CoinValues     `json:"borrows" yaml:"borrows"`                           // borrowed coins including the interest accrued so far
+	DepositValue        sdk.Dec        `json:"deposit_value" yaml:"deposit_value"`               // total USD value of the deposits
+	BorrowValue         sdk.Dec        `json:"borrow_value" yaml:"borrow_value"`                 // total USD value of the borrowed coins
+	BorrowLimit         sdk.Dec        `json:"borrow_limit" yaml:"borrow_limit"`                 // USD value that can be borrowed at the loan-to-value of each deposit's money market
+	BorrowCapacity      sdk.Dec        `json:"borrow_capacity" yaml:"borrow_capacity"`           // USD value that can still be borrowed before the borrow limit is reached
+	LoanToValue         sdk.Dec        `json:"loan_to_value" yaml:"loan_to_value"`               // borrow value divided by deposit value
+	LiquidationDistance sdk.Dec        `json:"liquidation_distance" yaml:"liquidation_distance"` // fraction the deposit value can fall by before the borrow can be liquidated
+}
+
+// NewAccountHealth returns a new AccountHealth
+func NewAccountHealth(owner sdk.AccAddress, deposits, borrows CoinValues, depositValue, borrowValue, borrowLimit,
+	borrowCapacity, loanToValue, liquidationDistance sdk.Dec) AccountHealth {
+	return AccountHealth{
+		Owner:               owner,
+		Deposits:            deposits,
+		Borrows:             borrows,
+		DepositValue:        depositValue,
+		BorrowValue:         borrowValue,
+		BorrowLimit:         borrowLimit,
+		BorrowCapacity:      borrowCapacity,
+		LoanToValue:         loanToValue,
+		LiquidationDistance: liquidationDistance,
+	}
+}
+
+// String implements fmt.Stringer
+func (ah AccountHealth) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`Account Health:
+	Owner: %s
+	Deposits: %s
+	Borrows: %s
+	Deposit Value: %s
+	Borrow Value: %s
+	Borrow Limit: %s
+	Borrow Capacity: %s
+	Loan To Value: %s
+	Liquidation Distance: %s`,
+		ah.Owner, ah.Deposits, ah.Borrows, ah.DepositValue, ah.BorrowValue, ah.BorrowLimit,
+		ah.BorrowCapacity, ah.LoanToValue, ah.LiquidationDistance,
+	))
+}
@@ -38,6 +38,11 @@ var (
 	BorrowsKeyPrefix                  = []byte{0x05}
 	BorrowedCoinsPrefix               = []byte{0x06}
 	InterestRateModelsPrefix          = []byte{0x07}
+	BorrowInterestFactorPrefix        = []byte{0x08}
+	SupplyInterestFactorPrefix        = []byte{0x09}
+	PreviousAccrualTimePrefix         = []byte{0x0A}
+	TotalReservesPrefix               = []byte{0x0B}
+	SuppliedCoinsPrefix               = []byte{0x0C}
 	sep                               = []byte(":")
 )
 
@@ -53,6 +53,8 @@ var (
 	_ sdk.Msg = &MsgDeposit{}
 	_ sdk.Msg = &MsgWithdraw{}
 	_ sdk.Msg = &MsgBorrow{}
+	_ sdk.Msg = &MsgRepay{}
+	_ sdk.Msg = &MsgLiquidate{}
 )
 
 // MsgDeposit deposit collateral to the harvest module.
@@ -260,3 +262,109 @@ func (msg MsgBorrow) String() string {
 	Amount:   %s
 `, msg.Borrower, msg.Amount)
 }
+
+// MsgRepay repays funds to the harvest module.
+type MsgRepay struct {
+	Sender sdk.AccAddress `json:"sender" yaml:"sender"`
+	Owner  sdk.AccAddress `json:"owner" yaml:"owner"`
+	Amount sdk.Coins      `json:"amount" yaml:"amount"`
+}
+
+// NewMsgRepay returns a new MsgRepay
+func NewMsgRepay(sender, owner sdk.AccAddress, amount sdk.Coins) MsgRepay {
+	return MsgRepay{
+		Sender: sender,
+		Owner:  owner,
+		Amount: amount,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgRepay) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgRepay) Type() string { return "harvest_repay" }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgRepay) ValidateBasic() error {
+	if msg.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "sender address cannot be empty")
+	}
+	if msg.Owner.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "owner address cannot be empty")
+	}
+	if !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "repay amount %s", msg.Amount)
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgRepay) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgRepay) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+// String implements the Stringer interface
+func (msg MsgRepay) String() string {
+	return fmt.Sprintf(`Repay Message:
+	Sender:         %s
+	Owner:         %s
+	Amount:   %s
+`, msg.Sender, msg.Owner, msg.Amount)
+}
+
+// MsgLiquidate repays the borrow of a borrower that is over its loan-to-value limit, in exchange for the borrower's deposits
+type MsgLiquidate struct {
+	Liquidator sdk.AccAddress `json:"liquidator" yaml:"liquidator"`
+	Borrower   sdk.AccAddress `json:"borrower" yaml:"borrower"`
+}
+
+// NewMsgLiquidate returns a new MsgLiquidate
+func NewMsgLiquidate(liquidator, borrower sdk.AccAddress) MsgLiquidate {
+	return MsgLiquidate{
+		Liquidator: liquidator,
+		Borrower:   borrower,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgLiquidate) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgLiquidate) Type() string { return "harvest_liquidate" }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgLiquidate) ValidateBasic() error {
+	if msg.Liquidator.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "liquidator address cannot be empty")
+	}
+	if msg.Borrower.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "borrower address cannot be empty")
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgLiquidate) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgLiquidate) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Liquidator}
+}
+
+// String implements the Stringer interface
+func (msg MsgLiquidate) String() string {
+	return fmt.Sprintf(`Liquidate Message:
+	Liquidator:         %s
+	Borrower:         %s
+`, msg.Liquidator, msg.Borrower)
+}
@@ -192,6 +192,107 @@ func (suite *MsgTestSuite) TestMsgClaim() {
 	}
 }
 
+func (suite *MsgTestSuite) TestMsgRepay() {
+	type args struct {
+		sender sdk.AccAddress
+		owner  sdk.AccAddress
+		amount sdk.Coins
+	}
+	addrs := []sdk.AccAddress{
+		sdk.AccAddress("test1"),
+		sdk.AccAddress("test2"),
+	}
+	testCases := []struct {
+		name        string
+		args        args
+		expectPass  bool
+		expectedErr string
+	}{
+		{
+			name: "valid",
+			args: args{
+				sender: addrs[0],
+				owner:  addrs[0],
+				amount: sdk.NewCoins(sdk.NewCoin("bnb", sdk.NewInt(10000000))),
+			},
+			expectPass:  true,
+			expectedErr: "",
+		},
+		{
+			name: "valid: third party repayment",
+			args: args{
+				sender: addrs[0],
+				owner:  addrs[1],
+				amount: sdk.NewCoins(sdk.NewCoin("bnb", sdk.NewInt(10000000))),
+			},
+			expectPass:  true,
+			expectedErr: "",
+		},
+		{
+			name: "invalid: empty owner",
+			args: args{
+				sender: addrs[0],
+				owner:  sdk.AccAddress{},
+				amount: sdk.NewCoins(sdk.NewCoin("bnb", sdk.NewInt(10000000))),
+			},
+			expectPass:  false,
+			expectedErr: "owner address cannot be empty",
+		},
+		{
+			name: "invalid: zero amount",
+			args: args{
+				sender: addrs[0],
+				owner:  addrs[1],
+				amount: sdk.NewCoins(),
+			},
+			expectPass:  false,
+			expectedErr: "invalid coins",
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			msg := types.NewMsgRepay(tc.args.sender, tc.args.owner, tc.args.amount)
+			err := msg.ValidateBasic()
+			if tc.expectPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+				suite.Require().True(strings.Contains(err.Error(), tc.expectedErr))
+			}
+		})
+	}
+}
+
+func (suite *MsgTestSuite) TestMsgLiquidate() {
+	addrs := []sdk.AccAddress{
+		sdk.AccAddress("test1"),
+		sdk.AccAddress("test2"),
+	}
+	testCases := []struct {
+		name        string
+		liquidator  sdk.AccAddress
+		borrower    sdk.AccAddress
+		expectPass  bool
+		expectedErr string
+	}{
+		{"valid", addrs[0], addrs[1], true, ""},
+		{"invalid: empty liquidator", sdk.AccAddress{}, addrs[1], false, "liquidator address cannot be empty"},
+		{"invalid: empty borrower", addrs[0], sdk.AccAddress{}, false, "borrower address cannot be empty"},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			msg := types.NewMsgLiquidate(tc.liquidator, tc.borrower)
+			err := msg.ValidateBasic()
+			if tc.expectPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+				suite.Require().True(strings.Contains(err.Error(), tc.expectedErr))
+			}
+		})
+	}
+}
+
 func TestMsgTestSuite(t *testing.T) {
 	suite.Run(t, new(MsgTestSuite))
 }
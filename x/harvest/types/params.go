@@ -17,11 +17,13 @@ var (
 	KeyLPSchedules            = []byte("LPSchedules")
 	KeyDelegatorSchedule      = []byte("DelegatorSchedule")
 	KeyMoneyMarkets           = []byte("MoneyMarkets")
+	KeyCloseFactor            = []byte("CloseFactor")
 	DefaultActive             = true
 	DefaultGovSchedules       = DistributionSchedules{}
 	DefaultLPSchedules        = DistributionSchedules{}
 	DefaultDelegatorSchedules = DelegatorDistributionSchedules{}
 	DefaultMoneyMarkets       = MoneyMarkets{}
+	DefaultCloseFactor        = sdk.MustNewDecFromStr("0.5")
 	GovDenom                  = cdptypes.DefaultGovDenom
 )
 
@@ -31,6 +33,7 @@ type Params struct {
 	LiquidityProviderSchedules     DistributionSchedules          `json:"liquidity_provider_schedules" yaml:"liquidity_provider_schedules"`
 	DelegatorDistributionSchedules DelegatorDistributionSchedules `json:"delegator_distribution_schedules" yaml:"delegator_distribution_schedules"`
 	MoneyMarkets                   MoneyMarkets                   `json:"money_markets" yaml:"money_markets"`
+	CloseFactor                    sdk.Dec                        `json:"close_factor" yaml:"close_factor"` // fraction of each borrowed coin a liquidator repays in one liquidation
 }
 
 // DistributionSchedule distribution schedule for liquidity providers
@@ -253,22 +256,26 @@ func (bl BorrowLimit) Validate() error {
 
 // MoneyMarket is a money market for an individual asset
 type MoneyMarket struct {
-	Denom             string            `json:"denom" yaml:"denom"`
-	BorrowLimit       BorrowLimit       `json:"borrow_limit" yaml:"borrow_limit"`
-	SpotMarketID      string            `json:"spot_market_id" yaml:"spot_market_id"`
-	ConversionFactor  sdk.Int           `json:"conversion_factor" yaml:"conversion_factor"`
-	InterestRateModel InterestRateModel `json:"interest_rate_model" yaml:"interest_rate_model"`
+	Denom               string            `json:"denom" yaml:"denom"`
+	BorrowLimit         BorrowLimit       `json:"borrow_limit" yaml:"borrow_limit"`
+	SpotMarketID        string            `json:"spot_market_id" yaml:"spot_market_id"`
+	ConversionFactor    sdk.Int           `json:"conversion_factor" yaml:"conversion_factor"`
+	InterestRateModel   InterestRateModel `json:"interest_rate_model" yaml:"interest_rate_model"`
+	ReserveFactor       sdk.Dec           `json:"reserve_factor" yaml:"reserve_factor"`
+	LiquidationDiscount sdk.Dec           `json:"liquidation_discount" yaml:"liquidation_discount"`
 }
 
 // NewMoneyMarket returns a new MoneyMarket
 func NewMoneyMarket(denom string, hasMaxLimit bool, maximumLimit, loanToValue sdk.Dec,
-	spotMarketID string, conversionFactor sdk.Int, interestRateModel InterestRateModel) MoneyMarket {
+	spotMarketID string, conversionFactor sdk.Int, interestRateModel InterestRateModel, reserveFactor, liquidationDiscount sdk.Dec) MoneyMarket {
 	return MoneyMarket{
-		Denom:             denom,
-		BorrowLimit:       NewBorrowLimit(hasMaxLimit, maximumLimit, loanToValue),
-		SpotMarketID:      spotMarketID,
-		ConversionFactor:  conversionFactor,
-		InterestRateModel: interestRateModel,
+		Denom:               denom,
+		BorrowLimit:         NewBorrowLimit(hasMaxLimit, maximumLimit, loanToValue),
+		SpotMarketID:        spotMarketID,
+		ConversionFactor:    conversionFactor,
+		InterestRateModel:   interestRateModel,
+		ReserveFactor:       reserveFactor,
+		LiquidationDiscount: liquidationDiscount,
 	}
 }
 
@@ -285,6 +292,14 @@ func (mm MoneyMarket) Validate() error {
 	if err := mm.InterestRateModel.Validate(); err != nil {
 		return err
 	}
+
+	if mm.ReserveFactor.IsNil() || mm.ReserveFactor.IsNegative() || mm.ReserveFactor.GT(sdk.OneDec()) {
+		return fmt.Errorf("Reserve factor must be between 0.0-1.0")
+	}
+
+	if mm.LiquidationDiscount.IsNil() || mm.LiquidationDiscount.IsNegative() || mm.LiquidationDiscount.GTE(sdk.OneDec()) {
+		return fmt.Errorf("Liquidation discount must be between 0.0-1.0, excluding 1.0")
+	}
 	return nil
 }
 
@@ -361,18 +376,19 @@ func (irm InterestRateModel) Equal(comparisonIRM InterestRateModel) bool {
 type InterestRateModels []InterestRateModel
 
 // NewParams returns a new params object
-func NewParams(active bool, lps DistributionSchedules, dds DelegatorDistributionSchedules, moneyMarkets MoneyMarkets) Params {
+func NewParams(active bool, lps DistributionSchedules, dds DelegatorDistributionSchedules, moneyMarkets MoneyMarkets, closeFactor sdk.Dec) Params {
 	return Params{
 		Active:                         active,
 		LiquidityProviderSchedules:     lps,
 		DelegatorDistributionSchedules: dds,
 		MoneyMarkets:                   moneyMarkets,
+		CloseFactor:                    closeFactor,
 	}
 }
 
 // DefaultParams returns default params for harvest module
 func DefaultParams() Params {
-	return NewParams(DefaultActive, DefaultLPSchedules, DefaultDelegatorSchedules, DefaultMoneyMarkets)
+	return NewParams(DefaultActive, DefaultLPSchedules, DefaultDelegatorSchedules, DefaultMoneyMarkets, DefaultCloseFactor)
 }
 
 // String implements fmt.Stringer
@@ -381,7 +397,8 @@ func (p Params) String() string {
 	Active: %t
 	Liquidity Provider Distribution Schedules %s
 	Delegator Distribution Schedule %s
-	Money Markets %v`, p.Active, p.LiquidityProviderSchedules, p.DelegatorDistributionSchedules, p.MoneyMarkets)
+	Money Markets %v
+	Close Factor: %s`, p.Active, p.LiquidityProviderSchedules, p.DelegatorDistributionSchedules, p.MoneyMarkets, p.CloseFactor)
 }
 
 // ParamKeyTable Key declaration for parameters
@@ -396,6 +413,7 @@ func (p *Params) ParamSetPairs() params.ParamSetPairs {
 		params.NewParamSetPair(KeyLPSchedules, &p.LiquidityProviderSchedules, validateLPParams),
 		params.NewParamSetPair(KeyDelegatorSchedule, &p.DelegatorDistributionSchedules, validateDelegatorParams),
 		params.NewParamSetPair(KeyMoneyMarkets, &p.MoneyMarkets, validateMoneyMarketParams),
+		params.NewParamSetPair(KeyCloseFactor, &p.CloseFactor, validateCloseFactorParam),
 	}
 }
 
@@ -409,7 +427,15 @@ func (p Params) Validate() error {
 		return err
 	}
 
-	return validateLPParams(p.LiquidityProviderSchedules)
+	if err := validateLPParams(p.LiquidityProviderSchedules); err != nil {
+		return err
+	}
+
+	if err := validateMoneyMarketParams(p.MoneyMarkets); err != nil {
+		return err
+	}
+
+	return validateCloseFactorParam(p.CloseFactor)
 }
 
 func validateActiveParam(i interface{}) error {
@@ -454,3 +480,16 @@ func validateMoneyMarketParams(i interface{}) error {
 
 	return mm.Validate()
 }
+
+func validateCloseFactorParam(i interface{}) error {
+	closeFactor, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if closeFactor.IsNil() || !closeFactor.IsPositive() || closeFactor.GT(sdk.OneDec()) {
+		return fmt.Errorf("close factor must be greater than 0.0 and at most 1.0: %s", closeFactor)
+	}
+
+	return nil
+}
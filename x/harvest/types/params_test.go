@@ -18,11 +18,12 @@ type ParamTestSuite struct {
 
 func (suite *ParamTestSuite) TestParamValidation() {
 	type args struct {
-		lps    types.DistributionSchedules
-		gds    types.DistributionSchedules
-		dds    types.DelegatorDistributionSchedules
-		mms    types.MoneyMarkets
-		active bool
+		lps         types.DistributionSchedules
+		gds         types.DistributionSchedules
+		dds         types.DelegatorDistributionSchedules
+		mms         types.MoneyMarkets
+		closeFactor sdk.Dec
+		active      bool
 	}
 	testCases := []struct {
 		name        string
@@ -33,9 +34,10 @@ func (suite *ParamTestSuite) TestParamValidation() {
 		{
 			name: "default",
 			args: args{
-				lps:    types.DefaultLPSchedules,
-				dds:    types.DefaultDelegatorSchedules,
-				active: types.DefaultActive,
+				lps:         types.DefaultLPSchedules,
+				dds:         types.DefaultDelegatorSchedules,
+				closeFactor: types.DefaultCloseFactor,
+				active:      types.DefaultActive,
 			},
 			expectPass:  true,
 			expectedErr: "",
@@ -51,8 +53,9 @@ func (suite *ParamTestSuite) TestParamValidation() {
 					time.Hour*24,
 				),
 				},
-				mms:    types.DefaultMoneyMarkets,
-				active: true,
+				mms:         types.DefaultMoneyMarkets,
+				closeFactor: types.DefaultCloseFactor,
+				active:      true,
 			},
 			expectPass:  true,
 			expectedErr: "",
@@ -68,16 +71,29 @@ func (suite *ParamTestSuite) TestParamValidation() {
 					time.Hour*24,
 				),
 				},
-				mms:    types.DefaultMoneyMarkets,
-				active: true,
+				mms:         types.DefaultMoneyMarkets,
+				closeFactor: types.DefaultCloseFactor,
+				active:      true,
 			},
 			expectPass:  false,
 			expectedErr: "reward denom should be hard",
 		},
+		{
+			name: "invalid close factor",
+			args: args{
+				lps:         types.DefaultLPSchedules,
+				dds:         types.DefaultDelegatorSchedules,
+				mms:         types.DefaultMoneyMarkets,
+				closeFactor: sdk.MustNewDecFromStr("1.1"),
+				active:      true,
+			},
+			expectPass:  false,
+			expectedErr: "close factor must be greater than 0.0 and at most 1.0",
+		},
 	}
 	for _, tc := range testCases {
 		suite.Run(tc.name, func() {
-			params := types.NewParams(tc.args.active, tc.args.lps, tc.args.dds, tc.args.mms)
+			params := types.NewParams(tc.args.active, tc.args.lps, tc.args.dds, tc.args.mms, tc.args.closeFactor)
 			err := params.Validate()
 			if tc.expectPass {
 				suite.NoError(err)
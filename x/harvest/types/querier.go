@@ -12,6 +12,8 @@ const (
 	QueryGetClaims         = "claims"
 	QueryGetBorrows        = "borrows"
 	QueryGetBorrowed       = "borrowed"
+	QueryGetMaxWithdraw    = "max-withdraw"
+	QueryGetAccountHealth  = "account-health"
 )
 
 // QueryDepositParams is the params for a filtered deposit query
@@ -97,3 +99,27 @@ func NewQueryBorrowedParams(denom string) QueryBorrowedParams {
 		Denom: denom,
 	}
 }
+
+// QueryMaxWithdrawParams is the params for a maximum withdrawable deposits query
+type QueryMaxWithdrawParams struct {
+	Owner sdk.AccAddress `json:"owner" yaml:"owner"`
+}
+
+// NewQueryMaxWithdrawParams creates a new QueryMaxWithdrawParams
+func NewQueryMaxWithdrawParams(owner sdk.AccAddress) QueryMaxWithdrawParams {
+	return QueryMaxWithdrawParams{
+		Owner: owner,
+	}
+}
+
+// QueryAccountHealthParams is the params for an account health query
+type QueryAccountHealthParams struct {
+	Owner sdk.AccAddress `json:"owner" yaml:"owner"`
+}
+
+// NewQueryAccountHealthParams creates a new QueryAccountHealthParams
+func NewQueryAccountHealthParams(owner sdk.AccAddress) QueryAccountHealthParams {
+	return QueryAccountHealthParams{
+		Owner: owner,
+	}
+}
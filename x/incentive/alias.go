@@ -79,7 +79,6 @@ var (
 	ModuleCdc                        = types.ModuleCdc
 	NextClaimPeriodIDPrefix          = types.NextClaimPeriodIDPrefix
 	PreviousBlockTimeKey             = types.PreviousBlockTimeKey
-	PrincipalDenom                   = types.PrincipalDenom
 	RewardPeriodKeyPrefix            = types.RewardPeriodKeyPrefix
 )
 
@@ -49,7 +49,7 @@ func (k Keeper) CreateAndDeleteRewardPeriods(ctx sdk.Context) {
 }
 
 // ApplyRewardsToCdps iterates over the reward periods and creates a claim for each
-// cdp owner that created usdx with the collateral specified in the reward period.
+// cdp owner that created debt with the collateral specified in the reward period.
 func (k Keeper) ApplyRewardsToCdps(ctx sdk.Context) {
 	previousBlockTime, found := k.GetPreviousBlockTime(ctx)
 	if !found {
@@ -60,8 +60,12 @@ func (k Keeper) ApplyRewardsToCdps(ctx sdk.Context) {
 
 	k.IterateRewardPeriods(ctx, func(rp types.RewardPeriod) bool {
 		expired := false
-		// the total amount of usdx created with the collateral type being incentivized
-		totalPrincipal := k.cdpKeeper.GetTotalPrincipal(ctx, rp.CollateralType, types.PrincipalDenom)
+		// the total amount of debt created with the collateral type being incentivized
+		cp, found := k.cdpKeeper.GetCollateral(ctx, rp.CollateralType)
+		if !found {
+			return false
+		}
+		totalPrincipal := k.cdpKeeper.GetTotalPrincipal(ctx, rp.CollateralType, cp.DebtLimit.Denom)
 		// the number of seconds since last payout
 		timeElapsed := sdk.NewInt(ctx.BlockTime().Unix() - previousBlockTime.Unix())
 		if rp.End.Before(ctx.BlockTime()) {
@@ -73,7 +77,9 @@ func (k Keeper) ApplyRewardsToCdps(ctx sdk.Context) {
 		rewardsThisPeriod := rp.Reward.Amount.Mul(timeElapsed)
 		id := k.GetNextClaimPeriodID(ctx, rp.CollateralType)
 		k.cdpKeeper.IterateCdpsByCollateralType(ctx, rp.CollateralType, func(cdp cdptypes.CDP) bool {
-			rewardsShare := sdk.NewDecFromInt(cdp.GetTotalPrincipal().Amount).Quo(sdk.NewDecFromInt(totalPrincipal))
+			// include the fees accumulated since the cdp was last synchronized, as they are part of the total principal
+			cdpPrincipal := cdp.GetTotalPrincipal().Add(k.cdpKeeper.CalculateNewInterest(ctx, cdp))
+			rewardsShare := sdk.NewDecFromInt(cdpPrincipal.Amount).Quo(sdk.NewDecFromInt(totalPrincipal))
 			// sanity check - don't create zero claims
 			if rewardsShare.IsZero() {
 				return false
@@ -177,11 +177,7 @@ func (suite *KeeperTestSuite) setupCdpChain() {
 	// need incentive params for one collateral
 	cdpGS := cdp.GenesisState{
 		Params: cdp.Params{
-			GlobalDebtLimit:              sdk.NewInt64Coin("usdx", 1000000000000),
-			SurplusAuctionThreshold:      cdp.DefaultSurplusThreshold,
-			SurplusAuctionLot:            cdp.DefaultSurplusLot,
-			DebtAuctionThreshold:         cdp.DefaultDebtThreshold,
-			DebtAuctionLot:               cdp.DefaultDebtLot,
+			GlobalDebtLimit:              sdk.NewCoins(sdk.NewInt64Coin("usdx", 1000000000000)),
 			SavingsDistributionFrequency: cdp.DefaultSavingsDistributionFrequency,
 			CollateralParams: cdp.CollateralParams{
 				{
@@ -198,12 +194,18 @@ func (suite *KeeperTestSuite) setupCdpChain() {
 					ConversionFactor:    i(8),
 				},
 			},
-			DebtParam: cdp.DebtParam{
-				Denom:            "usdx",
-				ReferenceAsset:   "usd",
-				ConversionFactor: i(6),
-				DebtFloor:        i(10000000),
-				SavingsRate:      d("0.95"),
+			DebtParams: cdp.DebtParams{
+				{
+					Denom:                   "usdx",
+					ReferenceAsset:          "usd",
+					ConversionFactor:        i(6),
+					DebtFloor:               i(10000000),
+					SavingsRate:             d("0.95"),
+					SurplusAuctionThreshold: cdp.DefaultSurplusThreshold,
+					SurplusAuctionLot:       cdp.DefaultSurplusLot,
+					DebtAuctionThreshold:    cdp.DefaultDebtThreshold,
+					DebtAuctionLot:          cdp.DefaultDebtLot,
+				},
 			},
 		},
 		StartingCdpID:            cdp.DefaultCdpStartingID,
@@ -19,6 +19,8 @@ type SupplyKeeper interface {
 type CdpKeeper interface {
 	IterateCdpsByCollateralType(ctx sdk.Context, collateralType string, cb func(cdp cdptypes.CDP) (stop bool))
 	GetTotalPrincipal(ctx sdk.Context, collateralType string, principalDenom string) (total sdk.Int)
+	CalculateNewInterest(ctx sdk.Context, cdp cdptypes.CDP) sdk.Coin
+	GetCollateral(ctx sdk.Context, collateralType string) (cdptypes.CollateralParam, bool)
 }
 
 // AccountKeeper defines the expected keeper interface for interacting with account
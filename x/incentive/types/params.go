@@ -29,7 +29,6 @@ var (
 	DefaultRewards           = Rewards{}
 	DefaultPreviousBlockTime = tmtime.Canonical(time.Unix(0, 0))
 	GovDenom                 = cdptypes.DefaultGovDenom
-	PrincipalDenom           = "usdx"
 	IncentiveMacc            = kavadistTypes.ModuleName
 )
 
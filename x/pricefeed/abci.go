@@ -9,15 +9,18 @@ import (
 
 // EndBlocker updates the current pricefeed
 func EndBlocker(ctx sdk.Context, k Keeper) {
-	// Update the current price of each asset.
-	for _, market := range k.GetMarkets(ctx) {
-		if !market.Active {
-			continue
-		}
+	markets := k.GetMarkets(ctx)
+	// Update the current price of each asset, then the derived prices, which are calculated from the current prices.
+	for _, derived := range []bool{false, true} {
+		for _, market := range markets {
+			if !market.Active || market.IsDerived() != derived {
+				continue
+			}
 
-		err := k.SetCurrentPrices(ctx, market.MarketID)
-		if err != nil && !errors.Is(err, types.ErrNoValidPrice) {
-			panic(err)
+			err := k.SetCurrentPrices(ctx, market.MarketID)
+			if err != nil && !errors.Is(err, types.ErrNoValidPrice) {
+				panic(err)
+			}
 		}
 	}
 }
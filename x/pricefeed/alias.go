@@ -12,22 +12,36 @@ const (
 	AttributeMarketID           = types.AttributeMarketID
 	AttributeMarketPrice        = types.AttributeMarketPrice
 	AttributeOracle             = types.AttributeOracle
+	AttributeReason             = types.AttributeReason
 	AttributeValueCategory      = types.AttributeValueCategory
+	AttributeValueDeviation     = types.AttributeValueDeviation
+	AttributeValueMissedWindows = types.AttributeValueMissedWindows
+	AttributeValuePriceChange   = types.AttributeValuePriceChange
+	AttributeValueQuorum        = types.AttributeValueQuorum
+	AttributeValueSource        = types.AttributeValueSource
 	DefaultParamspace           = types.DefaultParamspace
+	EventTypeMarketPriceStale   = types.EventTypeMarketPriceStale
 	EventTypeMarketPriceUpdated = types.EventTypeMarketPriceUpdated
 	EventTypeNoValidPrices      = types.EventTypeNoValidPrices
+	EventTypeOracleDelinquent   = types.EventTypeOracleDelinquent
 	EventTypeOracleUpdatedPrice = types.EventTypeOracleUpdatedPrice
 	MaxExpiry                   = types.MaxExpiry
+	MaxMarketIDLength           = types.MaxMarketIDLength
 	ModuleName                  = types.ModuleName
+	OracleDeviationWindow       = types.OracleDeviationWindow
 	QuerierRoute                = types.QuerierRoute
 	QueryGetParams              = types.QueryGetParams
 	QueryMarkets                = types.QueryMarkets
+	QueryOracleRecords          = types.QueryOracleRecords
 	QueryOracles                = types.QueryOracles
 	QueryPrice                  = types.QueryPrice
+	QueryPriceHistory           = types.QueryPriceHistory
 	QueryRawPrices              = types.QueryRawPrices
+	QueryTWAP                   = types.QueryTWAP
 	RouterKey                   = types.RouterKey
 	StoreKey                    = types.StoreKey
 	TypeMsgPostPrice            = types.TypeMsgPostPrice
+	TypeMsgPostPrices           = types.TypeMsgPostPrices
 )
 
 var (
@@ -37,29 +51,60 @@ var (
 	CurrentPriceKey            = types.CurrentPriceKey
 	DefaultGenesisState        = types.DefaultGenesisState
 	DefaultParams              = types.DefaultParams
+	GetSequenceBytes           = types.GetSequenceBytes
+	GetSequenceFromBytes       = types.GetSequenceFromBytes
+	LegacyRawPriceKey          = types.LegacyRawPriceKey
 	NewCurrentPrice            = types.NewCurrentPrice
 	NewGenesisState            = types.NewGenesisState
 	NewMarket                  = types.NewMarket
+	NewMarketSource            = types.NewMarketSource
 	NewMsgPostPrice            = types.NewMsgPostPrice
+	NewMsgPostPrices           = types.NewMsgPostPrices
+	NewOracleRecord            = types.NewOracleRecord
 	NewParams                  = types.NewParams
 	NewPostedPrice             = types.NewPostedPrice
+	NewPriceEntry              = types.NewPriceEntry
+	NewPriceSample             = types.NewPriceSample
+	NewQueryTWAPParams         = types.NewQueryTWAPParams
 	NewQueryWithMarketIDParams = types.NewQueryWithMarketIDParams
+	NewWeightedPrice           = types.NewWeightedPrice
+	OracleRecordIteratorKey    = types.OracleRecordIteratorKey
+	OracleRecordKey            = types.OracleRecordKey
 	ParamKeyTable              = types.ParamKeyTable
+	PriceHistoryIteratorKey    = types.PriceHistoryIteratorKey
+	PriceHistoryKey            = types.PriceHistoryKey
+	PriceHistorySequenceKey    = types.PriceHistorySequenceKey
+	RawPriceIteratorKey        = types.RawPriceIteratorKey
 	RawPriceKey                = types.RawPriceKey
 	RegisterCodec              = types.RegisterCodec
+	StaleMarketKey             = types.StaleMarketKey
 
 	// variable aliases
-	CurrentPricePrefix = types.CurrentPricePrefix
-	DefaultMarkets     = types.DefaultMarkets
-	ErrAssetNotFound   = types.ErrAssetNotFound
-	ErrEmptyInput      = types.ErrEmptyInput
-	ErrExpired         = types.ErrExpired
-	ErrInvalidMarket   = types.ErrInvalidMarket
-	ErrInvalidOracle   = types.ErrInvalidOracle
-	ErrNoValidPrice    = types.ErrNoValidPrice
-	KeyMarkets         = types.KeyMarkets
-	ModuleCdc          = types.ModuleCdc
-	RawPriceFeedPrefix = types.RawPriceFeedPrefix
+	CurrentPricePrefix              = types.CurrentPricePrefix
+	DefaultDeviationThreshold       = types.DefaultDeviationThreshold
+	DefaultMarkets                  = types.DefaultMarkets
+	DefaultMissedWindowsThreshold   = types.DefaultMissedWindowsThreshold
+	DefaultPriceHistoryLength       = types.DefaultPriceHistoryLength
+	ErrAssetNotFound                = types.ErrAssetNotFound
+	ErrEmptyInput                   = types.ErrEmptyInput
+	ErrExpired                      = types.ErrExpired
+	ErrInsufficientPriceHistory     = types.ErrInsufficientPriceHistory
+	ErrInvalidMarket                = types.ErrInvalidMarket
+	ErrInvalidOracle                = types.ErrInvalidOracle
+	ErrInvalidWindow                = types.ErrInvalidWindow
+	ErrNoValidPrice                 = types.ErrNoValidPrice
+	KeyMarkets                      = types.KeyMarkets
+	KeyOracleDeviationThreshold     = types.KeyOracleDeviationThreshold
+	KeyOracleMissedWindowsThreshold = types.KeyOracleMissedWindowsThreshold
+	KeyPriceHistoryLength           = types.KeyPriceHistoryLength
+	LegacyRawPriceFeedPrefix        = types.LegacyRawPriceFeedPrefix
+	MaxPriceHistoryLength           = types.MaxPriceHistoryLength
+	ModuleCdc                       = types.ModuleCdc
+	OracleRecordPrefix              = types.OracleRecordPrefix
+	PriceHistoryPrefix              = types.PriceHistoryPrefix
+	PriceHistorySequencePrefix      = types.PriceHistorySequencePrefix
+	RawPriceFeedPrefix              = types.RawPriceFeedPrefix
+	StaleMarketPrefix               = types.StaleMarketPrefix
 )
 
 type (
@@ -68,11 +113,22 @@ type (
 	CurrentPrices           = types.CurrentPrices
 	GenesisState            = types.GenesisState
 	Market                  = types.Market
+	MarketSource            = types.MarketSource
+	MarketSources           = types.MarketSources
 	Markets                 = types.Markets
 	MsgPostPrice            = types.MsgPostPrice
+	MsgPostPrices           = types.MsgPostPrices
+	OracleRecord            = types.OracleRecord
+	OracleRecords           = types.OracleRecords
 	Params                  = types.Params
 	PostedPrice             = types.PostedPrice
 	PostedPrices            = types.PostedPrices
+	PriceEntry              = types.PriceEntry
+	PriceSample             = types.PriceSample
+	PriceSamples            = types.PriceSamples
+	QueryTWAPParams         = types.QueryTWAPParams
 	QueryWithMarketIDParams = types.QueryWithMarketIDParams
 	SortDecs                = types.SortDecs
+	WeightedPrice           = types.WeightedPrice
+	WeightedPrices          = types.WeightedPrices
 )
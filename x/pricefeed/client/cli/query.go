@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -10,6 +11,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
 
 	"github.com/kava-labs/kava/x/pricefeed/types"
 )
@@ -28,7 +30,10 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	pricefeedQueryCmd.AddCommand(flags.GetCommands(
 		GetCmdPrice(queryRoute, cdc),
 		GetCmdRawPrices(queryRoute, cdc),
+		GetCmdPriceHistory(queryRoute, cdc),
+		GetCmdTWAP(queryRoute, cdc),
 		GetCmdOracles(queryRoute, cdc),
+		GetCmdOracleRecords(queryRoute, cdc),
 		GetCmdMarkets(queryRoute, cdc),
 		GetCmdQueryParams(queryRoute, cdc),
 	)...)
@@ -65,6 +70,36 @@ func GetCmdOracles(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	}
 }
 
+// GetCmdOracleRecords queries the accountability records of the oracles of an asset
+func GetCmdOracleRecords(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "oracle-records [marketID]",
+		Short: "get the posting records of the oracles for a market",
+		Long:  "Get the last post time, number of expired prices that were not refreshed, and rolling deviation from the median price of each oracle that has posted prices for a market.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			marketID := args[0]
+
+			bz, err := cdc.MarshalJSON(types.QueryWithMarketIDParams{
+				MarketID: marketID,
+			})
+			if err != nil {
+				return err
+			}
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryOracleRecords)
+
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+			var records types.OracleRecords
+			cdc.MustUnmarshalJSON(res, &records)
+			return cliCtx.PrintOutput(records)
+		},
+	}
+}
+
 // GetCmdPrice queries the current price of an asset
 func GetCmdPrice(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
@@ -124,6 +159,67 @@ func GetCmdRawPrices(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	}
 }
 
+// GetCmdPriceHistory queries the recorded median prices of an asset
+func GetCmdPriceHistory(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "history [marketID]",
+		Short: "get the recorded median prices for the input market",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			marketID := args[0]
+
+			bz, err := cdc.MarshalJSON(types.QueryWithMarketIDParams{
+				MarketID: marketID,
+			})
+			if err != nil {
+				return err
+			}
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryPriceHistory)
+
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+			var history types.PriceSamples
+			cdc.MustUnmarshalJSON(res, &history)
+			return cliCtx.PrintOutput(history)
+		},
+	}
+}
+
+// GetCmdTWAP queries the time weighted average price of an asset
+func GetCmdTWAP(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:     "twap [marketID] [window]",
+		Short:   "get the time weighted average price for the input market",
+		Example: fmt.Sprintf("%s query %s twap bnb:usd 1h", version.ClientName, types.ModuleName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			marketID := args[0]
+			window, err := time.ParseDuration(args[1])
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryTWAPParams(marketID, window))
+			if err != nil {
+				return err
+			}
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryTWAP)
+
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+			var price types.CurrentPrice
+			cdc.MustUnmarshalJSON(res, &price)
+			return cliCtx.PrintOutput(price)
+		},
+	}
+}
+
 // GetCmdMarkets queries list of markets in the pricefeed
 func GetCmdMarkets(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
@@ -4,6 +4,7 @@ import (
 	"bufio"
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
@@ -34,6 +35,7 @@ func GetTxCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
 
 	pricefeedTxCmd.AddCommand(flags.PostCommands(
 		GetCmdPostPrice(cdc),
+		GetCmdPostPrices(cdc),
 	)...)
 
 	return pricefeedTxCmd
@@ -57,19 +59,53 @@ func GetCmdPostPrice(cdc *codec.Codec) *cobra.Command {
 				return err
 			}
 
-			expiryInt, err := strconv.ParseInt(args[2], 10, 64)
+			expiry, err := parseExpiry(args[2])
 			if err != nil {
-				return fmt.Errorf("invalid expiry %s: %w", args[2], err)
+				return err
 			}
 
-			if expiryInt > types.MaxExpiry {
-				return fmt.Errorf("invalid expiry; got %d, max: %d", expiryInt, types.MaxExpiry)
+			msg := types.NewMsgPostPrice(cliCtx.GetFromAddress(), args[0], price, expiry)
+			if err = msg.ValidateBasic(); err != nil {
+				return err
 			}
 
-			expiry := tmtime.Canonical(time.Unix(expiryInt, 0))
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
 
-			msg := types.NewMsgPostPrice(cliCtx.GetFromAddress(), args[0], price, expiry)
-			if err = msg.ValidateBasic(); err != nil {
+// GetCmdPostPrices cli command for posting prices for several markets in one transaction.
+func GetCmdPostPrices(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "postprices [marketID,price,expiry]...",
+		Short: "post the latest prices for several markets, each with a given expiry as a UNIX time",
+		Example: fmt.Sprintf("%s tx %s postprices bnb:usd,25,9999999999 btc:usd,11000,9999999999 --from validator",
+			version.ClientName, types.ModuleName),
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			var prices []types.PriceEntry
+			for _, arg := range args {
+				fields := strings.Split(arg, ",")
+				if len(fields) != 3 {
+					return fmt.Errorf("invalid price entry %s, expected marketID,price,expiry", arg)
+				}
+				price, err := sdk.NewDecFromStr(fields[1])
+				if err != nil {
+					return err
+				}
+				expiry, err := parseExpiry(fields[2])
+				if err != nil {
+					return err
+				}
+				prices = append(prices, types.NewPriceEntry(fields[0], price, expiry))
+			}
+
+			msg := types.NewMsgPostPrices(cliCtx.GetFromAddress(), prices)
+			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
 
@@ -77,3 +113,17 @@ func GetCmdPostPrice(cdc *codec.Codec) *cobra.Command {
 		},
 	}
 }
+
+// parseExpiry parses an expiry given as a UNIX time
+func parseExpiry(arg string) (time.Time, error) {
+	expiryInt, err := strconv.ParseInt(arg, 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid expiry %s: %w", arg, err)
+	}
+
+	if expiryInt > types.MaxExpiry {
+		return time.Time{}, fmt.Errorf("invalid expiry; got %d, max: %d", expiryInt, types.MaxExpiry)
+	}
+
+	return tmtime.Canonical(time.Unix(expiryInt, 0)), nil
+}
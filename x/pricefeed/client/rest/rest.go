@@ -9,6 +9,7 @@ import (
 
 const (
 	RestMarketID = "market_id"
+	RestWindow   = "window"
 )
 
 // PostPriceReq defines the properties of a PostPrice request's body.
@@ -19,6 +20,19 @@ type PostPriceReq struct {
 	Expiry   string       `json:"expiry"`
 }
 
+// PriceEntryReq defines the properties of a price in a PostPrices request's body.
+type PriceEntryReq struct {
+	MarketID string `json:"market_id"`
+	Price    string `json:"price"`
+	Expiry   string `json:"expiry"`
+}
+
+// PostPricesReq defines the properties of a PostPrices request's body.
+type PostPricesReq struct {
+	BaseReq rest.BaseReq    `json:"base_req"`
+	Prices  []PriceEntryReq `json:"prices"`
+}
+
 // RegisterRoutes - Central function to define routes that get registered by the main application
 func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	registerQueryRoutes(cliCtx, r)
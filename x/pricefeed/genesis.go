@@ -1,6 +1,8 @@
 package pricefeed
 
 import (
+	"errors"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -22,7 +24,7 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, gs GenesisState) {
 
 	// Set the current price (if any) based on what's now in the store
 	for _, market := range params.Markets {
-		if !market.Active {
+		if !market.Active || market.IsDerived() {
 			continue
 		}
 		rps, err := keeper.GetRawPrices(ctx, market.MarketID)
@@ -37,6 +39,17 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, gs GenesisState) {
 			panic(err)
 		}
 	}
+
+	// Set the derived prices (if any) based on the current prices
+	for _, market := range params.Markets {
+		if !market.Active || !market.IsDerived() {
+			continue
+		}
+		err := keeper.SetCurrentPrices(ctx, market.MarketID)
+		if err != nil && !errors.Is(err, ErrNoValidPrice) {
+			panic(err)
+		}
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
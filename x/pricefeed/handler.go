@@ -12,6 +12,8 @@ func NewHandler(k Keeper) sdk.Handler {
 		switch msg := msg.(type) {
 		case MsgPostPrice:
 			return HandleMsgPostPrice(ctx, k, msg)
+		case MsgPostPrices:
+			return HandleMsgPostPrices(ctx, k, msg)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", ModuleName, msg)
 		}
@@ -46,3 +48,37 @@ func HandleMsgPostPrice(
 
 	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
 }
+
+// HandleMsgPostPrices handles prices for several markets posted by an oracle.
+// Every price is checked before any are set, so either all of the prices are posted or none are.
+func HandleMsgPostPrices(
+	ctx sdk.Context,
+	k Keeper,
+	msg MsgPostPrices) (*sdk.Result, error) {
+
+	for _, pe := range msg.Prices {
+		_, err := k.GetOracle(ctx, pe.MarketID, msg.From)
+		if err != nil {
+			return nil, sdkerrors.Wrap(err, pe.MarketID)
+		}
+		if !pe.Expiry.After(ctx.BlockTime()) {
+			return nil, sdkerrors.Wrap(ErrExpired, pe.MarketID)
+		}
+	}
+	for _, pe := range msg.Prices {
+		_, err := k.SetPrice(ctx, msg.From, pe.MarketID, pe.Price, pe.Expiry)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.From.String()),
+		),
+	)
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
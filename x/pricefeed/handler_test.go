@@ -0,0 +1,118 @@
+package pricefeed_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmtime "github.com/tendermint/tendermint/types/time"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/pricefeed"
+)
+
+type HandlerTestSuite struct {
+	suite.Suite
+
+	ctx     sdk.Context
+	app     app.TestApp
+	handler sdk.Handler
+	keeper  pricefeed.Keeper
+	addrs   []sdk.AccAddress
+}
+
+func (suite *HandlerTestSuite) SetupTest() {
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, abci.Header{Height: 1, Time: tmtime.Now()})
+	_, addrs := app.GeneratePrivKeyAddressPairs(3)
+	tApp.InitializeFromGenesisStates(
+		NewPricefeedGenStateWithOracles(addrs[:2]),
+	)
+	keeper := tApp.GetPriceFeedKeeper()
+	suite.handler = pricefeed.NewHandler(keeper)
+	suite.app = tApp
+	suite.keeper = keeper
+	suite.ctx = ctx
+	suite.addrs = addrs
+}
+
+func (suite *HandlerTestSuite) TestMsgPostPrices() {
+	expiry := suite.ctx.BlockTime().Add(time.Hour)
+	msg := pricefeed.NewMsgPostPrices(suite.addrs[1], []pricefeed.PriceEntry{
+		pricefeed.NewPriceEntry("btc:usd", sdk.MustNewDecFromStr("9000.00"), expiry),
+		pricefeed.NewPriceEntry("xrp:usd", sdk.MustNewDecFromStr("0.30"), expiry),
+	})
+	res, err := suite.handler(suite.ctx, msg)
+	suite.Require().NoError(err)
+
+	for _, pe := range msg.Prices {
+		rawPrices, err := suite.keeper.GetRawPrices(suite.ctx, pe.MarketID)
+		suite.Require().NoError(err)
+		suite.Require().Contains(rawPrices, pricefeed.NewPostedPrice(pe.MarketID, suite.addrs[1], pe.Price, expiry))
+	}
+
+	var updatedMarkets []string
+	for _, e := range res.Events {
+		if e.Type != pricefeed.EventTypeOracleUpdatedPrice {
+			continue
+		}
+		for _, attr := range e.Attributes {
+			if string(attr.Key) == pricefeed.AttributeMarketID {
+				updatedMarkets = append(updatedMarkets, string(attr.Value))
+			}
+		}
+	}
+	suite.Require().Equal([]string{"btc:usd", "xrp:usd"}, updatedMarkets)
+}
+
+func (suite *HandlerTestSuite) TestMsgPostPrices_Atomic() {
+	expiry := suite.ctx.BlockTime().Add(time.Hour)
+	testCases := []struct {
+		name   string
+		oracle sdk.AccAddress
+		prices []pricefeed.PriceEntry
+	}{
+		{
+			"invalid market",
+			suite.addrs[1],
+			[]pricefeed.PriceEntry{
+				pricefeed.NewPriceEntry("btc:usd", sdk.MustNewDecFromStr("9000.00"), expiry),
+				pricefeed.NewPriceEntry("eth:usd", sdk.MustNewDecFromStr("400.00"), expiry),
+			},
+		},
+		{
+			"expired price",
+			suite.addrs[1],
+			[]pricefeed.PriceEntry{
+				pricefeed.NewPriceEntry("btc:usd", sdk.MustNewDecFromStr("9000.00"), expiry),
+				pricefeed.NewPriceEntry("xrp:usd", sdk.MustNewDecFromStr("0.30"), suite.ctx.BlockTime()),
+			},
+		},
+		{
+			"not an oracle",
+			suite.addrs[2],
+			[]pricefeed.PriceEntry{
+				pricefeed.NewPriceEntry("btc:usd", sdk.MustNewDecFromStr("9000.00"), expiry),
+			},
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			_, err := suite.handler(suite.ctx, pricefeed.NewMsgPostPrices(tc.oracle, tc.prices))
+			suite.Require().Error(err)
+
+			// no prices are posted if any are invalid
+			rawPrices, err := suite.keeper.GetRawPrices(suite.ctx, "btc:usd")
+			suite.Require().NoError(err)
+			suite.Require().Len(rawPrices, 1)
+		})
+	}
+}
+
+func TestHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(HandlerTestSuite))
+}
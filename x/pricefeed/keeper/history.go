@@ -0,0 +1,166 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// recordPriceSample appends the median price of a market at the current block time to the market's price history,
+// then removes the oldest samples so that no more than the price history length param are kept.
+func (k Keeper) recordPriceSample(ctx sdk.Context, marketID string, price sdk.Dec) {
+	store := ctx.KVStore(k.key)
+	sequence := k.getPriceHistorySequence(ctx, marketID)
+
+	// only keep one sample per block time
+	if sequence > 0 {
+		latest, found := k.getPriceSample(ctx, marketID, sequence-1)
+		if found && latest.Time.Equal(ctx.BlockTime()) {
+			sequence--
+		}
+	}
+
+	sample := types.NewPriceSample(ctx.BlockTime(), price)
+	store.Set(types.PriceHistoryKey(marketID, sequence), k.cdc.MustMarshalBinaryBare(sample))
+	k.setPriceHistorySequence(ctx, marketID, sequence+1)
+
+	k.prunePriceHistory(ctx, marketID, k.GetParams(ctx).PriceHistoryLength)
+}
+
+// prunePriceHistory deletes the oldest price samples of a market until at most length samples remain.
+// Samples are pruned as they are recorded, so normally only the sample just outside the last length samples is deleted.
+// Older samples are only present after the length param is lowered, and are deleted by walking back from that sample.
+func (k Keeper) prunePriceHistory(ctx sdk.Context, marketID string, length uint64) {
+	store := ctx.KVStore(k.key)
+	nextSequence := k.getPriceHistorySequence(ctx, marketID)
+	if nextSequence <= length {
+		return
+	}
+
+	for sequence := nextSequence - length - 1; store.Has(types.PriceHistoryKey(marketID, sequence)); sequence-- {
+		store.Delete(types.PriceHistoryKey(marketID, sequence))
+		if sequence == 0 {
+			break
+		}
+	}
+}
+
+func (k Keeper) getPriceSample(ctx sdk.Context, marketID string, sequence uint64) (types.PriceSample, bool) {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(types.PriceHistoryKey(marketID, sequence))
+	if bz == nil {
+		return types.PriceSample{}, false
+	}
+	var sample types.PriceSample
+	k.cdc.MustUnmarshalBinaryBare(bz, &sample)
+	return sample, true
+}
+
+func (k Keeper) getPriceHistorySequence(ctx sdk.Context, marketID string) uint64 {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(types.PriceHistorySequenceKey(marketID))
+	if bz == nil {
+		return 0
+	}
+	return types.GetSequenceFromBytes(bz)
+}
+
+func (k Keeper) setPriceHistorySequence(ctx sdk.Context, marketID string, sequence uint64) {
+	store := ctx.KVStore(k.key)
+	store.Set(types.PriceHistorySequenceKey(marketID), types.GetSequenceBytes(sequence))
+}
+
+// IteratePriceHistory iterates over the price samples of a market from oldest to newest and performs a callback function
+func (k Keeper) IteratePriceHistory(ctx sdk.Context, marketID string, cb func(types.PriceSample) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.key), types.PriceHistoryIteratorKey(marketID))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var sample types.PriceSample
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &sample)
+		if cb(sample) {
+			break
+		}
+	}
+}
+
+// iteratePriceHistoryReverse iterates over the price samples of a market from newest to oldest and performs a callback function
+func (k Keeper) iteratePriceHistoryReverse(ctx sdk.Context, marketID string, cb func(types.PriceSample) (stop bool)) {
+	iterator := sdk.KVStoreReversePrefixIterator(ctx.KVStore(k.key), types.PriceHistoryIteratorKey(marketID))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var sample types.PriceSample
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &sample)
+		if cb(sample) {
+			break
+		}
+	}
+}
+
+// GetPriceHistory returns the price samples of a market from oldest to newest
+func (k Keeper) GetPriceHistory(ctx sdk.Context, marketID string) types.PriceSamples {
+	samples := types.PriceSamples{}
+	k.IteratePriceHistory(ctx, marketID, func(sample types.PriceSample) bool {
+		samples = append(samples, sample)
+		return false
+	})
+	return samples
+}
+
+// GetTWAP returns the time weighted average of a market's median price over the window ending at the current block time.
+// Each sample's price is weighted by the time until the next sample, with the latest sample holding until the current block time.
+// An error is returned if the price history does not reach back to the start of the window.
+func (k Keeper) GetTWAP(ctx sdk.Context, marketID string, window time.Duration) (sdk.Dec, error) {
+	if window <= 0 {
+		return sdk.Dec{}, sdkerrors.Wrapf(types.ErrInvalidWindow, "%s", window)
+	}
+	_, found := k.GetMarket(ctx, marketID)
+	if !found {
+		return sdk.Dec{}, sdkerrors.Wrap(types.ErrInvalidMarket, marketID)
+	}
+	if k.IsMarketStale(ctx, marketID) {
+		return sdk.Dec{}, sdkerrors.Wrapf(types.ErrNoValidPrice, "%s is stale", marketID)
+	}
+
+	now := ctx.BlockTime()
+	start := now.Add(-window)
+	weightedSum := sdk.ZeroDec()
+	totalDuration := time.Duration(0)
+	accumulate := func(sample types.PriceSample, end time.Time) {
+		from := sample.Time
+		if from.Before(start) {
+			from = start
+		}
+		if end.After(from) {
+			duration := end.Sub(from)
+			weightedSum = weightedSum.Add(sample.Price.MulInt64(int64(duration)))
+			totalDuration += duration
+		}
+	}
+
+	// walk back from the latest sample, stopping at the first sample at or before the start of the window
+	var latest *types.PriceSample
+	historyStart := now
+	k.iteratePriceHistoryReverse(ctx, marketID, func(sample types.PriceSample) bool {
+		if latest == nil {
+			latest = &sample
+		}
+		accumulate(sample, historyStart)
+		historyStart = sample.Time
+		return !historyStart.After(start)
+	})
+	if latest == nil {
+		return sdk.Dec{}, sdkerrors.Wrap(types.ErrNoValidPrice, marketID)
+	}
+	if historyStart.After(start) {
+		return sdk.Dec{}, sdkerrors.Wrapf(types.ErrInsufficientPriceHistory, "%s history starts at %s, window starts at %s", marketID, historyStart, start)
+	}
+
+	// the latest sample was recorded in the current block
+	if totalDuration == 0 {
+		return latest.Price, nil
+	}
+	return weightedSum.QuoInt64(int64(totalDuration)), nil
+}
@@ -0,0 +1,89 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// TestKeeper_PriceHistory tests recording median prices and computing time weighted average prices
+func TestKeeper_PriceHistory(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(1)
+	tApp := app.NewTestApp()
+	startTime := time.Date(2020, 10, 1, 0, 0, 0, 0, time.UTC)
+	ctx := tApp.NewContext(true, abci.Header{Time: startTime})
+	keeper := tApp.GetPriceFeedKeeper()
+
+	keeper.SetParams(ctx, types.NewParams(types.Markets{
+		types.Market{MarketID: "tstusd", BaseAsset: "tst", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+	}, 3, types.DefaultMissedWindowsThreshold, types.DefaultDeviationThreshold))
+
+	_, err := keeper.GetTWAP(ctx, "tstusd", time.Hour)
+	require.True(t, errors.Is(err, types.ErrNoValidPrice))
+
+	// post a new median price every 10 seconds
+	for i := int64(1); i <= 5; i++ {
+		ctx = ctx.WithBlockTime(startTime.Add(time.Duration(i) * 10 * time.Second))
+		_, err := keeper.SetPrice(ctx, addrs[0], "tstusd", sdk.NewDec(i), ctx.BlockTime().Add(time.Hour))
+		require.NoError(t, err)
+		require.NoError(t, keeper.SetCurrentPrices(ctx, "tstusd"))
+	}
+	// prices are only sampled once per block
+	require.NoError(t, keeper.SetCurrentPrices(ctx, "tstusd"))
+
+	history := keeper.GetPriceHistory(ctx, "tstusd")
+	require.Equal(t, types.PriceSamples{
+		types.NewPriceSample(startTime.Add(30*time.Second), sdk.NewDec(3)),
+		types.NewPriceSample(startTime.Add(40*time.Second), sdk.NewDec(4)),
+		types.NewPriceSample(startTime.Add(50*time.Second), sdk.NewDec(5)),
+	}, history)
+
+	twap, err := keeper.GetTWAP(ctx, "tstusd", 20*time.Second)
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("3.5"), twap, "the latest sample has no weight in the block it was recorded")
+
+	ctx = ctx.WithBlockTime(startTime.Add(60 * time.Second))
+	testCases := []struct {
+		name     string
+		marketID string
+		window   time.Duration
+		expPrice sdk.Dec
+		expErr   error
+	}{
+		{"within latest sample", "tstusd", 5 * time.Second, sdk.NewDec(5), nil},
+		{"across samples", "tstusd", 20 * time.Second, sdk.MustNewDecFromStr("4.5"), nil},
+		{"whole history", "tstusd", 30 * time.Second, sdk.NewDec(4), nil},
+		{"longer than history", "tstusd", time.Hour, sdk.Dec{}, types.ErrInsufficientPriceHistory},
+		{"zero window", "tstusd", 0, sdk.Dec{}, types.ErrInvalidWindow},
+		{"unknown market", "nan", time.Hour, sdk.Dec{}, types.ErrInvalidMarket},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			twap, err := keeper.GetTWAP(ctx, tc.marketID, tc.window)
+			if tc.expErr != nil {
+				require.True(t, errors.Is(err, tc.expErr))
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expPrice, twap)
+		})
+	}
+
+	// shortening the history length removes the oldest samples on the next update
+	params := keeper.GetParams(ctx)
+	params.PriceHistoryLength = 1
+	keeper.SetParams(ctx, params)
+	require.NoError(t, keeper.SetCurrentPrices(ctx, "tstusd"))
+	require.Equal(t, types.PriceSamples{
+		types.NewPriceSample(startTime.Add(60*time.Second), sdk.NewDec(5)),
+	}, keeper.GetPriceHistory(ctx, "tstusd"))
+}
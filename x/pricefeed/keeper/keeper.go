@@ -57,28 +57,10 @@ func (k Keeper) SetPrice(
 		return types.PostedPrice{}, types.ErrExpired
 	}
 
-	store := ctx.KVStore(k.key)
-	prices, err := k.GetRawPrices(ctx, marketID)
-	if err != nil {
-		return types.PostedPrice{}, err
-	}
-	var index int
-	found := false
-	for i := range prices {
-		if prices[i].OracleAddress.Equals(oracle) {
-			index = i
-			found = true
-			break
-		}
-	}
-
 	// set the price for that particular oracle
-	if found {
-		prices[index] = types.NewPostedPrice(marketID, oracle, price, expiry)
-	} else {
-		prices = append(prices, types.NewPostedPrice(marketID, oracle, price, expiry))
-		index = len(prices) - 1
-	}
+	postedPrice := types.NewPostedPrice(marketID, oracle, price, expiry)
+	k.setRawPrice(ctx, postedPrice)
+	k.recordOraclePost(ctx, marketID, oracle)
 
 	// Emit an event containing the oracle's new price
 	ctx.EventManager().EmitEvent(
@@ -91,34 +73,43 @@ func (k Keeper) SetPrice(
 		),
 	)
 
-	store.Set(types.RawPriceKey(marketID), k.cdc.MustMarshalBinaryBare(prices))
-	return prices[index], nil
+	return postedPrice, nil
 }
 
-// SetCurrentPrices updates the price of an asset to the median of all valid oracle inputs
+func (k Keeper) setRawPrice(ctx sdk.Context, postedPrice types.PostedPrice) {
+	store := ctx.KVStore(k.key)
+	store.Set(types.RawPriceKey(postedPrice.MarketID, postedPrice.OracleAddress), k.cdc.MustMarshalBinaryBare(postedPrice))
+}
+
+// SetCurrentPrices updates the price of an asset to the median of all valid oracle inputs,
+// or for derived markets, to the product of the current prices of the source markets.
+// If the market's circuit breaker rejects the price, the market is marked stale and the last accepted price is kept.
 func (k Keeper) SetCurrentPrices(ctx sdk.Context, marketID string) error {
-	_, ok := k.GetMarket(ctx, marketID)
+	market, ok := k.GetMarket(ctx, marketID)
 	if !ok {
 		return sdkerrors.Wrap(types.ErrInvalidMarket, marketID)
 	}
-	// store current price
-	validPrevPrice := true
-	prevPrice, err := k.GetCurrentPrice(ctx, marketID)
-	if err != nil {
-		validPrevPrice = false
+	if market.IsDerived() {
+		return k.setDerivedCurrentPrice(ctx, market)
 	}
 
-	prices, err := k.GetRawPrices(ctx, marketID)
-	if err != nil {
-		return err
-	}
-	var notExpiredPrices types.CurrentPrices
-	// filter out expired prices
-	for _, v := range prices {
+	var notExpiredPrices types.WeightedPrices
+	var validPosts, expiredPosts types.PostedPrices
+	// filter out expired prices, and prices from oracles without weight
+	k.IterateRawPricesByMarket(ctx, marketID, func(v types.PostedPrice) bool {
+		weight := market.OracleWeight(v.OracleAddress)
+		if !weight.IsPositive() {
+			return false
+		}
 		if v.Expiry.After(ctx.BlockTime()) {
-			notExpiredPrices = append(notExpiredPrices, types.NewCurrentPrice(v.MarketID, v.Price))
+			notExpiredPrices = append(notExpiredPrices, types.NewWeightedPrice(v.Price, weight))
+			validPosts = append(validPosts, v)
+		} else {
+			expiredPosts = append(expiredPosts, v)
 		}
-	}
+		return false
+	})
+	k.recordMissedWindows(ctx, expiredPosts)
 
 	if len(notExpiredPrices) == 0 {
 		// NOTE: The current price stored will continue storing the most recent (expired)
@@ -126,25 +117,104 @@ func (k Keeper) SetCurrentPrices(ctx sdk.Context, marketID string) error {
 		// This zero's out the current price stored value for that market and ensures
 		// that CDP methods that GetCurrentPrice will return error.
 		k.setCurrentPrice(ctx, marketID, types.CurrentPrice{})
+		k.setMarketStale(ctx, marketID, false)
 		return types.ErrNoValidPrice
 	}
 
-	medianPrice := k.CalculateMedianPrice(ctx, notExpiredPrices)
+	if market.OutlierRejectionEnabled() {
+		var err error
+		notExpiredPrices, err = k.rejectOutliers(ctx, notExpiredPrices, market.MaxOracleDeviation)
+		if err != nil {
+			return err
+		}
+		// every price can be rejected when the prices are spread further apart than the max deviation
+		if len(notExpiredPrices) == 0 {
+			k.markMarketStale(ctx, marketID, types.AttributeValueOutliers)
+			return sdkerrors.Wrapf(types.ErrNoValidPrice, "%s has no prices within %s of the median", marketID, market.MaxOracleDeviation)
+		}
+	}
+	if uint64(len(notExpiredPrices)) < market.Quorum {
+		k.markMarketStale(ctx, marketID, types.AttributeValueQuorum)
+		return sdkerrors.Wrapf(types.ErrNoValidPrice, "%s has %d valid prices, quorum is %d", marketID, len(notExpiredPrices), market.Quorum)
+	}
+
+	medianPrice, err := k.CalculateWeightedMedianPrice(ctx, notExpiredPrices)
+	if err != nil {
+		return err
+	}
+
+	if err := k.updateCurrentPrice(ctx, market, medianPrice); err != nil {
+		return err
+	}
+	k.recordOracleDeviations(ctx, validPosts, medianPrice)
+
+	return nil
+}
+
+// setDerivedCurrentPrice updates the price of a derived market from the current prices of its source markets.
+// If any source market has no valid price, the derived market is marked stale.
+func (k Keeper) setDerivedCurrentPrice(ctx sdk.Context, market types.Market) error {
+	derivedPrice := sdk.OneDec()
+	for _, source := range market.Sources {
+		sourcePrice, err := k.GetCurrentPrice(ctx, source.MarketID)
+		if err != nil {
+			k.markMarketStale(ctx, market.MarketID, types.AttributeValueSource)
+			return sdkerrors.Wrapf(types.ErrNoValidPrice, "%s source %s has no valid price", market.MarketID, source.MarketID)
+		}
+		if source.Inverse {
+			derivedPrice = derivedPrice.Quo(sourcePrice.Price)
+		} else {
+			derivedPrice = derivedPrice.Mul(sourcePrice.Price)
+		}
+	}
+	if !derivedPrice.IsPositive() {
+		k.markMarketStale(ctx, market.MarketID, types.AttributeValueSource)
+		return sdkerrors.Wrapf(types.ErrNoValidPrice, "%s derived price %s is not positive", market.MarketID, derivedPrice)
+	}
+
+	return k.updateCurrentPrice(ctx, market, derivedPrice)
+}
+
+// updateCurrentPrice sets the current price of a market, unless the market's circuit breaker rejects the price
+func (k Keeper) updateCurrentPrice(ctx sdk.Context, market types.Market, price sdk.Dec) error {
+	marketID := market.MarketID
+	validPrevPrice := true
+	prevPrice, err := k.getCurrentPrice(ctx, marketID)
+	if err != nil {
+		validPrevPrice = false
+	}
+
+	if validPrevPrice && market.PriceChangeLimitEnabled() {
+		acceptedTime, found := k.getPriceAcceptedTime(ctx, marketID)
+		if !found {
+			// prices accepted before accepted times were recorded are limited from the first check
+			acceptedTime = ctx.BlockTime()
+			k.setPriceAcceptedTime(ctx, marketID, acceptedTime)
+		}
+		maxPriceChange := market.MaxPriceChangeSince(ctx.BlockTime().Sub(acceptedTime))
+		if price.Sub(prevPrice.Price).Abs().GT(prevPrice.Price.Mul(maxPriceChange)) {
+			k.markMarketStale(ctx, marketID, types.AttributeValuePriceChange)
+			return sdkerrors.Wrapf(types.ErrNoValidPrice, "%s price %s moved more than %s from %s", marketID, price, maxPriceChange, prevPrice.Price)
+		}
+	}
+	k.setMarketStale(ctx, marketID, false)
+	k.setPriceAcceptedTime(ctx, marketID, ctx.BlockTime())
 
 	// check case that market price was not set in genesis
-	if validPrevPrice && !medianPrice.Equal(prevPrice.Price) {
+	if validPrevPrice && !price.Equal(prevPrice.Price) {
 		// only emit event if price has changed
 		ctx.EventManager().EmitEvent(
 			sdk.NewEvent(
 				types.EventTypeMarketPriceUpdated,
 				sdk.NewAttribute(types.AttributeMarketID, marketID),
-				sdk.NewAttribute(types.AttributeMarketPrice, medianPrice.String()),
+				sdk.NewAttribute(types.AttributeMarketPrice, price.String()),
 			),
 		)
 	}
 
-	currentPrice := types.NewCurrentPrice(marketID, medianPrice)
+	currentPrice := types.NewCurrentPrice(marketID, price)
 	k.setCurrentPrice(ctx, marketID, currentPrice)
+	k.recordPriceSample(ctx, marketID, price)
 
 	return nil
 }
@@ -154,36 +224,105 @@ func (k Keeper) setCurrentPrice(ctx sdk.Context, marketID string, currentPrice t
 	store.Set(types.CurrentPriceKey(marketID), k.cdc.MustMarshalBinaryBare(currentPrice))
 }
 
-// CalculateMedianPrice calculates the median prices for the input prices.
-func (k Keeper) CalculateMedianPrice(ctx sdk.Context, prices types.CurrentPrices) sdk.Dec {
-	l := len(prices)
+func (k Keeper) getPriceAcceptedTime(ctx sdk.Context, marketID string) (time.Time, bool) {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(types.PriceAcceptedTimeKey(marketID))
+	if bz == nil {
+		return time.Time{}, false
+	}
+	acceptedTime, err := sdk.ParseTimeBytes(bz)
+	if err != nil {
+		panic(err)
+	}
+	return acceptedTime, true
+}
 
-	if l == 1 {
-		// Return immediately if there's only one price
-		return prices[0].Price
+func (k Keeper) setPriceAcceptedTime(ctx sdk.Context, marketID string, acceptedTime time.Time) {
+	store := ctx.KVStore(k.key)
+	store.Set(types.PriceAcceptedTimeKey(marketID), sdk.FormatTimeBytes(acceptedTime))
+}
+
+// rejectOutliers removes the prices that deviate from their weighted median by more than the max deviation
+func (k Keeper) rejectOutliers(ctx sdk.Context, prices types.WeightedPrices, maxDeviation sdk.Dec) (types.WeightedPrices, error) {
+	median, err := k.CalculateWeightedMedianPrice(ctx, prices)
+	if err != nil {
+		return nil, err
 	}
-	// sort the prices
-	sort.Slice(prices, func(i, j int) bool {
-		return prices[i].Price.LT(prices[j].Price)
-	})
-	// for even numbers of prices, the median is calculated as the mean of the two middle prices
-	if l%2 == 0 {
-		median := k.calculateMeanPrice(ctx, prices[l/2-1:l/2+1])
-		return median
+	maxDistance := median.Mul(maxDeviation)
+	var accepted types.WeightedPrices
+	for _, p := range prices {
+		if p.Price.Sub(median).Abs().LTE(maxDistance) {
+			accepted = append(accepted, p)
+		}
 	}
-	// for odd numbers of prices, return the middle element
-	return prices[l/2].Price
+	return accepted, nil
+}
+
+// markMarketStale marks a market as stale so that its current price is unavailable until a new median price is accepted
+func (k Keeper) markMarketStale(ctx sdk.Context, marketID, reason string) {
+	k.setMarketStale(ctx, marketID, true)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeMarketPriceStale,
+			sdk.NewAttribute(types.AttributeMarketID, marketID),
+			sdk.NewAttribute(types.AttributeReason, reason),
+		),
+	)
+}
 
+func (k Keeper) setMarketStale(ctx sdk.Context, marketID string, stale bool) {
+	store := ctx.KVStore(k.key)
+	if stale {
+		store.Set(types.StaleMarketKey(marketID), []byte{0x01})
+		return
+	}
+	store.Delete(types.StaleMarketKey(marketID))
 }
 
-func (k Keeper) calculateMeanPrice(ctx sdk.Context, prices types.CurrentPrices) sdk.Dec {
-	sum := prices[0].Price.Add(prices[1].Price)
-	mean := sum.Quo(sdk.NewDec(2))
-	return mean
+// IsMarketStale returns true if the last median price of the market was rejected by the market's circuit breaker
+func (k Keeper) IsMarketStale(ctx sdk.Context, marketID string) bool {
+	store := ctx.KVStore(k.key)
+	return store.Has(types.StaleMarketKey(marketID))
+}
+
+// CalculateWeightedMedianPrice calculates the weighted median of the input prices, the price at which half of the total weight
+// is on either side. If the weight of the lower prices is exactly half the total weight, the median is the mean of the
+// highest of those prices and the next price. With equal weights this is the same as the median.
+// An error is returned if there are no prices.
+func (k Keeper) CalculateWeightedMedianPrice(ctx sdk.Context, prices types.WeightedPrices) (sdk.Dec, error) {
+	if len(prices) == 0 {
+		return sdk.Dec{}, sdkerrors.Wrap(types.ErrNoValidPrice, "cannot take the median of no prices")
+	}
+	// sort the prices
+	sort.SliceStable(prices, func(i, j int) bool {
+		return prices[i].Price.LT(prices[j].Price)
+	})
+	totalWeight := prices.TotalWeight()
+	cumulativeWeight := sdk.ZeroInt()
+	for i, p := range prices {
+		cumulativeWeight = cumulativeWeight.Add(p.Weight)
+		doubleCumulativeWeight := cumulativeWeight.MulRaw(2)
+		if doubleCumulativeWeight.GT(totalWeight) {
+			return p.Price, nil
+		}
+		if doubleCumulativeWeight.Equal(totalWeight) {
+			return p.Price.Add(prices[i+1].Price).Quo(sdk.NewDec(2)), nil
+		}
+	}
+	// unreachable for prices with positive weights
+	return prices[len(prices)-1].Price, nil
 }
 
 // GetCurrentPrice fetches the current median price of all oracles for a specific market
 func (k Keeper) GetCurrentPrice(ctx sdk.Context, marketID string) (types.CurrentPrice, error) {
+	if k.IsMarketStale(ctx, marketID) {
+		return types.CurrentPrice{}, types.ErrNoValidPrice
+	}
+	return k.getCurrentPrice(ctx, marketID)
+}
+
+// getCurrentPrice fetches the last accepted median price of a market, regardless of whether the market is stale
+func (k Keeper) getCurrentPrice(ctx sdk.Context, marketID string) (types.CurrentPrice, error) {
 	store := ctx.KVStore(k.key)
 	bz := store.Get(types.CurrentPriceKey(marketID))
 
@@ -201,17 +340,25 @@ func (k Keeper) GetCurrentPrice(ctx sdk.Context, marketID string) (types.Current
 	return price, nil
 }
 
+// IterateRawPricesByMarket iterates over the prices posted by oracles for a market and performs a callback function
+func (k Keeper) IterateRawPricesByMarket(ctx sdk.Context, marketID string, cb func(types.PostedPrice) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.key), types.RawPriceIteratorKey(marketID))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var postedPrice types.PostedPrice
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &postedPrice)
+		if cb(postedPrice) {
+			break
+		}
+	}
+}
+
 // GetRawPrices fetches the set of all prices posted by oracles for an asset
 func (k Keeper) GetRawPrices(ctx sdk.Context, marketID string) (types.PostedPrices, error) {
-	store := ctx.KVStore(k.key)
-	bz := store.Get(types.RawPriceKey(marketID))
-	if bz == nil {
-		return types.PostedPrices{}, nil
-	}
-	var prices types.PostedPrices
-	err := k.cdc.UnmarshalBinaryBare(bz, &prices)
-	if err != nil {
-		return types.PostedPrices{}, err
-	}
+	prices := types.PostedPrices{}
+	k.IterateRawPricesByMarket(ctx, marketID, func(postedPrice types.PostedPrice) bool {
+		prices = append(prices, postedPrice)
+		return false
+	})
 	return prices, nil
 }
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"errors"
 	"testing"
 	"time"
 
@@ -140,3 +141,241 @@ func TestKeeper_GetSetCurrentPrice(t *testing.T) {
 	require.Nil(t, err)
 	require.Equal(t, price.Price.Equal(sdk.MustNewDecFromStr("0.345")), true)
 }
+
+// TestKeeper_CalculateWeightedMedianPrice tests the weighted median for odd and even total weights
+func TestKeeper_CalculateWeightedMedianPrice(t *testing.T) {
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, abci.Header{})
+	keeper := tApp.GetPriceFeedKeeper()
+
+	wp := func(price string, weight int64) types.WeightedPrice {
+		return types.NewWeightedPrice(sdk.MustNewDecFromStr(price), sdk.NewInt(weight))
+	}
+	testCases := []struct {
+		name     string
+		prices   types.WeightedPrices
+		expected sdk.Dec
+	}{
+		{"single price", types.WeightedPrices{wp("1.0", 5)}, sdk.MustNewDecFromStr("1.0")},
+		{"odd total equal weights", types.WeightedPrices{wp("3.0", 1), wp("1.0", 1), wp("2.0", 1)}, sdk.MustNewDecFromStr("2.0")},
+		{"even total equal weights", types.WeightedPrices{wp("4.0", 1), wp("1.0", 1), wp("3.0", 1), wp("2.0", 1)}, sdk.MustNewDecFromStr("2.5")},
+		{"odd total heavy low price", types.WeightedPrices{wp("1.0", 3), wp("2.0", 1), wp("3.0", 1)}, sdk.MustNewDecFromStr("1.0")},
+		{"odd total heavy high price", types.WeightedPrices{wp("1.0", 1), wp("2.0", 1), wp("3.0", 5)}, sdk.MustNewDecFromStr("3.0")},
+		{"even total split at boundary", types.WeightedPrices{wp("1.0", 2), wp("2.0", 1), wp("3.0", 1)}, sdk.MustNewDecFromStr("1.5")},
+		{"even total not split at boundary", types.WeightedPrices{wp("1.0", 1), wp("2.0", 2), wp("3.0", 1)}, sdk.MustNewDecFromStr("2.0")},
+		{"even total two prices", types.WeightedPrices{wp("2.0", 2), wp("1.0", 2)}, sdk.MustNewDecFromStr("1.5")},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			median, err := keeper.CalculateWeightedMedianPrice(ctx, tc.prices)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, median)
+		})
+	}
+
+	_, err := keeper.CalculateWeightedMedianPrice(ctx, types.WeightedPrices{})
+	require.True(t, errors.Is(err, types.ErrNoValidPrice))
+}
+
+// TestKeeper_OracleWeights tests that current prices are set from the weighted median of the oracles' prices
+func TestKeeper_OracleWeights(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(4)
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, abci.Header{})
+	keeper := tApp.GetPriceFeedKeeper()
+
+	market := types.NewMarket("tstusd", "tst", "usd", addrs[:3], true)
+	market.OracleWeights = []sdk.Int{sdk.NewInt(1), sdk.NewInt(1), sdk.NewInt(3)}
+	keeper.SetParams(ctx, types.NewParams(types.Markets{market}, types.DefaultPriceHistoryLength, types.DefaultMissedWindowsThreshold, types.DefaultDeviationThreshold))
+
+	expiry := ctx.BlockTime().Add(time.Hour)
+	for i, price := range []string{"1.0", "2.0", "3.0", "0.1"} {
+		_, err := keeper.SetPrice(ctx, addrs[i], "tstusd", sdk.MustNewDecFromStr(price), expiry)
+		require.NoError(t, err)
+	}
+	require.NoError(t, keeper.SetCurrentPrices(ctx, "tstusd"))
+	price, err := keeper.GetCurrentPrice(ctx, "tstusd")
+	require.NoError(t, err)
+	// the price from the oracle outside the market has no weight
+	require.Equal(t, sdk.MustNewDecFromStr("3.0"), price.Price)
+
+	// weights summing to an even total average the prices either side of the midpoint
+	market.OracleWeights = []sdk.Int{sdk.NewInt(1), sdk.NewInt(2), sdk.NewInt(3)}
+	keeper.SetParams(ctx, types.NewParams(types.Markets{market}, types.DefaultPriceHistoryLength, types.DefaultMissedWindowsThreshold, types.DefaultDeviationThreshold))
+	require.NoError(t, keeper.SetCurrentPrices(ctx, "tstusd"))
+	price, err = keeper.GetCurrentPrice(ctx, "tstusd")
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("2.5"), price.Price)
+}
+
+// TestKeeper_DerivedMarket tests deriving a market price from the current prices of other markets
+func TestKeeper_DerivedMarket(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(1)
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, abci.Header{})
+	keeper := tApp.GetPriceFeedKeeper()
+
+	xrpUSD := types.NewMarket("xrp:usd", "xrp", "usd", nil, true)
+	xrpUSD.Sources = types.MarketSources{types.NewMarketSource("xrp:btc", false), types.NewMarketSource("btc:usd", false)}
+	btcXRP := types.NewMarket("btc:xrp", "btc", "xrp", nil, true)
+	btcXRP.Sources = types.MarketSources{types.NewMarketSource("btc:usd", false), types.NewMarketSource("xrp:usd:30", true)}
+	keeper.SetParams(ctx, types.NewParams(types.Markets{
+		types.NewMarket("xrp:btc", "xrp", "btc", addrs, true),
+		types.NewMarket("btc:usd", "btc", "usd", addrs, true),
+		types.NewMarket("xrp:usd:30", "xrp", "usd", addrs, true),
+		xrpUSD,
+		btcXRP,
+	}, types.DefaultPriceHistoryLength, types.DefaultMissedWindowsThreshold, types.DefaultDeviationThreshold))
+
+	// derived markets have no price until their sources do
+	err := keeper.SetCurrentPrices(ctx, "xrp:usd")
+	require.True(t, errors.Is(err, types.ErrNoValidPrice))
+	require.True(t, keeper.IsMarketStale(ctx, "xrp:usd"))
+
+	expiry := ctx.BlockTime().Add(time.Hour)
+	for marketID, price := range map[string]string{"xrp:btc": "0.00002", "btc:usd": "10000", "xrp:usd:30": "0.25"} {
+		_, err := keeper.SetPrice(ctx, addrs[0], marketID, sdk.MustNewDecFromStr(price), expiry)
+		require.NoError(t, err)
+		require.NoError(t, keeper.SetCurrentPrices(ctx, marketID))
+	}
+
+	require.NoError(t, keeper.SetCurrentPrices(ctx, "xrp:usd"))
+	price, err := keeper.GetCurrentPrice(ctx, "xrp:usd")
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("0.2"), price.Price)
+
+	require.NoError(t, keeper.SetCurrentPrices(ctx, "btc:xrp"))
+	price, err = keeper.GetCurrentPrice(ctx, "btc:xrp")
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("40000"), price.Price)
+
+	// the derived market goes stale when a source is stale
+	btcUSD, _ := keeper.GetMarket(ctx, "btc:usd")
+	btcUSD.MaxPriceChange = sdk.MustNewDecFromStr("0.1")
+	params := keeper.GetParams(ctx)
+	params.Markets[1] = btcUSD
+	keeper.SetParams(ctx, params)
+	_, err = keeper.SetPrice(ctx, addrs[0], "btc:usd", sdk.MustNewDecFromStr("20000"), expiry)
+	require.NoError(t, err)
+	require.Error(t, keeper.SetCurrentPrices(ctx, "btc:usd"))
+	err = keeper.SetCurrentPrices(ctx, "xrp:usd")
+	require.True(t, errors.Is(err, types.ErrNoValidPrice))
+	_, err = keeper.GetCurrentPrice(ctx, "xrp:usd")
+	require.True(t, errors.Is(err, types.ErrNoValidPrice))
+
+	// and recovers with its source
+	_, err = keeper.SetPrice(ctx, addrs[0], "btc:usd", sdk.MustNewDecFromStr("10500"), expiry)
+	require.NoError(t, err)
+	require.NoError(t, keeper.SetCurrentPrices(ctx, "btc:usd"))
+	require.NoError(t, keeper.SetCurrentPrices(ctx, "xrp:usd"))
+	price, err = keeper.GetCurrentPrice(ctx, "xrp:usd")
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("0.21"), price.Price)
+}
+
+// TestKeeper_CircuitBreaker tests that median prices breaching a market's limits mark the market stale
+func TestKeeper_CircuitBreaker(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(3)
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, abci.Header{Time: time.Date(2020, 10, 1, 0, 0, 0, 0, time.UTC)})
+	keeper := tApp.GetPriceFeedKeeper()
+
+	market := types.NewMarket("tstusd", "tst", "usd", addrs, true)
+	market.MaxPriceChange = sdk.MustNewDecFromStr("0.1")
+	market.Quorum = 2
+	market.MaxOracleDeviation = sdk.MustNewDecFromStr("0.05")
+	keeper.SetParams(ctx, types.NewParams(types.Markets{market}, types.DefaultPriceHistoryLength, types.DefaultMissedWindowsThreshold, types.DefaultDeviationThreshold))
+
+	setPrices := func(prices ...string) error {
+		for i, price := range prices {
+			_, err := keeper.SetPrice(ctx, addrs[i], "tstusd", sdk.MustNewDecFromStr(price), ctx.BlockTime().Add(time.Hour))
+			require.NoError(t, err)
+		}
+		return keeper.SetCurrentPrices(ctx, "tstusd")
+	}
+	requirePrice := func(expected string) {
+		price, err := keeper.GetCurrentPrice(ctx, "tstusd")
+		require.NoError(t, err)
+		require.Equal(t, sdk.MustNewDecFromStr(expected), price.Price)
+		require.False(t, keeper.IsMarketStale(ctx, "tstusd"))
+	}
+	requireStale := func(err error) {
+		require.True(t, errors.Is(err, types.ErrNoValidPrice))
+		require.True(t, keeper.IsMarketStale(ctx, "tstusd"))
+		_, err = keeper.GetCurrentPrice(ctx, "tstusd")
+		require.True(t, errors.Is(err, types.ErrNoValidPrice))
+	}
+
+	// the first price is accepted without a previous price to compare against
+	require.NoError(t, setPrices("1.0", "1.0", "1.02"))
+	requirePrice("1.0")
+
+	// outliers are rejected before the median is taken
+	require.NoError(t, setPrices("1.05", "1.04", "5.0"))
+	requirePrice("1.045")
+
+	// prices moving more than the max price change are rejected
+	requireStale(setPrices("1.5", "1.5", "1.5"))
+
+	// the market recovers once the median is back within range of the last accepted price
+	require.NoError(t, setPrices("1.1", "1.1", "1.1"))
+	requirePrice("1.1")
+
+	// outlier rejection can leave too few prices for a quorum
+	requireStale(setPrices("1.0", "1.1", "1.2"))
+
+	// outlier rejection can reject every price when the prices are spread further apart than the max deviation
+	spread := types.NewMarket("sprdusd", "sprd", "usd", addrs[:2], true)
+	spread.Quorum = 1
+	spread.MaxOracleDeviation = sdk.MustNewDecFromStr("0.05")
+	keeper.SetParams(ctx, types.NewParams(types.Markets{market, spread}, types.DefaultPriceHistoryLength, types.DefaultMissedWindowsThreshold, types.DefaultDeviationThreshold))
+	for i, price := range []string{"1.0", "1.2"} {
+		_, err := keeper.SetPrice(ctx, addrs[i], "sprdusd", sdk.MustNewDecFromStr(price), ctx.BlockTime().Add(time.Hour))
+		require.NoError(t, err)
+	}
+	err := keeper.SetCurrentPrices(ctx, "sprdusd")
+	require.True(t, errors.Is(err, types.ErrNoValidPrice))
+	require.True(t, keeper.IsMarketStale(ctx, "sprdusd"))
+}
+
+// TestKeeper_CircuitBreakerRecovery tests that the max price change grows with the time since the last accepted price
+func TestKeeper_CircuitBreakerRecovery(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(1)
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, abci.Header{Time: time.Date(2020, 10, 1, 0, 0, 0, 0, time.UTC)})
+	keeper := tApp.GetPriceFeedKeeper()
+
+	market := types.NewMarket("tstusd", "tst", "usd", addrs, true)
+	market.MaxPriceChange = sdk.MustNewDecFromStr("0.1")
+	keeper.SetParams(ctx, types.NewParams(types.Markets{market}, types.DefaultPriceHistoryLength, types.DefaultMissedWindowsThreshold, types.DefaultDeviationThreshold))
+
+	setPrice := func(price string, elapsed time.Duration) error {
+		ctx = ctx.WithBlockTime(ctx.BlockTime().Add(elapsed))
+		_, err := keeper.SetPrice(ctx, addrs[0], "tstusd", sdk.MustNewDecFromStr(price), ctx.BlockTime().Add(time.Hour))
+		require.NoError(t, err)
+		return keeper.SetCurrentPrices(ctx, "tstusd")
+	}
+	requirePrice := func(expected string) {
+		price, err := keeper.GetCurrentPrice(ctx, "tstusd")
+		require.NoError(t, err)
+		require.Equal(t, sdk.MustNewDecFromStr(expected), price.Price)
+	}
+
+	require.NoError(t, setPrice("1.0", 0))
+	requirePrice("1.0")
+
+	// the price crashes by 50%, which is rejected until five periods have passed since the last accepted price
+	for i := 0; i < 4; i++ {
+		err := setPrice("0.5", types.MaxPriceChangePeriod)
+		require.True(t, errors.Is(err, types.ErrNoValidPrice))
+		require.True(t, keeper.IsMarketStale(ctx, "tstusd"))
+	}
+	require.NoError(t, setPrice("0.5", types.MaxPriceChangePeriod))
+	requirePrice("0.5")
+
+	// the limit is measured from the newly accepted price
+	err := setPrice("0.6", time.Minute)
+	require.True(t, errors.Is(err, types.ErrNoValidPrice))
+	require.NoError(t, setPrice("0.6", 2*types.MaxPriceChangePeriod))
+	requirePrice("0.6")
+}
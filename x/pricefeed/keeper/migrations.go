@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// MigrateRawPrices moves raw prices from the legacy store layout, where all the prices of a market are stored in one slice,
+// to the current layout, where each oracle's price is stored under its own key.
+// It is not registered as an upgrade handler, as chains upgrade to this version through the migrate/v0_12 genesis migration.
+func (k Keeper) MigrateRawPrices(ctx sdk.Context) {
+	store := ctx.KVStore(k.key)
+	iterator := sdk.KVStorePrefixIterator(store, types.LegacyRawPriceFeedPrefix)
+	var legacyKeys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		var prices types.PostedPrices
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &prices)
+		for _, postedPrice := range prices {
+			k.setRawPrice(ctx, postedPrice)
+		}
+		legacyKeys = append(legacyKeys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range legacyKeys {
+		store.Delete(key)
+	}
+}
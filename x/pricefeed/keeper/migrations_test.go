@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+func TestMigrateRawPrices(t *testing.T) {
+	cdc := codec.New()
+	sdk.RegisterCodec(cdc)
+	types.RegisterCodec(cdc)
+
+	keyPricefeed := sdk.NewKVStoreKey(types.StoreKey)
+	keyParams := sdk.NewKVStoreKey(params.StoreKey)
+	tkeyParams := sdk.NewTransientStoreKey(params.TStoreKey)
+
+	ms := store.NewCommitMultiStore(dbm.NewMemDB())
+	ms.MountStoreWithDB(keyPricefeed, sdk.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, nil)
+	require.NoError(t, ms.LoadLatestVersion())
+	ctx := sdk.NewContext(ms, abci.Header{Time: time.Date(2020, 10, 1, 0, 0, 0, 0, time.UTC)}, false, log.NewNopLogger())
+
+	paramsKeeper := params.NewKeeper(cdc, keyParams, tkeyParams)
+	keeper := NewKeeper(cdc, keyPricefeed, paramsKeeper.Subspace(types.DefaultParamspace))
+
+	oracle1 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	oracle2 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	expiry := ctx.BlockTime().Add(time.Hour)
+	legacyPrices := map[string]types.PostedPrices{
+		"btc:usd": {
+			types.NewPostedPrice("btc:usd", oracle1, sdk.MustNewDecFromStr("11000.0"), expiry),
+			types.NewPostedPrice("btc:usd", oracle2, sdk.MustNewDecFromStr("11100.0"), expiry),
+		},
+		"btc:usd:30": {
+			types.NewPostedPrice("btc:usd:30", oracle1, sdk.MustNewDecFromStr("10900.0"), expiry),
+		},
+	}
+	store := ctx.KVStore(keyPricefeed)
+	for marketID, prices := range legacyPrices {
+		store.Set(types.LegacyRawPriceKey(marketID), cdc.MustMarshalBinaryBare(prices))
+	}
+
+	keeper.MigrateRawPrices(ctx)
+
+	for marketID, prices := range legacyPrices {
+		require.Nil(t, store.Get(types.LegacyRawPriceKey(marketID)))
+
+		rawPrices, err := keeper.GetRawPrices(ctx, marketID)
+		require.NoError(t, err)
+		require.ElementsMatch(t, prices, rawPrices)
+	}
+}
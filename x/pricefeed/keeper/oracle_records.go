@@ -0,0 +1,118 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// recordOraclePost updates the last post time of an oracle's record
+func (k Keeper) recordOraclePost(ctx sdk.Context, marketID string, oracle sdk.AccAddress) {
+	record, found := k.GetOracleRecord(ctx, marketID, oracle)
+	if !found {
+		record = types.NewOracleRecord(marketID, oracle)
+	}
+	record.LastPostTime = ctx.BlockTime()
+	k.setOracleRecord(ctx, record)
+}
+
+// recordMissedWindows counts each expired posted price as a missed window for its oracle.
+// A posted price is only counted once, no matter how many blocks pass before the oracle posts again.
+func (k Keeper) recordMissedWindows(ctx sdk.Context, expiredPrices types.PostedPrices) {
+	threshold := k.GetParams(ctx).OracleMissedWindowsThreshold
+	for _, pp := range expiredPrices {
+		record, found := k.GetOracleRecord(ctx, pp.MarketID, pp.OracleAddress)
+		if !found {
+			record = types.NewOracleRecord(pp.MarketID, pp.OracleAddress)
+		}
+		if !pp.Expiry.After(record.LastMissedExpiry) {
+			continue
+		}
+		record.MissedWindows++
+		record.LastMissedExpiry = pp.Expiry
+		k.setOracleRecord(ctx, record)
+
+		if threshold > 0 && record.MissedWindows == threshold+1 {
+			k.emitOracleDelinquent(ctx, record, types.AttributeValueMissedWindows)
+		}
+	}
+}
+
+// recordOracleDeviations adds the deviation of each valid posted price from the median price to its oracle's rolling deviation.
+// Each posted price is only compared to the median price of the block it was posted in, so records are not rewritten every block.
+func (k Keeper) recordOracleDeviations(ctx sdk.Context, validPrices types.PostedPrices, median sdk.Dec) {
+	threshold := k.GetParams(ctx).OracleDeviationThreshold
+	for _, pp := range validPrices {
+		record, found := k.GetOracleRecord(ctx, pp.MarketID, pp.OracleAddress)
+		if !found {
+			record = types.NewOracleRecord(pp.MarketID, pp.OracleAddress)
+		} else if !record.LastPostTime.After(record.LastSampledPostTime) {
+			continue
+		}
+		prevDeviation := record.Deviation
+		record = record.AddDeviationSample(pp.Price, median)
+		record.LastSampledPostTime = record.LastPostTime
+		k.setOracleRecord(ctx, record)
+
+		// only report the oracle when its deviation crosses the threshold
+		if !threshold.IsNil() && threshold.IsPositive() && prevDeviation.LTE(threshold) && record.Deviation.GT(threshold) {
+			k.emitOracleDelinquent(ctx, record, types.AttributeValueDeviation)
+		}
+	}
+}
+
+func (k Keeper) emitOracleDelinquent(ctx sdk.Context, record types.OracleRecord, reason string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeOracleDelinquent,
+			sdk.NewAttribute(types.AttributeMarketID, record.MarketID),
+			sdk.NewAttribute(types.AttributeOracle, record.OracleAddress.String()),
+			sdk.NewAttribute(types.AttributeReason, reason),
+			sdk.NewAttribute(types.AttributeValueMissedWindows, fmt.Sprintf("%d", record.MissedWindows)),
+			sdk.NewAttribute(types.AttributeValueDeviation, record.Deviation.String()),
+		),
+	)
+}
+
+// GetOracleRecord returns the accountability record of an oracle for a market
+func (k Keeper) GetOracleRecord(ctx sdk.Context, marketID string, oracle sdk.AccAddress) (types.OracleRecord, bool) {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(types.OracleRecordKey(marketID, oracle))
+	if bz == nil {
+		return types.OracleRecord{}, false
+	}
+	var record types.OracleRecord
+	k.cdc.MustUnmarshalBinaryBare(bz, &record)
+	return record, true
+}
+
+func (k Keeper) setOracleRecord(ctx sdk.Context, record types.OracleRecord) {
+	store := ctx.KVStore(k.key)
+	store.Set(types.OracleRecordKey(record.MarketID, record.OracleAddress), k.cdc.MustMarshalBinaryBare(record))
+}
+
+// IterateOracleRecordsByMarket iterates over the oracle records of a market and performs a callback function
+func (k Keeper) IterateOracleRecordsByMarket(ctx sdk.Context, marketID string, cb func(record types.OracleRecord) (stop bool)) {
+	store := ctx.KVStore(k.key)
+	iterator := sdk.KVStorePrefixIterator(store, types.OracleRecordIteratorKey(marketID))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.OracleRecord
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &record)
+		if cb(record) {
+			break
+		}
+	}
+}
+
+// GetOracleRecords returns the accountability records of the oracles that have posted prices for a market
+func (k Keeper) GetOracleRecords(ctx sdk.Context, marketID string) types.OracleRecords {
+	var records types.OracleRecords
+	k.IterateOracleRecordsByMarket(ctx, marketID, func(record types.OracleRecord) bool {
+		records = append(records, record)
+		return false
+	})
+	return records
+}
@@ -0,0 +1,90 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/kv"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// TestKeeper_OracleRecords tests tracking oracle post times, missed windows and deviations from the median price
+func TestKeeper_OracleRecords(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(3)
+	tApp := app.NewTestApp()
+	startTime := time.Date(2020, 10, 1, 0, 0, 0, 0, time.UTC)
+	ctx := tApp.NewContext(true, abci.Header{Time: startTime})
+	keeper := tApp.GetPriceFeedKeeper()
+
+	keeper.SetParams(ctx, types.NewParams(types.Markets{
+		types.NewMarket("tstusd", "tst", "usd", addrs, true),
+	}, types.DefaultPriceHistoryLength, 1, sdk.MustNewDecFromStr("0.5")))
+
+	delinquentEvents := func(ctx sdk.Context) []sdk.Event {
+		var events []sdk.Event
+		for _, e := range ctx.EventManager().Events() {
+			if e.Type == types.EventTypeOracleDelinquent {
+				events = append(events, e)
+			}
+		}
+		return events
+	}
+
+	_, err := keeper.SetPrice(ctx, addrs[0], "tstusd", sdk.MustNewDecFromStr("1.0"), startTime.Add(time.Hour))
+	require.NoError(t, err)
+	_, err = keeper.SetPrice(ctx, addrs[1], "tstusd", sdk.MustNewDecFromStr("1.0"), startTime.Add(time.Hour))
+	require.NoError(t, err)
+	_, err = keeper.SetPrice(ctx, addrs[2], "tstusd", sdk.MustNewDecFromStr("2.0"), startTime.Add(10*time.Minute))
+	require.NoError(t, err)
+	require.NoError(t, keeper.SetCurrentPrices(ctx, "tstusd"))
+
+	record, found := keeper.GetOracleRecord(ctx, "tstusd", addrs[0])
+	require.True(t, found)
+	require.Equal(t, startTime, record.LastPostTime)
+	require.Equal(t, sdk.ZeroDec(), record.Deviation)
+	require.Equal(t, uint64(1), record.DeviationSamples)
+
+	// the outlying oracle's deviation crosses the threshold
+	record, _ = keeper.GetOracleRecord(ctx, "tstusd", addrs[2])
+	require.Equal(t, sdk.OneDec(), record.Deviation)
+	events := delinquentEvents(ctx)
+	require.Len(t, events, 1)
+	require.Contains(t, events[0].Attributes, kv.Pair{Key: []byte(types.AttributeReason), Value: []byte(types.AttributeValueDeviation)})
+
+	// an expired price is counted as a single missed window
+	ctx = tApp.NewContext(true, abci.Header{Time: startTime.Add(20 * time.Minute)})
+	require.NoError(t, keeper.SetCurrentPrices(ctx, "tstusd"))
+	ctx = ctx.WithBlockTime(startTime.Add(21 * time.Minute))
+	require.NoError(t, keeper.SetCurrentPrices(ctx, "tstusd"))
+	record, _ = keeper.GetOracleRecord(ctx, "tstusd", addrs[2])
+	require.Equal(t, uint64(1), record.MissedWindows)
+	require.Equal(t, startTime.Add(10*time.Minute), record.LastMissedExpiry)
+	require.Empty(t, delinquentEvents(ctx))
+
+	// a posted price is only compared to the median price once
+	record, _ = keeper.GetOracleRecord(ctx, "tstusd", addrs[0])
+	require.Equal(t, uint64(1), record.DeviationSamples)
+	require.Equal(t, startTime, record.LastSampledPostTime)
+
+	// missing another window exceeds the threshold
+	_, err = keeper.SetPrice(ctx, addrs[2], "tstusd", sdk.MustNewDecFromStr("1.0"), startTime.Add(30*time.Minute))
+	require.NoError(t, err)
+	ctx = ctx.WithBlockTime(startTime.Add(40 * time.Minute))
+	require.NoError(t, keeper.SetCurrentPrices(ctx, "tstusd"))
+	record, _ = keeper.GetOracleRecord(ctx, "tstusd", addrs[2])
+	require.Equal(t, startTime.Add(21*time.Minute), record.LastPostTime)
+	require.Equal(t, uint64(2), record.MissedWindows)
+	events = delinquentEvents(ctx)
+	require.Len(t, events, 1)
+	require.Contains(t, events[0].Attributes, kv.Pair{Key: []byte(types.AttributeReason), Value: []byte(types.AttributeValueMissedWindows)})
+
+	records := keeper.GetOracleRecords(ctx, "tstusd")
+	require.Len(t, records, 3)
+}
@@ -24,6 +24,12 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			return queryMarkets(ctx, req, keeper)
 		case types.QueryGetParams:
 			return queryGetParams(ctx, req, keeper)
+		case types.QueryPriceHistory:
+			return queryPriceHistory(ctx, req, keeper)
+		case types.QueryTWAP:
+			return queryTWAP(ctx, req, keeper)
+		case types.QueryOracleRecords:
+			return queryOracleRecords(ctx, req, keeper)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown %s query endpoint", types.ModuleName)
 		}
@@ -77,6 +83,72 @@ func queryRawPrices(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) (res
 	return bz, nil
 }
 
+func queryPriceHistory(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) (res []byte, sdkErr error) {
+	var requestParams types.QueryWithMarketIDParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &requestParams)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+	_, found := keeper.GetMarket(ctx, requestParams.MarketID)
+	if !found {
+		return []byte{}, sdkerrors.Wrap(types.ErrAssetNotFound, requestParams.MarketID)
+	}
+
+	history := keeper.GetPriceHistory(ctx, requestParams.MarketID)
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, history)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+func queryTWAP(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) (res []byte, sdkErr error) {
+	var requestParams types.QueryTWAPParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &requestParams)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+	_, found := keeper.GetMarket(ctx, requestParams.MarketID)
+	if !found {
+		return []byte{}, sdkerrors.Wrap(types.ErrAssetNotFound, requestParams.MarketID)
+	}
+
+	twap, err := keeper.GetTWAP(ctx, requestParams.MarketID, requestParams.Window)
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, types.NewCurrentPrice(requestParams.MarketID, twap))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+func queryOracleRecords(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) (res []byte, sdkErr error) {
+	var requestParams types.QueryWithMarketIDParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &requestParams)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+	_, found := keeper.GetMarket(ctx, requestParams.MarketID)
+	if !found {
+		return []byte{}, sdkerrors.Wrap(types.ErrAssetNotFound, requestParams.MarketID)
+	}
+
+	records := keeper.GetOracleRecords(ctx, requestParams.MarketID)
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, records)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
 func queryOracles(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) (res []byte, sdkErr error) {
 	var requestParams types.QueryWithMarketIDParams
 	err := types.ModuleCdc.UnmarshalJSON(req.Data, &requestParams)
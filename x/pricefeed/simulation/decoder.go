@@ -14,18 +14,36 @@ import (
 // DecodeStore unmarshals the KVPair's Value to the corresponding pricefeed type
 func DecodeStore(cdc *codec.Codec, kvA, kvB kv.Pair) string {
 	switch {
-	case bytes.Contains(kvA.Key, []byte(types.CurrentPricePrefix)):
+	case bytes.Equal(kvA.Key[:1], types.CurrentPricePrefix):
 		var priceA, priceB types.CurrentPrice
 		cdc.MustUnmarshalBinaryBare(kvA.Value, &priceA)
 		cdc.MustUnmarshalBinaryBare(kvB.Value, &priceB)
 		return fmt.Sprintf("%s\n%s", priceA, priceB)
 
-	case bytes.Contains(kvA.Key, []byte(types.RawPriceFeedPrefix)):
-		var postedPriceA, postedPriceB []types.PostedPrice
+	case bytes.Equal(kvA.Key[:1], types.RawPriceFeedPrefix):
+		var postedPriceA, postedPriceB types.PostedPrice
 		cdc.MustUnmarshalBinaryBare(kvA.Value, &postedPriceA)
 		cdc.MustUnmarshalBinaryBare(kvB.Value, &postedPriceB)
 		return fmt.Sprintf("%s\n%s", postedPriceA, postedPriceB)
 
+	case bytes.Equal(kvA.Key[:1], types.PriceHistoryPrefix):
+		var sampleA, sampleB types.PriceSample
+		cdc.MustUnmarshalBinaryBare(kvA.Value, &sampleA)
+		cdc.MustUnmarshalBinaryBare(kvB.Value, &sampleB)
+		return fmt.Sprintf("%s\n%s", sampleA, sampleB)
+
+	case bytes.Equal(kvA.Key[:1], types.PriceHistorySequencePrefix):
+		return fmt.Sprintf("%d\n%d", types.GetSequenceFromBytes(kvA.Value), types.GetSequenceFromBytes(kvB.Value))
+
+	case bytes.Equal(kvA.Key[:1], types.OracleRecordPrefix):
+		var recordA, recordB types.OracleRecord
+		cdc.MustUnmarshalBinaryBare(kvA.Value, &recordA)
+		cdc.MustUnmarshalBinaryBare(kvB.Value, &recordB)
+		return fmt.Sprintf("%s\n%s", recordA, recordB)
+
+	case bytes.Equal(kvA.Key[:1], types.StaleMarketPrefix):
+		return fmt.Sprintf("%v\n%v", kvA.Value, kvB.Value)
+
 	default:
 		panic(fmt.Sprintf("invalid %s key prefix %X", types.ModuleName, kvA.Key[:1]))
 	}
@@ -27,11 +27,17 @@ func TestDecodeDistributionStore(t *testing.T) {
 	cdc := makeTestCodec()
 
 	currentPrice := types.CurrentPrice{MarketID: "current", Price: sdk.OneDec()}
-	postedPrice := []types.PostedPrice{{MarketID: "posted", Price: sdk.OneDec(), Expiry: time.Now().UTC()}}
+	postedPrice := types.PostedPrice{MarketID: "posted", Price: sdk.OneDec(), Expiry: time.Now().UTC()}
+
+	priceSample := types.PriceSample{Time: time.Now().UTC(), Price: sdk.OneDec()}
+	oracleRecord := types.NewOracleRecord("posted", sdk.AccAddress("oracle"))
 
 	kvPairs := kv.Pairs{
 		kv.Pair{Key: []byte(types.CurrentPricePrefix), Value: cdc.MustMarshalBinaryBare(currentPrice)},
 		kv.Pair{Key: []byte(types.RawPriceFeedPrefix), Value: cdc.MustMarshalBinaryBare(postedPrice)},
+		kv.Pair{Key: types.PriceHistoryKey("posted", 1), Value: cdc.MustMarshalBinaryBare(priceSample)},
+		kv.Pair{Key: types.PriceHistorySequenceKey("posted"), Value: types.GetSequenceBytes(2)},
+		kv.Pair{Key: types.OracleRecordKey("posted", oracleRecord.OracleAddress), Value: cdc.MustMarshalBinaryBare(oracleRecord)},
 		kv.Pair{Key: []byte{0x99}, Value: []byte{0x99}},
 	}
 
@@ -40,7 +46,10 @@ func TestDecodeDistributionStore(t *testing.T) {
 		expectedLog string
 	}{
 		{"CurrentPrice", fmt.Sprintf("%v\n%v", currentPrice, currentPrice)},
-		{"PostedPrice", fmt.Sprintf("%s\n%s", postedPrice, postedPrice)},
+		{"PostedPrice", fmt.Sprintf("%v\n%v", postedPrice, postedPrice)},
+		{"PriceSample", fmt.Sprintf("%v\n%v", priceSample, priceSample)},
+		{"PriceHistorySequence", "2\n2"},
+		{"OracleRecord", fmt.Sprintf("%v\n%v", oracleRecord, oracleRecord)},
 		{"other", ""},
 	}
 	for i, tt := range tests {
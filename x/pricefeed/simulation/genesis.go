@@ -54,7 +54,7 @@ func loadPricefeedGenState(simState *module.SimulationState) pricefeed.GenesisSt
 		markets = append(markets, market)
 		postedPrices = append(postedPrices, postedPrice)
 	}
-	params := pricefeed.NewParams(markets)
+	params := pricefeed.NewParams(markets, pricefeed.DefaultPriceHistoryLength, pricefeed.DefaultMissedWindowsThreshold, pricefeed.DefaultDeviationThreshold)
 	return pricefeed.NewGenesisState(params, postedPrices)
 }
 
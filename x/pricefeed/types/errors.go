@@ -19,4 +19,8 @@ var (
 	ErrInvalidOracle = sdkerrors.Register(ModuleName, 6, "oracle does not exist or not authorized")
 	// ErrAssetNotFound error for not found asset
 	ErrAssetNotFound = sdkerrors.Register(ModuleName, 7, "asset not found")
+	// ErrInvalidWindow error for time weighted average price queries with an invalid window
+	ErrInvalidWindow = sdkerrors.Register(ModuleName, 8, "invalid time weighted average price window")
+	// ErrInsufficientPriceHistory error for time weighted average price queries with a window longer than the price history
+	ErrInsufficientPriceHistory = sdkerrors.Register(ModuleName, 9, "price history does not cover the time weighted average price window")
 )
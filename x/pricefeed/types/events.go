@@ -5,10 +5,20 @@ const (
 	EventTypeMarketPriceUpdated = "market_price_updated"
 	EventTypeOracleUpdatedPrice = "oracle_updated_price"
 	EventTypeNoValidPrices      = "no_valid_prices"
+	EventTypeMarketPriceStale   = "market_price_stale"
+	EventTypeOracleDelinquent   = "oracle_delinquent"
 
 	AttributeValueCategory = ModuleName
 	AttributeMarketID      = "market_id"
 	AttributeMarketPrice   = "market_price"
 	AttributeOracle        = "oracle"
 	AttributeExpiry        = "expiry"
+	AttributeReason        = "reason"
+
+	AttributeValueQuorum        = "quorum"
+	AttributeValueOutliers      = "outliers"
+	AttributeValuePriceChange   = "price_change"
+	AttributeValueSource        = "source"
+	AttributeValueMissedWindows = "missed_windows"
+	AttributeValueDeviation     = "deviation"
 )
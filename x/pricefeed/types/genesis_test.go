@@ -32,18 +32,38 @@ func TestGenesisStateValidate(t *testing.T) {
 			msg: "valid genesis",
 			genesisState: NewGenesisState(
 				NewParams(Markets{
-					{"market", "xrp", "bnb", []sdk.AccAddress{addr}, true},
-				}),
+					NewMarket("market", "xrp", "bnb", []sdk.AccAddress{addr}, true),
+				}, DefaultPriceHistoryLength, DefaultMissedWindowsThreshold, DefaultDeviationThreshold),
 				[]PostedPrice{NewPostedPrice("xrp", addr, sdk.OneDec(), now)},
 			),
 			expPass: true,
 		},
+		{
+			msg: "invalid price history length",
+			genesisState: NewGenesisState(
+				NewParams(Markets{
+					NewMarket("market", "xrp", "bnb", []sdk.AccAddress{addr}, true),
+				}, MaxPriceHistoryLength+1, DefaultMissedWindowsThreshold, DefaultDeviationThreshold),
+				[]PostedPrice{NewPostedPrice("xrp", addr, sdk.OneDec(), now)},
+			),
+			expPass: false,
+		},
+		{
+			msg: "negative oracle deviation threshold",
+			genesisState: NewGenesisState(
+				NewParams(Markets{
+					NewMarket("market", "xrp", "bnb", []sdk.AccAddress{addr}, true),
+				}, DefaultPriceHistoryLength, DefaultMissedWindowsThreshold, sdk.MustNewDecFromStr("-0.1")),
+				[]PostedPrice{NewPostedPrice("xrp", addr, sdk.OneDec(), now)},
+			),
+			expPass: false,
+		},
 		{
 			msg: "invalid param",
 			genesisState: NewGenesisState(
 				NewParams(Markets{
-					{"", "xrp", "bnb", []sdk.AccAddress{addr}, true},
-				}),
+					NewMarket("", "xrp", "bnb", []sdk.AccAddress{addr}, true),
+				}, DefaultPriceHistoryLength, DefaultMissedWindowsThreshold, DefaultDeviationThreshold),
 				[]PostedPrice{NewPostedPrice("xrp", addr, sdk.OneDec(), now)},
 			),
 			expPass: false,
@@ -52,9 +72,9 @@ func TestGenesisStateValidate(t *testing.T) {
 			msg: "dup market param",
 			genesisState: NewGenesisState(
 				NewParams(Markets{
-					{"market", "xrp", "bnb", []sdk.AccAddress{addr}, true},
-					{"market", "xrp", "bnb", []sdk.AccAddress{addr}, true},
-				}),
+					NewMarket("market", "xrp", "bnb", []sdk.AccAddress{addr}, true),
+					NewMarket("market", "xrp", "bnb", []sdk.AccAddress{addr}, true),
+				}, DefaultPriceHistoryLength, DefaultMissedWindowsThreshold, DefaultDeviationThreshold),
 				[]PostedPrice{NewPostedPrice("xrp", addr, sdk.OneDec(), now)},
 			),
 			expPass: false,
@@ -62,7 +82,7 @@ func TestGenesisStateValidate(t *testing.T) {
 		{
 			msg: "invalid posted price",
 			genesisState: NewGenesisState(
-				NewParams(Markets{}),
+				NewParams(Markets{}, DefaultPriceHistoryLength, DefaultMissedWindowsThreshold, DefaultDeviationThreshold),
 				[]PostedPrice{NewPostedPrice("xrp", nil, sdk.OneDec(), now)},
 			),
 			expPass: false,
@@ -70,7 +90,7 @@ func TestGenesisStateValidate(t *testing.T) {
 		{
 			msg: "duplicated posted price",
 			genesisState: NewGenesisState(
-				NewParams(Markets{}),
+				NewParams(Markets{}, DefaultPriceHistoryLength, DefaultMissedWindowsThreshold, DefaultDeviationThreshold),
 				[]PostedPrice{
 					NewPostedPrice("xrp", addr, sdk.OneDec(), now),
 					NewPostedPrice("xrp", addr, sdk.OneDec(), now),
@@ -1,5 +1,11 @@
 package types
 
+import (
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	// ModuleName The name that will be used throughout the module
 	ModuleName = "pricefeed"
@@ -21,16 +27,98 @@ var (
 	// CurrentPricePrefix prefix for the current price of an asset
 	CurrentPricePrefix = []byte{0x00}
 
-	// RawPriceFeedPrefix prefix for the raw pricefeed of an asset
-	RawPriceFeedPrefix = []byte{0x01}
+	// LegacyRawPriceFeedPrefix prefix for the raw pricefeed of an asset, stored as a single slice of posted prices per market
+	// Deprecated: raw prices are stored per oracle under RawPriceFeedPrefix, see Keeper.MigrateRawPrices
+	LegacyRawPriceFeedPrefix = []byte{0x01}
+
+	// RawPriceFeedPrefix prefix for the raw price posted by each oracle for an asset
+	RawPriceFeedPrefix = []byte{0x02}
+
+	// PriceHistoryPrefix prefix for the median price samples of an asset
+	PriceHistoryPrefix = []byte{0x03}
+
+	// PriceHistorySequencePrefix prefix for the sequence of the next median price sample of an asset
+	PriceHistorySequencePrefix = []byte{0x04}
+
+	// StaleMarketPrefix prefix for the markets whose price was rejected by the circuit breaker
+	StaleMarketPrefix = []byte{0x05}
+
+	// OracleRecordPrefix prefix for the accountability record of each oracle of an asset
+	OracleRecordPrefix = []byte{0x06}
+
+	// PriceAcceptedTimePrefix prefix for the block time at which the current price of an asset was last accepted
+	PriceAcceptedTimePrefix = []byte{0x07}
 )
 
+// MaxMarketIDLength is the maximum length of a market id, so it can be length prefixed in store keys
+const MaxMarketIDLength = 255
+
 // CurrentPriceKey returns the prefix for the current price
 func CurrentPriceKey(marketID string) []byte {
 	return append(CurrentPricePrefix, []byte(marketID)...)
 }
 
-// RawPriceKey returns the prefix for the raw price
-func RawPriceKey(marketID string) []byte {
-	return append(RawPriceFeedPrefix, []byte(marketID)...)
+// LegacyRawPriceKey returns the key for the raw prices of a market in the legacy store layout
+func LegacyRawPriceKey(marketID string) []byte {
+	return append(LegacyRawPriceFeedPrefix, []byte(marketID)...)
+}
+
+// RawPriceIteratorKey returns the prefix for the raw prices of a market
+// The market id is length prefixed so markets with ids that share a prefix are iterated separately.
+func RawPriceIteratorKey(marketID string) []byte {
+	return append(append([]byte{}, RawPriceFeedPrefix...), lengthPrefixMarketID(marketID)...)
+}
+
+// RawPriceKey returns the key for the raw price posted by an oracle for a market
+func RawPriceKey(marketID string, oracle sdk.AccAddress) []byte {
+	return append(RawPriceIteratorKey(marketID), oracle...)
+}
+
+// OracleRecordIteratorKey returns the prefix for the oracle records of a market
+func OracleRecordIteratorKey(marketID string) []byte {
+	return append(append([]byte{}, OracleRecordPrefix...), lengthPrefixMarketID(marketID)...)
+}
+
+// OracleRecordKey returns the key for the record of an oracle for a market
+func OracleRecordKey(marketID string, oracle sdk.AccAddress) []byte {
+	return append(OracleRecordIteratorKey(marketID), oracle...)
+}
+
+// PriceAcceptedTimeKey returns the key for the time the current price of a market was last accepted
+func PriceAcceptedTimeKey(marketID string) []byte {
+	return append(PriceAcceptedTimePrefix, []byte(marketID)...)
+}
+
+// StaleMarketKey returns the key for the stale status of a market
+func StaleMarketKey(marketID string) []byte {
+	return append(StaleMarketPrefix, []byte(marketID)...)
+}
+
+// PriceHistoryIteratorKey returns the prefix for the price samples of a market
+func PriceHistoryIteratorKey(marketID string) []byte {
+	return append(append([]byte{}, PriceHistoryPrefix...), lengthPrefixMarketID(marketID)...)
+}
+
+// PriceHistoryKey returns the key for a price sample of a market
+func PriceHistoryKey(marketID string, sequence uint64) []byte {
+	return append(PriceHistoryIteratorKey(marketID), GetSequenceBytes(sequence)...)
+}
+
+// PriceHistorySequenceKey returns the key for the sequence of the next price sample of a market
+func PriceHistorySequenceKey(marketID string) []byte {
+	return append(PriceHistorySequencePrefix, []byte(marketID)...)
+}
+
+// GetSequenceBytes returns a byte slice representation of a price sample sequence
+func GetSequenceBytes(sequence uint64) []byte {
+	return sdk.Uint64ToBigEndian(sequence)
+}
+
+// GetSequenceFromBytes returns the price sample sequence from its byte representation
+func GetSequenceFromBytes(bz []byte) uint64 {
+	return binary.BigEndian.Uint64(bz)
+}
+
+func lengthPrefixMarketID(marketID string) []byte {
+	return append([]byte{byte(len(marketID))}, []byte(marketID)...)
 }
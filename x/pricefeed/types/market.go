@@ -11,11 +11,16 @@ import (
 
 // Market an asset in the pricefeed
 type Market struct {
-	MarketID   string           `json:"market_id" yaml:"market_id"`
-	BaseAsset  string           `json:"base_asset" yaml:"base_asset"`
-	QuoteAsset string           `json:"quote_asset" yaml:"quote_asset"`
-	Oracles    []sdk.AccAddress `json:"oracles" yaml:"oracles"`
-	Active     bool             `json:"active" yaml:"active"`
+	MarketID           string           `json:"market_id" yaml:"market_id"`
+	BaseAsset          string           `json:"base_asset" yaml:"base_asset"`
+	QuoteAsset         string           `json:"quote_asset" yaml:"quote_asset"`
+	Oracles            []sdk.AccAddress `json:"oracles" yaml:"oracles"`
+	Active             bool             `json:"active" yaml:"active"`
+	MaxPriceChange     sdk.Dec          `json:"max_price_change" yaml:"max_price_change"`         // maximum fractional change of the median price from the last accepted price per MaxPriceChangePeriod, zero disables the check
+	Quorum             uint64           `json:"quorum" yaml:"quorum"`                             // minimum number of unexpired oracle prices, after outlier rejection, needed to update the price
+	MaxOracleDeviation sdk.Dec          `json:"max_oracle_deviation" yaml:"max_oracle_deviation"` // maximum fractional deviation of an oracle price from the median before it is rejected, zero disables outlier rejection
+	OracleWeights      []sdk.Int        `json:"oracle_weights" yaml:"oracle_weights"`             // weight of each oracle's price in the median, in the same order as the oracles, empty gives every oracle equal weight
+	Sources            MarketSources    `json:"sources" yaml:"sources"`                           // markets whose current prices are multiplied, or divided if inverse, to derive the price, empty for markets priced by oracles
 }
 
 // NewMarket returns a new Market
@@ -36,8 +41,50 @@ func (m Market) String() string {
 	Base Asset: %s
 	Quote Asset: %s
 	Oracles: %s
-	Active: %t`,
-		m.MarketID, m.BaseAsset, m.QuoteAsset, m.Oracles, m.Active)
+	Active: %t
+	Max Price Change: %s
+	Quorum: %d
+	Max Oracle Deviation: %s
+	Oracle Weights: %s
+	Sources: %s`,
+		m.MarketID, m.BaseAsset, m.QuoteAsset, m.Oracles, m.Active, m.MaxPriceChange, m.Quorum, m.MaxOracleDeviation, m.OracleWeights, m.Sources)
+}
+
+// IsDerived returns true if the market's price is derived from the prices of other markets rather than posted by oracles
+func (m Market) IsDerived() bool {
+	return len(m.Sources) > 0
+}
+
+// OracleWeight returns the weight of an oracle's price in the market's median.
+// Oracles have a weight of one if the market has no oracle weights, and oracles not in the market have no weight.
+func (m Market) OracleWeight(oracle sdk.AccAddress) sdk.Int {
+	if len(m.OracleWeights) == 0 {
+		return sdk.OneInt()
+	}
+	for i, o := range m.Oracles {
+		if o.Equals(oracle) {
+			return m.OracleWeights[i]
+		}
+	}
+	return sdk.ZeroInt()
+}
+
+// PriceChangeLimitEnabled returns true if the market limits the change of the median price between updates
+func (m Market) PriceChangeLimitEnabled() bool {
+	return !m.MaxPriceChange.IsNil() && m.MaxPriceChange.IsPositive()
+}
+
+// MaxPriceChangeSince returns the maximum fractional change of the median price a given time after the last accepted price.
+// The max price change is allowed once per MaxPriceChangePeriod, so a market that has genuinely moved further than its
+// max price change recovers once enough time has passed.
+func (m Market) MaxPriceChangeSince(elapsed time.Duration) sdk.Dec {
+	periods := sdk.NewDec(int64(elapsed)).QuoInt64(int64(MaxPriceChangePeriod))
+	return m.MaxPriceChange.Mul(sdk.MaxDec(sdk.OneDec(), periods))
+}
+
+// OutlierRejectionEnabled returns true if the market rejects oracle prices too far from the median
+func (m Market) OutlierRejectionEnabled() bool {
+	return !m.MaxOracleDeviation.IsNil() && m.MaxOracleDeviation.IsPositive()
 }
 
 // Validate performs a basic validation of the market params
@@ -45,6 +92,9 @@ func (m Market) Validate() error {
 	if strings.TrimSpace(m.MarketID) == "" {
 		return errors.New("market id cannot be blank")
 	}
+	if len(m.MarketID) > MaxMarketIDLength {
+		return fmt.Errorf("market id cannot be longer than %d characters", MaxMarketIDLength)
+	}
 	if err := sdk.ValidateDenom(m.BaseAsset); err != nil {
 		return fmt.Errorf("invalid base asset: %w", err)
 	}
@@ -61,9 +111,93 @@ func (m Market) Validate() error {
 		}
 		seenOracles[oracle.String()] = true
 	}
+	if !m.MaxPriceChange.IsNil() && m.MaxPriceChange.IsNegative() {
+		return fmt.Errorf("max price change cannot be negative %s", m.MaxPriceChange)
+	}
+	if m.Quorum > uint64(len(m.Oracles)) {
+		return fmt.Errorf("quorum %d cannot be greater than the number of oracles %d", m.Quorum, len(m.Oracles))
+	}
+	if !m.MaxOracleDeviation.IsNil() && m.MaxOracleDeviation.IsNegative() {
+		return fmt.Errorf("max oracle deviation cannot be negative %s", m.MaxOracleDeviation)
+	}
+	if m.OutlierRejectionEnabled() && m.Quorum < 1 {
+		return fmt.Errorf("quorum must be at least 1 when max oracle deviation is set, is %d", m.Quorum)
+	}
+	if len(m.OracleWeights) > 0 && len(m.OracleWeights) != len(m.Oracles) {
+		return fmt.Errorf("number of oracle weights %d must match the number of oracles %d", len(m.OracleWeights), len(m.Oracles))
+	}
+	for i, weight := range m.OracleWeights {
+		if weight.BigInt() == nil || !weight.IsPositive() {
+			return fmt.Errorf("oracle weight %d must be positive, is %s", i, weight)
+		}
+	}
+	if m.IsDerived() {
+		return m.validateDerived()
+	}
 	return nil
 }
 
+// validateDerived checks that a derived market has at least two distinct sources and no oracle params
+func (m Market) validateDerived() error {
+	if len(m.Sources) < 2 {
+		return fmt.Errorf("derived market %s must have at least 2 sources", m.MarketID)
+	}
+	if len(m.Oracles) > 0 {
+		return fmt.Errorf("derived market %s cannot have oracles", m.MarketID)
+	}
+	if m.OutlierRejectionEnabled() {
+		return fmt.Errorf("derived market %s cannot have a max oracle deviation", m.MarketID)
+	}
+	seenSources := make(map[string]bool)
+	for _, source := range m.Sources {
+		if strings.TrimSpace(source.MarketID) == "" {
+			return fmt.Errorf("derived market %s source market id cannot be blank", m.MarketID)
+		}
+		if source.MarketID == m.MarketID {
+			return fmt.Errorf("derived market %s cannot be its own source", m.MarketID)
+		}
+		if seenSources[source.MarketID] {
+			return fmt.Errorf("derived market %s has duplicated source %s", m.MarketID, source.MarketID)
+		}
+		seenSources[source.MarketID] = true
+	}
+	return nil
+}
+
+// MarketSource a market whose current price is used to derive the price of another market
+type MarketSource struct {
+	MarketID string `json:"market_id" yaml:"market_id"`
+	Inverse  bool   `json:"inverse" yaml:"inverse"` // divide by the source price instead of multiplying by it
+}
+
+// NewMarketSource returns a new MarketSource
+func NewMarketSource(marketID string, inverse bool) MarketSource {
+	return MarketSource{
+		MarketID: marketID,
+		Inverse:  inverse,
+	}
+}
+
+// String implements fmt.Stringer
+func (ms MarketSource) String() string {
+	if ms.Inverse {
+		return fmt.Sprintf("1/%s", ms.MarketID)
+	}
+	return ms.MarketID
+}
+
+// MarketSources array type for market sources
+type MarketSources []MarketSource
+
+// String implements fmt.Stringer
+func (mss MarketSources) String() string {
+	sources := make([]string, len(mss))
+	for i, ms := range mss {
+		sources[i] = ms.String()
+	}
+	return strings.Join(sources, " * ")
+}
+
 // Markets array type for oracle
 type Markets []Market
 
@@ -80,9 +214,31 @@ func (ms Markets) Validate() error {
 		}
 		seenMarkets[m.MarketID] = true
 	}
+	// derived markets can only be derived from markets priced by oracles, so all derived prices can be updated after the oracle prices
+	for _, m := range ms {
+		for _, source := range m.Sources {
+			sourceMarket, found := ms.Get(source.MarketID)
+			if !found {
+				return fmt.Errorf("derived market %s source %s not found", m.MarketID, source.MarketID)
+			}
+			if sourceMarket.IsDerived() {
+				return fmt.Errorf("derived market %s source %s cannot be a derived market", m.MarketID, source.MarketID)
+			}
+		}
+	}
 	return nil
 }
 
+// Get returns the market with the input market id
+func (ms Markets) Get(marketID string) (Market, bool) {
+	for _, m := range ms {
+		if m.MarketID == marketID {
+			return m, true
+		}
+	}
+	return Market{}, false
+}
+
 // String implements fmt.Stringer
 func (ms Markets) String() string {
 	out := "Markets:\n"
@@ -106,6 +262,32 @@ func NewCurrentPrice(marketID string, price sdk.Dec) CurrentPrice {
 // CurrentPrices type for an array of CurrentPrice
 type CurrentPrices []CurrentPrice
 
+// WeightedPrice a price and its weight in a weighted median
+type WeightedPrice struct {
+	Price  sdk.Dec `json:"price" yaml:"price"`
+	Weight sdk.Int `json:"weight" yaml:"weight"`
+}
+
+// NewWeightedPrice returns a new WeightedPrice
+func NewWeightedPrice(price sdk.Dec, weight sdk.Int) WeightedPrice {
+	return WeightedPrice{
+		Price:  price,
+		Weight: weight,
+	}
+}
+
+// WeightedPrices type for an array of WeightedPrice
+type WeightedPrices []WeightedPrice
+
+// TotalWeight returns the sum of the weights of the prices
+func (wps WeightedPrices) TotalWeight() sdk.Int {
+	total := sdk.ZeroInt()
+	for _, wp := range wps {
+		total = total.Add(wp.Weight)
+	}
+	return total
+}
+
 // PostedPrice price for market posted by a specific oracle
 type PostedPrice struct {
 	MarketID      string         `json:"market_id" yaml:"market_id"`
@@ -185,6 +367,106 @@ func (ps PostedPrices) String() string {
 	return strings.TrimSpace(out)
 }
 
+// PriceSample the median price of a market recorded at a block time
+type PriceSample struct {
+	Time  time.Time `json:"time" yaml:"time"`
+	Price sdk.Dec   `json:"price" yaml:"price"`
+}
+
+// NewPriceSample returns a new PriceSample
+func NewPriceSample(time time.Time, price sdk.Dec) PriceSample {
+	return PriceSample{
+		Time:  time,
+		Price: price,
+	}
+}
+
+// implement fmt.Stringer
+func (ps PriceSample) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`Time: %s
+Price: %s`, ps.Time, ps.Price))
+}
+
+// PriceSamples type for an array of PriceSample, ordered from oldest to newest
+type PriceSamples []PriceSample
+
+// String implements fmt.Stringer
+func (pss PriceSamples) String() string {
+	out := "Price Samples:\n"
+	for _, ps := range pss {
+		out += fmt.Sprintf("%s\n", ps.String())
+	}
+	return strings.TrimSpace(out)
+}
+
+// MaxPriceChangePeriod is the period over which the median price of a market can move by the market's max price change
+const MaxPriceChangePeriod = time.Hour
+
+// OracleDeviationWindow is the number of median prices the rolling deviation of an oracle is averaged over
+const OracleDeviationWindow = uint64(100)
+
+// OracleRecord tracks how reliably an oracle posts prices for a market
+type OracleRecord struct {
+	MarketID            string         `json:"market_id" yaml:"market_id"`
+	OracleAddress       sdk.AccAddress `json:"oracle_address" yaml:"oracle_address"`
+	LastPostTime        time.Time      `json:"last_post_time" yaml:"last_post_time"`                 // block time of the oracle's last price post
+	MissedWindows       uint64         `json:"missed_windows" yaml:"missed_windows"`                 // number of posted prices that expired before the oracle posted a new price
+	LastMissedExpiry    time.Time      `json:"last_missed_expiry" yaml:"last_missed_expiry"`         // expiry of the last posted price counted as a missed window
+	Deviation           sdk.Dec        `json:"deviation" yaml:"deviation"`                           // rolling average of the fractional distance of the oracle's price from the median price
+	DeviationSamples    uint64         `json:"deviation_samples" yaml:"deviation_samples"`           // number of the oracle's posted prices that have been compared to the median price
+	LastSampledPostTime time.Time      `json:"last_sampled_post_time" yaml:"last_sampled_post_time"` // post time of the oracle's last price compared to the median price
+}
+
+// NewOracleRecord returns a new OracleRecord for an oracle that has not been tracked yet
+func NewOracleRecord(marketID string, oracle sdk.AccAddress) OracleRecord {
+	return OracleRecord{
+		MarketID:      marketID,
+		OracleAddress: oracle,
+		Deviation:     sdk.ZeroDec(),
+	}
+}
+
+// AddDeviationSample adds the deviation of a posted price from a median price to the rolling deviation.
+// The rolling deviation is the mean of the samples until there are OracleDeviationWindow samples,
+// after which it is an exponential moving average over that window.
+func (or OracleRecord) AddDeviationSample(price, median sdk.Dec) OracleRecord {
+	if !median.IsPositive() {
+		return or
+	}
+	sample := price.Sub(median).Abs().Quo(median)
+	or.DeviationSamples++
+	window := or.DeviationSamples
+	if window > OracleDeviationWindow {
+		window = OracleDeviationWindow
+	}
+	or.Deviation = or.Deviation.Add(sample.Sub(or.Deviation).QuoInt64(int64(window)))
+	return or
+}
+
+// String implements fmt.Stringer
+func (or OracleRecord) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`Market ID: %s
+Oracle Address: %s
+Last Post Time: %s
+Missed Windows: %d
+Last Missed Expiry: %s
+Deviation: %s
+Deviation Samples: %d
+Last Sampled Post Time: %s`, or.MarketID, or.OracleAddress, or.LastPostTime, or.MissedWindows, or.LastMissedExpiry, or.Deviation, or.DeviationSamples, or.LastSampledPostTime))
+}
+
+// OracleRecords type for an array of OracleRecord
+type OracleRecords []OracleRecord
+
+// String implements fmt.Stringer
+func (ors OracleRecords) String() string {
+	out := "Oracle Records:\n"
+	for _, or := range ors {
+		out += fmt.Sprintf("%s\n", or.String())
+	}
+	return strings.TrimSpace(out)
+}
+
 // SortDecs provides the interface needed to sort sdk.Dec slices
 type SortDecs []sdk.Dec
 
@@ -77,6 +77,148 @@ func TestMarketValidate(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"valid circuit breaker",
+			Market{
+				MarketID:           "market",
+				BaseAsset:          "xrp",
+				QuoteAsset:         "bnb",
+				Oracles:            []sdk.AccAddress{addr},
+				Active:             true,
+				MaxPriceChange:     sdk.MustNewDecFromStr("0.1"),
+				Quorum:             1,
+				MaxOracleDeviation: sdk.MustNewDecFromStr("0.05"),
+			},
+			true,
+		},
+		{
+			"negative max price change",
+			Market{
+				MarketID:       "market",
+				BaseAsset:      "xrp",
+				QuoteAsset:     "bnb",
+				Oracles:        []sdk.AccAddress{addr},
+				MaxPriceChange: sdk.MustNewDecFromStr("-0.1"),
+			},
+			false,
+		},
+		{
+			"quorum greater than oracles",
+			Market{
+				MarketID:   "market",
+				BaseAsset:  "xrp",
+				QuoteAsset: "bnb",
+				Oracles:    []sdk.AccAddress{addr},
+				Quorum:     2,
+			},
+			false,
+		},
+		{
+			"negative max oracle deviation",
+			Market{
+				MarketID:           "market",
+				BaseAsset:          "xrp",
+				QuoteAsset:         "bnb",
+				Oracles:            []sdk.AccAddress{addr},
+				MaxOracleDeviation: sdk.MustNewDecFromStr("-0.05"),
+			},
+			false,
+		},
+		{
+			"max oracle deviation without quorum",
+			Market{
+				MarketID:           "market",
+				BaseAsset:          "xrp",
+				QuoteAsset:         "bnb",
+				Oracles:            []sdk.AccAddress{addr},
+				MaxOracleDeviation: sdk.MustNewDecFromStr("0.05"),
+			},
+			false,
+		},
+		{
+			"valid derived market",
+			Market{
+				MarketID:   "xrp:usd",
+				BaseAsset:  "xrp",
+				QuoteAsset: "usd",
+				Sources:    MarketSources{NewMarketSource("xrp:btc", false), NewMarketSource("usd:btc", true)},
+			},
+			true,
+		},
+		{
+			"derived market with one source",
+			Market{
+				MarketID:   "xrp:usd",
+				BaseAsset:  "xrp",
+				QuoteAsset: "usd",
+				Sources:    MarketSources{NewMarketSource("xrp:btc", false)},
+			},
+			false,
+		},
+		{
+			"derived market with oracles",
+			Market{
+				MarketID:   "xrp:usd",
+				BaseAsset:  "xrp",
+				QuoteAsset: "usd",
+				Oracles:    []sdk.AccAddress{addr},
+				Sources:    MarketSources{NewMarketSource("xrp:btc", false), NewMarketSource("btc:usd", false)},
+			},
+			false,
+		},
+		{
+			"derived market with duplicated source",
+			Market{
+				MarketID:   "xrp:usd",
+				BaseAsset:  "xrp",
+				QuoteAsset: "usd",
+				Sources:    MarketSources{NewMarketSource("xrp:btc", false), NewMarketSource("xrp:btc", true)},
+			},
+			false,
+		},
+		{
+			"derived market as its own source",
+			Market{
+				MarketID:   "xrp:usd",
+				BaseAsset:  "xrp",
+				QuoteAsset: "usd",
+				Sources:    MarketSources{NewMarketSource("xrp:usd", false), NewMarketSource("btc:usd", false)},
+			},
+			false,
+		},
+		{
+			"valid oracle weights",
+			Market{
+				MarketID:      "market",
+				BaseAsset:     "xrp",
+				QuoteAsset:    "bnb",
+				Oracles:       []sdk.AccAddress{addr},
+				OracleWeights: []sdk.Int{sdk.NewInt(3)},
+			},
+			true,
+		},
+		{
+			"oracle weights length mismatch",
+			Market{
+				MarketID:      "market",
+				BaseAsset:     "xrp",
+				QuoteAsset:    "bnb",
+				Oracles:       []sdk.AccAddress{addr},
+				OracleWeights: []sdk.Int{sdk.NewInt(3), sdk.NewInt(1)},
+			},
+			false,
+		},
+		{
+			"zero oracle weight",
+			Market{
+				MarketID:      "market",
+				BaseAsset:     "xrp",
+				QuoteAsset:    "bnb",
+				Oracles:       []sdk.AccAddress{addr},
+				OracleWeights: []sdk.Int{sdk.ZeroInt()},
+			},
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -156,3 +298,52 @@ func TestPostedPriceValidate(t *testing.T) {
 		}
 	}
 }
+
+func TestOracleRecordAddDeviationSample(t *testing.T) {
+	record := NewOracleRecord("market", sdk.AccAddress("oracle"))
+	median := sdk.MustNewDecFromStr("10.0")
+
+	// samples are averaged until the window is full
+	record = record.AddDeviationSample(sdk.MustNewDecFromStr("11.0"), median)
+	require.Equal(t, sdk.MustNewDecFromStr("0.1"), record.Deviation)
+	record = record.AddDeviationSample(sdk.MustNewDecFromStr("7.0"), median)
+	require.Equal(t, sdk.MustNewDecFromStr("0.2"), record.Deviation)
+	require.Equal(t, uint64(2), record.DeviationSamples)
+
+	// a zero median price is not sampled
+	require.Equal(t, record, record.AddDeviationSample(sdk.OneDec(), sdk.ZeroDec()))
+
+	// once the window is full, each sample moves the deviation by a fraction of the window
+	record.DeviationSamples = OracleDeviationWindow
+	record = record.AddDeviationSample(median, median)
+	require.Equal(t, sdk.MustNewDecFromStr("0.198"), record.Deviation)
+}
+
+func TestMarketsValidate_DerivedSources(t *testing.T) {
+	xrpBTC := NewMarket("xrp:btc", "xrp", "btc", nil, true)
+	btcUSD := NewMarket("btc:usd", "btc", "usd", nil, true)
+	xrpUSD := NewMarket("xrp:usd", "xrp", "usd", nil, true)
+	xrpUSD.Sources = MarketSources{NewMarketSource("xrp:btc", false), NewMarketSource("btc:usd", false)}
+	usdXRP := NewMarket("usd:xrp", "usd", "xrp", nil, true)
+	usdXRP.Sources = MarketSources{NewMarketSource("xrp:usd", true), NewMarketSource("btc:usd", false)}
+
+	testCases := []struct {
+		name    string
+		markets Markets
+		expPass bool
+	}{
+		{"valid", Markets{xrpBTC, btcUSD, xrpUSD}, true},
+		{"missing source", Markets{xrpBTC, xrpUSD}, false},
+		{"derived source", Markets{xrpBTC, btcUSD, xrpUSD, usdXRP}, false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.markets.Validate()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -13,13 +13,18 @@ import (
 const (
 	// TypeMsgPostPrice type of PostPrice msg
 	TypeMsgPostPrice = "post_price"
+	// TypeMsgPostPrices type of PostPrices msg
+	TypeMsgPostPrices = "post_prices"
 
 	// MaxExpiry defines the max expiry time defined as UNIX time (9999-12-31 23:59:59 +0000 UTC)
 	MaxExpiry = 253402300799
 )
 
 // ensure Msg interface compliance at compile time
-var _ sdk.Msg = &MsgPostPrice{}
+var (
+	_ sdk.Msg = &MsgPostPrice{}
+	_ sdk.Msg = &MsgPostPrices{}
+)
 
 // MsgPostPrice struct representing a posted price message.
 // Used by oracles to input prices to the pricefeed
@@ -66,14 +71,88 @@ func (msg MsgPostPrice) ValidateBasic() error {
 	if msg.From.Empty() {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "sender address cannot be empty")
 	}
-	if strings.TrimSpace(msg.MarketID) == "" {
+	return NewPriceEntry(msg.MarketID, msg.Price, msg.Expiry).Validate()
+}
+
+// PriceEntry a price for a market posted in a MsgPostPrices
+type PriceEntry struct {
+	MarketID string    `json:"market_id" yaml:"market_id"` // asset code used by exchanges/api
+	Price    sdk.Dec   `json:"price" yaml:"price"`         // price in decimal (max precision 18)
+	Expiry   time.Time `json:"expiry" yaml:"expiry"`       // expiry time
+}
+
+// NewPriceEntry returns a new PriceEntry
+func NewPriceEntry(marketID string, price sdk.Dec, expiry time.Time) PriceEntry {
+	return PriceEntry{
+		MarketID: marketID,
+		Price:    price,
+		Expiry:   expiry,
+	}
+}
+
+// Validate performs a basic validation of the price entry
+func (pe PriceEntry) Validate() error {
+	if strings.TrimSpace(pe.MarketID) == "" {
 		return errors.New("market id cannot be blank")
 	}
-	if msg.Price.IsNegative() {
-		return fmt.Errorf("price cannot be negative: %s", msg.Price.String())
+	if pe.Price.IsNegative() {
+		return fmt.Errorf("price cannot be negative: %s", pe.Price.String())
 	}
-	if msg.Expiry.IsZero() {
+	if pe.Expiry.IsZero() {
 		return errors.New("must set an expiration time")
 	}
 	return nil
 }
+
+// MsgPostPrices struct representing a message posting prices for several markets.
+// Used by oracles to input the prices of all the markets they cover in one message
+type MsgPostPrices struct {
+	From   sdk.AccAddress `json:"from" yaml:"from"`     // client that sent in this address
+	Prices []PriceEntry   `json:"prices" yaml:"prices"` // prices for each market, at most one per market
+}
+
+// NewMsgPostPrices creates a new post prices msg
+func NewMsgPostPrices(from sdk.AccAddress, prices []PriceEntry) MsgPostPrices {
+	return MsgPostPrices{
+		From:   from,
+		Prices: prices,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgPostPrices) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgPostPrices) Type() string { return TypeMsgPostPrices }
+
+// GetSignBytes Implements Msg.
+func (msg MsgPostPrices) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgPostPrices) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.From}
+}
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgPostPrices) ValidateBasic() error {
+	if msg.From.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "sender address cannot be empty")
+	}
+	if len(msg.Prices) == 0 {
+		return errors.New("must post at least one price")
+	}
+	seenMarkets := make(map[string]bool)
+	for _, pe := range msg.Prices {
+		if err := pe.Validate(); err != nil {
+			return err
+		}
+		if seenMarkets[pe.MarketID] {
+			return fmt.Errorf("duplicate price for market %s", pe.MarketID)
+		}
+		seenMarkets[pe.MarketID] = true
+	}
+	return nil
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -36,3 +37,32 @@ func TestMsgPlaceBid_ValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgPostPrices_ValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress([]byte("someName"))
+	price := sdk.MustNewDecFromStr("0.3005")
+	expiry := tmtime.Now()
+
+	tests := []struct {
+		name       string
+		msg        MsgPostPrices
+		expectPass bool
+	}{
+		{"normal", NewMsgPostPrices(addr, []PriceEntry{NewPriceEntry("xrp:usd", price, expiry), NewPriceEntry("bnb:usd", price, expiry)}), true},
+		{"emptyAddr", NewMsgPostPrices(sdk.AccAddress{}, []PriceEntry{NewPriceEntry("xrp:usd", price, expiry)}), false},
+		{"noPrices", NewMsgPostPrices(addr, nil), false},
+		{"emptyAsset", NewMsgPostPrices(addr, []PriceEntry{NewPriceEntry("xrp:usd", price, expiry), NewPriceEntry("", price, expiry)}), false},
+		{"negativePrice", NewMsgPostPrices(addr, []PriceEntry{NewPriceEntry("xrp:usd", sdk.MustNewDecFromStr("-3.05"), expiry)}), false},
+		{"noExpiry", NewMsgPostPrices(addr, []PriceEntry{NewPriceEntry("xrp:usd", price, time.Time{})}), false},
+		{"duplicateMarket", NewMsgPostPrices(addr, []PriceEntry{NewPriceEntry("xrp:usd", price, expiry), NewPriceEntry("xrp:usd", price, expiry)}), false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.expectPass {
+				require.Nil(t, tc.msg.ValidateBasic())
+			} else {
+				require.NotNil(t, tc.msg.ValidateBasic())
+			}
+		})
+	}
+}
@@ -4,30 +4,44 @@ import (
 	"fmt"
 	"strings"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/params"
 )
 
 // Parameter keys
 var (
-	KeyMarkets     = []byte("Markets")
-	DefaultMarkets = Markets{}
+	KeyMarkets                      = []byte("Markets")
+	KeyPriceHistoryLength           = []byte("PriceHistoryLength")
+	KeyOracleMissedWindowsThreshold = []byte("OracleMissedWindowsThreshold")
+	KeyOracleDeviationThreshold     = []byte("OracleDeviationThreshold")
+	DefaultMarkets                  = Markets{}
+	DefaultPriceHistoryLength       = uint64(100)
+	MaxPriceHistoryLength           = uint64(10000)
+	DefaultMissedWindowsThreshold   = uint64(0)
+	DefaultDeviationThreshold       = sdk.ZeroDec()
 )
 
 // Params params for pricefeed. Can be altered via governance
 type Params struct {
-	Markets Markets `json:"markets" yaml:"markets"` //  Array containing the markets supported by the pricefeed
+	Markets                      Markets `json:"markets" yaml:"markets"`                                                 //  Array containing the markets supported by the pricefeed
+	PriceHistoryLength           uint64  `json:"price_history_length" yaml:"price_history_length"`                       //  Number of median price samples kept for each market, zero disables price history
+	OracleMissedWindowsThreshold uint64  `json:"oracle_missed_windows_threshold" yaml:"oracle_missed_windows_threshold"` //  Number of missed windows above which an oracle is reported as delinquent, zero disables the report
+	OracleDeviationThreshold     sdk.Dec `json:"oracle_deviation_threshold" yaml:"oracle_deviation_threshold"`           //  Rolling deviation above which an oracle is reported as delinquent, zero disables the report
 }
 
 // NewParams creates a new AssetParams object
-func NewParams(markets Markets) Params {
+func NewParams(markets Markets, priceHistoryLength, missedWindowsThreshold uint64, deviationThreshold sdk.Dec) Params {
 	return Params{
-		Markets: markets,
+		Markets:                      markets,
+		PriceHistoryLength:           priceHistoryLength,
+		OracleMissedWindowsThreshold: missedWindowsThreshold,
+		OracleDeviationThreshold:     deviationThreshold,
 	}
 }
 
 // DefaultParams default params for pricefeed
 func DefaultParams() Params {
-	return NewParams(DefaultMarkets)
+	return NewParams(DefaultMarkets, DefaultPriceHistoryLength, DefaultMissedWindowsThreshold, DefaultDeviationThreshold)
 }
 
 // ParamKeyTable Key declaration for parameters
@@ -40,6 +54,9 @@ func ParamKeyTable() params.KeyTable {
 func (p *Params) ParamSetPairs() params.ParamSetPairs {
 	return params.ParamSetPairs{
 		params.NewParamSetPair(KeyMarkets, &p.Markets, validateMarketParams),
+		params.NewParamSetPair(KeyPriceHistoryLength, &p.PriceHistoryLength, validatePriceHistoryLengthParam),
+		params.NewParamSetPair(KeyOracleMissedWindowsThreshold, &p.OracleMissedWindowsThreshold, validateMissedWindowsThresholdParam),
+		params.NewParamSetPair(KeyOracleDeviationThreshold, &p.OracleDeviationThreshold, validateDeviationThresholdParam),
 	}
 }
 
@@ -49,12 +66,24 @@ func (p Params) String() string {
 	for _, a := range p.Markets {
 		out += fmt.Sprintf("%s\n", a.String())
 	}
+	out += fmt.Sprintf("Price History Length: %d\n", p.PriceHistoryLength)
+	out += fmt.Sprintf("Oracle Missed Windows Threshold: %d\n", p.OracleMissedWindowsThreshold)
+	out += fmt.Sprintf("Oracle Deviation Threshold: %s\n", p.OracleDeviationThreshold)
 	return strings.TrimSpace(out)
 }
 
 // Validate ensure that params have valid values
 func (p Params) Validate() error {
-	return validateMarketParams(p.Markets)
+	if err := validateMarketParams(p.Markets); err != nil {
+		return err
+	}
+	if err := validatePriceHistoryLengthParam(p.PriceHistoryLength); err != nil {
+		return err
+	}
+	if err := validateMissedWindowsThresholdParam(p.OracleMissedWindowsThreshold); err != nil {
+		return err
+	}
+	return validateDeviationThresholdParam(p.OracleDeviationThreshold)
 }
 
 func validateMarketParams(i interface{}) error {
@@ -65,3 +94,35 @@ func validateMarketParams(i interface{}) error {
 
 	return markets.Validate()
 }
+
+func validatePriceHistoryLengthParam(i interface{}) error {
+	length, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if length > MaxPriceHistoryLength {
+		return fmt.Errorf("price history length %d exceeds maximum %d", length, MaxPriceHistoryLength)
+	}
+	return nil
+}
+
+func validateMissedWindowsThresholdParam(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateDeviationThresholdParam(i interface{}) error {
+	threshold, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if !threshold.IsNil() && threshold.IsNegative() {
+		return fmt.Errorf("oracle deviation threshold cannot be negative %s", threshold)
+	}
+	return nil
+}
@@ -1,5 +1,9 @@
 package types
 
+import (
+	"time"
+)
+
 // price Takes an [assetcode] and returns CurrentPrice for that asset
 // pricefeed Takes an [assetcode] and returns the raw []PostedPrice for that asset
 // assets Returns []Assets in the pricefeed system
@@ -15,6 +19,12 @@ const (
 	QueryRawPrices = "rawprices"
 	// QueryPrice command for price queries
 	QueryPrice = "price"
+	// QueryPriceHistory command for price history queries
+	QueryPriceHistory = "pricehistory"
+	// QueryTWAP command for time weighted average price queries
+	QueryTWAP = "twap"
+	// QueryOracleRecords command for oracle accountability record queries
+	QueryOracleRecords = "oraclerecords"
 )
 
 // QueryWithMarketIDParams fields for querying information from a specific market
@@ -28,3 +38,17 @@ func NewQueryWithMarketIDParams(marketID string) QueryWithMarketIDParams {
 		MarketID: marketID,
 	}
 }
+
+// QueryTWAPParams fields for querying the time weighted average price of a market
+type QueryTWAPParams struct {
+	MarketID string
+	Window   time.Duration
+}
+
+// NewQueryTWAPParams creates a new instance of QueryTWAPParams
+func NewQueryTWAPParams(marketID string, window time.Duration) QueryTWAPParams {
+	return QueryTWAPParams{
+		MarketID: marketID,
+		Window:   window,
+	}
+}